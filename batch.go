@@ -0,0 +1,184 @@
+package krpcgo
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/atburke/krpc-go/types"
+	"github.com/ztrue/tracerr"
+)
+
+// BatchConfig controls how calls are coalesced into batches.
+type BatchConfig struct {
+	// Window is how long to wait for more calls after the first call of a
+	// batch. Defaults to 1ms.
+	Window time.Duration
+	// MaxSize is the maximum number of calls in a batch. A batch is sent as
+	// soon as it is full. Defaults to 64.
+	MaxSize int
+}
+
+// SetDefaults sets the config defaults.
+func (cfg *BatchConfig) SetDefaults() {
+	if cfg.Window == 0 {
+		cfg.Window = time.Millisecond
+	}
+	if cfg.MaxSize == 0 {
+		cfg.MaxSize = 64
+	}
+}
+
+// BatchStats are statistics about batched calls.
+type BatchStats struct {
+	// Batches is the number of batches sent.
+	Batches uint64
+	// Calls is the number of calls sent in batches.
+	Calls uint64
+	// MaxBatchSize is the number of calls in the largest batch.
+	MaxBatchSize int
+	// TotalLatency is the total time calls spent waiting for their results,
+	// including the time spent waiting for their batch to be sent.
+	TotalLatency time.Duration
+	// MaxLatency is the longest time a call waited for its result.
+	MaxLatency time.Duration
+}
+
+// MeanBatchSize gets the average number of calls in a batch.
+func (s BatchStats) MeanBatchSize() float64 {
+	if s.Batches == 0 {
+		return 0
+	}
+	return float64(s.Calls) / float64(s.Batches)
+}
+
+// MeanLatency gets the average time a call waited for its result.
+func (s BatchStats) MeanLatency() time.Duration {
+	if s.Calls == 0 {
+		return 0
+	}
+	return s.TotalLatency / time.Duration(s.Calls)
+}
+
+// batchedCall is a call waiting to be sent in a batch.
+type batchedCall struct {
+	call   *types.ProcedureCall
+	result *Future[*types.ProcedureResult]
+	queued time.Time
+}
+
+// batcher coalesces individual calls into batches.
+type batcher struct {
+	BatchConfig
+	callMultiple func(context.Context, []*types.ProcedureCall) ([]*types.ProcedureResult, error)
+
+	mu      sync.Mutex
+	pending []*batchedCall
+	timer   *time.Timer
+	stats   BatchStats
+}
+
+func newBatcher(cfg BatchConfig, callMultiple func(context.Context, []*types.ProcedureCall) ([]*types.ProcedureResult, error)) *batcher {
+	return &batcher{
+		BatchConfig:  cfg,
+		callMultiple: callMultiple,
+	}
+}
+
+// add adds a call to the next batch.
+func (b *batcher) add(call *types.ProcedureCall) *Future[*types.ProcedureResult] {
+	bc := &batchedCall{
+		call:   call,
+		result: newFuture[*types.ProcedureResult](),
+		queued: time.Now(),
+	}
+
+	b.mu.Lock()
+	b.pending = append(b.pending, bc)
+	if len(b.pending) >= b.MaxSize {
+		batch := b.take()
+		b.mu.Unlock()
+		go b.send(batch)
+		return bc.result
+	}
+	if len(b.pending) == 1 {
+		b.timer = time.AfterFunc(b.Window, b.flush)
+	}
+	b.mu.Unlock()
+	return bc.result
+}
+
+// take removes and returns the pending calls. Callers must hold the lock.
+func (b *batcher) take() []*batchedCall {
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+	batch := b.pending
+	b.pending = nil
+	return batch
+}
+
+// flush sends the pending calls.
+func (b *batcher) flush() {
+	b.mu.Lock()
+	batch := b.take()
+	b.mu.Unlock()
+	if len(batch) > 0 {
+		b.send(batch)
+	}
+}
+
+// send sends a batch of calls and delivers each result to its caller. An
+// error in one call doesn't affect the others; only a failure of the whole
+// request is delivered to every caller.
+func (b *batcher) send(batch []*batchedCall) {
+	calls := make([]*types.ProcedureCall, len(batch))
+	for i, bc := range batch {
+		calls[i] = bc.call
+	}
+	// Individual callers stop waiting when their own context ends, so the
+	// batch itself isn't bound to any of them.
+	results, err := b.callMultiple(context.Background(), calls)
+	if err == nil && len(results) != len(batch) {
+		err = tracerr.Errorf("Expected %v results, got %v", len(batch), len(results))
+	}
+
+	now := time.Now()
+	var totalLatency, maxLatency time.Duration
+	for i, bc := range batch {
+		if err != nil {
+			bc.result.resolve(nil, tracerr.Wrap(err))
+		} else {
+			bc.result.resolve(firstResult(results[i : i+1]))
+		}
+		latency := now.Sub(bc.queued)
+		totalLatency += latency
+		if latency > maxLatency {
+			maxLatency = latency
+		}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.stats.Batches++
+	b.stats.Calls += uint64(len(batch))
+	b.stats.TotalLatency += totalLatency
+	if len(batch) > b.stats.MaxBatchSize {
+		b.stats.MaxBatchSize = len(batch)
+	}
+	if maxLatency > b.stats.MaxLatency {
+		b.stats.MaxLatency = maxLatency
+	}
+}
+
+// BatchStats gets statistics about batched calls. The stats are empty if
+// batching is disabled.
+func (c *KRPCClient) BatchStats() BatchStats {
+	if c.batcher == nil {
+		return BatchStats{}
+	}
+	c.batcher.mu.Lock()
+	defer c.batcher.mu.Unlock()
+	return c.batcher.stats
+}
@@ -0,0 +1,87 @@
+package krpcgo
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/atburke/krpc-go/krpctest"
+	"github.com/atburke/krpc-go/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatching(t *testing.T) {
+	server := krpctest.NewServer(t)
+	server.HandleDefault(func(call *types.ProcedureCall) ([]byte, error) {
+		return []byte(call.Procedure), nil
+	})
+	server.HandleError("Test", "Fail", &types.Error{Service: "Test", Name: "Failure"})
+	client := connect(t, server, KRPCClientConfig{
+		RPCOnly: true,
+		Batch:   &BatchConfig{Window: 50 * time.Millisecond, MaxSize: 8},
+	})
+
+	const numCalls = 20
+	var wg sync.WaitGroup
+	errs := make([]error, numCalls)
+	values := make([]string, numCalls)
+	for i := 0; i < numCalls; i++ {
+		i := i
+		procedure := fmt.Sprint(i)
+		if i == 5 {
+			procedure = "Fail"
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result, err := client.Call(&types.ProcedureCall{Service: "Test", Procedure: procedure})
+			errs[i] = err
+			if err == nil {
+				values[i] = string(result.Value)
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i := 0; i < numCalls; i++ {
+		if i == 5 {
+			var serverErr *types.Error
+			require.ErrorAs(t, errs[i], &serverErr)
+			continue
+		}
+		require.NoError(t, errs[i])
+		require.Equal(t, fmt.Sprint(i), values[i])
+	}
+
+	stats := client.BatchStats()
+	require.Equal(t, uint64(numCalls), stats.Calls)
+	// The server also received the version check.
+	require.Equal(t, stats.Batches, uint64(server.Requests()-1))
+	require.Less(t, stats.Batches, uint64(numCalls))
+	require.LessOrEqual(t, stats.MaxBatchSize, 8)
+	require.Greater(t, stats.MeanLatency(), time.Duration(0))
+}
+
+func TestBatchingAsync(t *testing.T) {
+	server := krpctest.NewServer(t)
+	server.HandleDefault(func(call *types.ProcedureCall) ([]byte, error) {
+		return []byte(call.Procedure), nil
+	})
+	client := connect(t, server, KRPCClientConfig{
+		RPCOnly: true,
+		Batch:   &BatchConfig{Window: 50 * time.Millisecond},
+	})
+
+	var futures []*Future[*types.ProcedureResult]
+	for i := 0; i < 5; i++ {
+		futures = append(futures, client.CallAsync(&types.ProcedureCall{Service: "Test", Procedure: fmt.Sprint(i)}))
+	}
+	for i, f := range futures {
+		result, err := f.Wait(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, fmt.Sprint(i), string(result.Value))
+	}
+	require.Equal(t, uint64(1), client.BatchStats().Batches)
+}
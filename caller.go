@@ -0,0 +1,37 @@
+package krpcgo
+
+import (
+	"context"
+
+	"github.com/atburke/krpc-go/types"
+)
+
+// Caller makes procedure calls to a kRPC server. *KRPCClient is a Caller.
+// Generated services make all their calls through a Caller, so other
+// implementations can route calls elsewhere, such as to a fake, a recorder, a
+// pool of clients or a proxy. Implementations can use RunAsync and NewStream
+// to create futures and streams.
+type Caller interface {
+	// Call performs a remote procedure call.
+	Call(call *types.ProcedureCall) (*types.ProcedureResult, error)
+	// CallContext performs a remote procedure call, giving up when ctx ends.
+	CallContext(ctx context.Context, call *types.ProcedureCall) (*types.ProcedureResult, error)
+	// CallMultiple performs a batch of procedure calls.
+	CallMultiple(calls []*types.ProcedureCall) ([]*types.ProcedureResult, error)
+	// CallMultipleContext performs a batch of procedure calls, giving up
+	// when ctx ends.
+	CallMultipleContext(ctx context.Context, calls []*types.ProcedureCall) ([]*types.ProcedureResult, error)
+	// CallAsync performs a remote procedure call without waiting for the
+	// result.
+	CallAsync(call *types.ProcedureCall) *Future[*types.ProcedureResult]
+	// AddStream adds a stream for a procedure call and returns a byte stream
+	// of its results, buffered according to opts. Closing the stream removes
+	// it.
+	AddStream(call *types.ProcedureCall, opts ...StreamOption) (*Stream[[]byte], error)
+	// OpenStream returns a byte stream for a stream that was added by
+	// another procedure, such as KRPC.AddEvent. Closing the stream removes
+	// it.
+	OpenStream(id uint64, opts ...StreamOption) (*Stream[[]byte], error)
+}
+
+var _ Caller = (*KRPCClient)(nil)
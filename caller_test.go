@@ -0,0 +1,115 @@
+package krpcgo_test
+
+import (
+	"context"
+	"testing"
+
+	krpcgo "github.com/atburke/krpc-go"
+	"github.com/atburke/krpc-go/krpc"
+	"github.com/atburke/krpc-go/lib/encode"
+	"github.com/atburke/krpc-go/types"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCaller answers every call with the same value, and streams values sent
+// to its channel.
+type fakeCaller struct {
+	value   []byte
+	updates chan []byte
+	calls   []*types.ProcedureCall
+}
+
+func (c *fakeCaller) Call(call *types.ProcedureCall) (*types.ProcedureResult, error) {
+	return c.CallContext(context.Background(), call)
+}
+
+func (c *fakeCaller) CallContext(ctx context.Context, call *types.ProcedureCall) (*types.ProcedureResult, error) {
+	c.calls = append(c.calls, call)
+	return &types.ProcedureResult{Value: c.value}, nil
+}
+
+func (c *fakeCaller) CallMultiple(calls []*types.ProcedureCall) ([]*types.ProcedureResult, error) {
+	return c.CallMultipleContext(context.Background(), calls)
+}
+
+func (c *fakeCaller) CallMultipleContext(ctx context.Context, calls []*types.ProcedureCall) ([]*types.ProcedureResult, error) {
+	var results []*types.ProcedureResult
+	for _, call := range calls {
+		result, _ := c.CallContext(ctx, call)
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func (c *fakeCaller) CallAsync(call *types.ProcedureCall) *krpcgo.Future[*types.ProcedureResult] {
+	return krpcgo.RunAsync(func() (*types.ProcedureResult, error) {
+		return c.Call(call)
+	})
+}
+
+func (c *fakeCaller) AddStream(call *types.ProcedureCall, opts ...krpcgo.StreamOption) (*krpcgo.Stream[[]byte], error) {
+	c.calls = append(c.calls, call)
+	return krpcgo.NewStream(1, c.updates), nil
+}
+
+func (c *fakeCaller) OpenStream(id uint64, opts ...krpcgo.StreamOption) (*krpcgo.Stream[[]byte], error) {
+	return krpcgo.NewStream(id, c.updates), nil
+}
+
+func TestCaller(t *testing.T) {
+	value, err := encode.Marshal(true)
+	require.NoError(t, err)
+	caller := &fakeCaller{value: value, updates: make(chan []byte)}
+	k := krpc.New(caller)
+
+	paused, err := k.Paused()
+	require.NoError(t, err)
+	require.True(t, paused)
+
+	future, err := k.PausedAsync()
+	require.NoError(t, err)
+	paused, err = future.Wait(context.Background())
+	require.NoError(t, err)
+	require.True(t, paused)
+
+	stream, err := k.PausedStream()
+	require.NoError(t, err)
+	defer stream.Close()
+	go func() { caller.updates <- value }()
+	require.True(t, <-stream.C)
+
+	// Payloads that can't be decoded end the stream.
+	go func() { caller.updates <- []byte{0xff} }()
+	for range stream.C {
+	}
+	require.Error(t, stream.Err())
+
+	require.Len(t, caller.calls, 3)
+	for _, call := range caller.calls {
+		require.Equal(t, "get_Paused", call.Procedure)
+	}
+}
+
+func TestCallBuilders(t *testing.T) {
+	value, err := encode.Marshal(true)
+	require.NoError(t, err)
+	caller := &fakeCaller{value: value}
+	k := krpc.New(caller)
+
+	call, err := k.PausedCall()
+	require.NoError(t, err)
+	require.Equal(t, "get_Paused", call.Procedure)
+	require.Empty(t, caller.calls)
+
+	results, err := caller.CallMultiple([]*types.ProcedureCall{call})
+	require.NoError(t, err)
+	paused, err := k.DecodePaused(results[0])
+	require.NoError(t, err)
+	require.True(t, paused)
+
+	serverErr := &types.Error{Service: "KRPC", Name: "InvalidOperationException"}
+	_, err = k.DecodePaused(&types.ProcedureResult{Error: serverErr})
+	var invalidOp *krpc.ErrInvalidOperation
+	require.ErrorAs(t, err, &invalidOp)
+	require.ErrorAs(t, err, &krpc.ErrInvalidOperation{})
+}
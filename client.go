@@ -2,9 +2,13 @@ package krpcgo
 
 import (
 	"context"
+	"errors"
 	"io"
 	"net"
+	"os"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/atburke/krpc-go/types"
 	"github.com/golang/protobuf/proto"
@@ -13,11 +17,37 @@ import (
 
 // KRPCClient is a client for a kRPC server.
 type KRPCClient struct {
-	mu sync.Mutex
+	// mu is held while a request is in flight. It's a channel rather than a
+	// sync.Mutex so that callers can stop waiting when their context ends.
+	mu chan struct{}
 	KRPCClientConfig
-	conn net.Conn
+	// connMu is held while the connections are replaced or closed.
+	connMu sync.Mutex
+	conn   messageConn
+	// serial is the connection to the server with ProtocolSerialIO.
+	serial *serialConn
+	// pipeline tracks requests awaiting responses when pipelined.
+	pipeline *pipeline
+	// batcher coalesces calls when batching is enabled.
+	batcher *batcher
+	// invoker sends calls through the interceptors.
+	invoker Invoker
+	// metrics are the metrics for the calls sent to the server.
+	metrics callMetrics
+	// status is the server status being fetched for the metrics, if any.
+	statusMu sync.Mutex
+	status   *Future[*types.Status]
 	*StreamClient
 	clientIdentifier [16]byte
+	// ctx is the context the client was connected with. It bounds the
+	// lifetime of the stream handler and of any reconnection attempts.
+	ctx context.Context
+	// closed is closed when the client is closed.
+	closed chan struct{}
+	// reconnectErr is set once reconnection has been given up on.
+	reconnectErr error
+	// reconnecting is set while the connections are being re-established.
+	reconnecting atomic.Bool
 }
 
 // KRPCClientConfig is the config for a kRPC client.
@@ -33,6 +63,36 @@ type KRPCClientConfig struct {
 	// RPCOnly will only set up the RPC client (and not the stream client) when enabled.
 	// Disabled by default.
 	RPCOnly bool
+	// Reconnect enables automatic reconnection when the connection to the
+	// server is lost. Disabled (nil) by default.
+	Reconnect *ReconnectPolicy
+	// Pipelined sends requests without waiting for the responses to earlier
+	// requests, so that concurrent calls don't wait on each other's round
+	// trips. Disabled by default.
+	Pipelined bool
+	// Batch coalesces single calls made around the same time into one
+	// request. Disabled (nil) by default.
+	Batch *BatchConfig
+	// SkipVersionCheck connects to servers of any version, instead of only
+	// MinServerVersion up to MaxServerVersion.
+	SkipVersionCheck bool
+	// Recorder records every request, response and stream update, such as
+	// to replay them later. Disabled (nil) by default.
+	Recorder Recorder
+	// Dialer opens the connections to the servers, given the "tcp" network
+	// and the host and port of the server. Defaults to a *net.Dialer.
+	Dialer Dialer
+	// Protocol is the protocol the server is configured with. Defaults to
+	// ProtocolTCP.
+	Protocol Protocol
+	// SerialPort is the path of the serial port (or pty) to connect to with
+	// ProtocolSerialIO. Host, RPCPort, StreamPort and Dialer aren't used with
+	// ProtocolSerialIO.
+	SerialPort string
+	// Interceptors intercept every batch of calls, in order, the first one
+	// being the outermost. Calls the client makes itself while connecting
+	// aren't intercepted.
+	Interceptors []Interceptor
 }
 
 // SetDefaults sets the config defaults.
@@ -49,14 +109,34 @@ func (cfg *KRPCClientConfig) SetDefaults() {
 	if cfg.ClientName == "" {
 		cfg.ClientName = "krpc-go"
 	}
+	if cfg.Dialer == nil {
+		cfg.Dialer = &net.Dialer{}
+	}
+	if cfg.Reconnect != nil {
+		policy := *cfg.Reconnect
+		policy.SetDefaults()
+		cfg.Reconnect = &policy
+	}
+	if cfg.Batch != nil {
+		batch := *cfg.Batch
+		batch.SetDefaults()
+		cfg.Batch = &batch
+	}
 }
 
 // NewKRPCClient creates a new client.
 func NewKRPCClient(cfg KRPCClientConfig) *KRPCClient {
 	cfg.SetDefaults()
-	return &KRPCClient{
+	c := &KRPCClient{
 		KRPCClientConfig: cfg,
+		mu:               make(chan struct{}, 1),
+		closed:           make(chan struct{}),
+	}
+	c.invoker = chainInterceptors(cfg.Interceptors, c.callMultiple)
+	if cfg.Batch != nil {
+		c.batcher = newBatcher(*cfg.Batch, c.CallMultipleContext)
 	}
+	return c
 }
 
 // DefaultKRPCClient creates a new kRPC client with all default parameters.
@@ -65,93 +145,112 @@ func DefaultKRPCClient() *KRPCClient {
 	return NewKRPCClient(KRPCClientConfig{})
 }
 
-// Connect connects to a kRPC server.
+// Connect connects to a kRPC server. If connecting to the stream server
+// fails, the RPC connection is closed again.
 func (c *KRPCClient) Connect(ctx context.Context) error {
+	c.ctx = ctx
 	if err := c.connectRPC(); err != nil {
 		return tracerr.Wrap(err)
 	}
 	if !c.RPCOnly {
 		if err := c.connectStream(ctx); err != nil {
+			c.conn.Close()
 			return tracerr.Wrap(err)
 		}
 	}
+	c.startPipeline()
 	return nil
 }
 
-// connectRPC performs the kRPC connection handshake with the RPC server.
+// connectRPC connects to the RPC server.
 func (c *KRPCClient) connectRPC() error {
-	conn, err := net.Dial("tcp", net.JoinHostPort(c.Host, c.RPCPort))
-	if err != nil {
-		return tracerr.Wrap(err)
+	if c.Protocol == ProtocolSerialIO {
+		return tracerr.Wrap(c.connectSerial())
 	}
-	c.conn = conn
-
-	request := types.ConnectionRequest{
-		Type:       types.ConnectionRequest_RPC,
-		ClientName: c.ClientName,
-	}
-	out, err := proto.Marshal(&request)
+	conn, err := c.dial(c.ctx, c.RPCPort)
 	if err != nil {
 		return tracerr.Wrap(err)
 	}
-	if err := c.Send(out); err != nil {
-		return tracerr.Wrap(err)
+	return tracerr.Wrap(c.handshakeRPC(conn))
+}
+
+// handshakeRPC performs the kRPC connection handshake with the RPC server and
+// checks the server's version. The connection is closed if either fails.
+func (c *KRPCClient) handshakeRPC(conn net.Conn) error {
+	err := c.openRPC(conn)
+	if err == nil && !c.SkipVersionCheck {
+		err = c.checkVersion(c.ctx)
 	}
-	in, err := c.Receive()
 	if err != nil {
+		conn.Close()
 		return tracerr.Wrap(err)
 	}
-
-	var resp types.ConnectionResponse
-	if err := proto.Unmarshal(in, &resp); err != nil {
-		return tracerr.Wrap(err)
-	}
-	if resp.Status != types.ConnectionResponse_OK {
-		return tracerr.Errorf(resp.Message)
-	}
-
-	copy(c.clientIdentifier[:], resp.ClientIdentifier)
 	return nil
 }
 
-// connectStream creates a new stream from a kRPC client.
-func (c *KRPCClient) connectStream(ctx context.Context) error {
-	conn, err := net.Dial("tcp", net.JoinHostPort(c.Host, c.StreamPort))
-	if err != nil {
-		tracerr.Wrap(err)
+// startPipeline starts reading responses from the RPC server if the client
+// is pipelined.
+func (c *KRPCClient) startPipeline() {
+	if c.Pipelined {
+		c.pipeline = &pipeline{}
+		go c.readResponses(c.conn, c.pipeline)
 	}
+}
 
-	request := types.ConnectionRequest{
-		Type:             types.ConnectionRequest_STREAM,
-		ClientIdentifier: c.clientIdentifier[:],
+// connectStream connects to the stream server.
+func (c *KRPCClient) connectStream(ctx context.Context) error {
+	if c.Protocol == ProtocolSerialIO {
+		// Stream updates arrive over the RPC connection.
+		c.useStreamConn(ctx, c.serial.streamConn())
+		return nil
 	}
-	out, err := proto.Marshal(&request)
+	conn, err := c.dial(ctx, c.StreamPort)
 	if err != nil {
-		tracerr.Wrap(err)
-	}
-	if err := send(conn, out); err != nil {
-		tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	in, err := receive(conn)
+	return tracerr.Wrap(c.handshakeStream(ctx, conn))
+}
+
+// handshakeStream performs the kRPC connection handshake with the stream
+// server and starts receiving stream updates. The connection is closed if the
+// handshake fails.
+func (c *KRPCClient) handshakeStream(ctx context.Context, conn net.Conn) error {
+	mc, err := c.openStream(conn)
 	if err != nil {
-		tracerr.Wrap(err)
+		conn.Close()
+		return tracerr.Wrap(err)
 	}
+	c.useStreamConn(ctx, mc)
+	return nil
+}
 
-	var resp types.ConnectionResponse
-	if err := proto.Unmarshal(in, &resp); err != nil {
-		tracerr.Wrap(err)
+// useStreamConn starts receiving stream updates from a connection.
+func (c *KRPCClient) useStreamConn(ctx context.Context, mc messageConn) {
+	// When reconnecting, keep the existing stream client so that its streams
+	// carry on with the new connection.
+	if c.StreamClient != nil {
+		c.StreamClient.setConn(mc)
+		return
 	}
-	if resp.Status != types.ConnectionResponse_OK {
-		tracerr.Errorf(resp.Message)
+	c.StreamClient = newStreamClient(mc)
+	c.StreamClient.recorder = c.Recorder
+	if c.Reconnect != nil {
+		c.StreamClient.connectionLost = c.streamConnectionLost
 	}
-
-	c.StreamClient = NewStreamClient(conn)
 	go c.StreamClient.Run(ctx)
-	return nil
 }
 
 // Close closes the client.
 func (c *KRPCClient) Close() error {
+	select {
+	case <-c.closed:
+	default:
+		close(c.closed)
+	}
+	// Wait for any reconnection attempt, which stops once it sees that the
+	// client is closed, and close the connections it made.
+	c.connMu.Lock()
+	defer c.connMu.Unlock()
 	var errors []error
 	if c.StreamClient != nil {
 		errors = append(errors, c.StreamClient.Close())
@@ -187,12 +286,12 @@ func receive(r io.Reader) ([]byte, error) {
 
 // Send sends protobuf-encoded data to a kRPC server.
 func (c *KRPCClient) Send(data []byte) error {
-	return tracerr.Wrap(send(c.conn, data))
+	return tracerr.Wrap(c.conn.WriteMessage(data))
 }
 
 // Receive receives protobuf-encoded data from a kRPC server.
 func (c *KRPCClient) Receive() ([]byte, error) {
-	data, err := receive(c.conn)
+	data, err := c.conn.ReadMessage()
 	return data, tracerr.Wrap(err)
 }
 
@@ -217,6 +316,33 @@ func readMessageLength(r io.Reader) (uint64, error) {
 
 // CallMultiple performs a batch of procedure calls to the rpc server.
 func (c *KRPCClient) CallMultiple(calls []*types.ProcedureCall) ([]*types.ProcedureResult, error) {
+	results, err := c.CallMultipleContext(context.Background(), calls)
+	return results, tracerr.Wrap(err)
+}
+
+// CallMultipleContext performs a batch of procedure calls to the rpc server.
+// If ctx has a deadline, it is applied to the connection for the duration of
+// the call. If ctx ends while waiting for the server to respond, the RPC
+// connection is closed, since the late response would otherwise be read as
+// the response to the next request. Pipelined clients instead leave the
+// connection open and discard the response when it arrives. The calls go
+// through the configured interceptors.
+func (c *KRPCClient) CallMultipleContext(ctx context.Context, calls []*types.ProcedureCall) ([]*types.ProcedureResult, error) {
+	results, err := c.invoker(ctx, calls)
+	return results, tracerr.Wrap(err)
+}
+
+// callMultiple sends a batch of procedure calls to the rpc server and records
+// metrics for them.
+func (c *KRPCClient) callMultiple(ctx context.Context, calls []*types.ProcedureCall) ([]*types.ProcedureResult, error) {
+	start := time.Now()
+	results, err := c.sendCalls(ctx, calls)
+	c.metrics.observe(calls, results, err, time.Since(start))
+	return results, tracerr.Wrap(err)
+}
+
+// sendCalls sends a batch of procedure calls to the rpc server.
+func (c *KRPCClient) sendCalls(ctx context.Context, calls []*types.ProcedureCall) ([]*types.ProcedureResult, error) {
 	req := &types.Request{
 		Calls: calls,
 	}
@@ -225,38 +351,290 @@ func (c *KRPCClient) CallMultiple(calls []*types.ProcedureCall) ([]*types.Proced
 		return nil, tracerr.Wrap(err)
 	}
 
+	// A call that's already been given up on isn't sent, and mustn't close
+	// the connection like a call abandoned while waiting for its response.
+	if err := ctx.Err(); err != nil {
+		return nil, tracerr.Wrap(err)
+	}
 	// Lock here to prevent RPC requests from intermingling.
-	c.mu.Lock()
-	if err := c.Send(out); err != nil {
-		c.mu.Unlock()
+	select {
+	case c.mu <- struct{}{}:
+	case <-ctx.Done():
+		return nil, tracerr.Wrap(ctx.Err())
+	}
+	if c.Pipelined {
+		response := c.sendPipelined(out)
+		<-c.mu
+		in, err := response.Wait(ctx)
+		if err != nil {
+			return nil, tracerr.Wrap(err)
+		}
+		return decodeResponse(in)
+	}
+	in, err := c.roundTrip(ctx, out)
+	if err != nil && c.shouldReconnect() {
+		// The failed call isn't retried, since it may not be safe to repeat.
+		// Reconnection takes over the lock, so later calls wait for it,
+		// unless streams are being added or removed, which reconnection must
+		// wait for first.
+		if unlockStreams, ok := c.tryLockStreams(); ok {
+			go c.reconnect(unlockStreams)
+		} else {
+			conn := c.conn
+			<-c.mu
+			go c.connectionLost(func() bool { return c.conn == conn })
+		}
+	} else {
+		<-c.mu
+	}
+
+	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	in, err := c.Receive()
-	c.mu.Unlock()
+	return decodeResponse(in)
+}
 
+// callLocked performs a batch of procedure calls. Callers must hold the lock.
+func (c *KRPCClient) callLocked(ctx context.Context, calls []*types.ProcedureCall) ([]*types.ProcedureResult, error) {
+	out, err := proto.Marshal(&types.Request{Calls: calls})
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	var in []byte
+	if c.Pipelined {
+		in, err = c.sendPipelined(out).Wait(ctx)
+	} else {
+		in, err = c.roundTrip(ctx, out)
+	}
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
+	return decodeResponse(in)
+}
+
+// decodeResponse decodes the results of a response from the RPC server.
+func decodeResponse(in []byte) ([]*types.ProcedureResult, error) {
 	var resp types.Response
 	if err := proto.Unmarshal(in, &resp); err != nil {
 		return nil, tracerr.Wrap(err)
 	}
 
 	if resp.Error != nil {
-		return nil, tracerr.Wrap(resp.Error)
+		return nil, tracerr.Wrap(translateError(resp.Error))
 	}
 	return resp.Results, nil
 }
 
+// roundTrip sends a request to the RPC server and waits for the response,
+// giving up when ctx ends. Callers must hold the lock.
+func (c *KRPCClient) roundTrip(ctx context.Context, out []byte) ([]byte, error) {
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := c.conn.SetDeadline(deadline); err != nil {
+			return nil, tracerr.Wrap(err)
+		}
+	}
+
+	// Interrupt any pending read or write if ctx is cancelled.
+	stop := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		select {
+		case <-ctx.Done():
+			c.conn.SetDeadline(time.Now())
+		case <-stop:
+		}
+	}()
+
+	var in []byte
+	err := c.Send(out)
+	if err == nil {
+		c.recordRequest(out)
+		in, err = c.Receive()
+	}
+	if err == nil {
+		c.recordResponse(in)
+	}
+	close(stop)
+	<-stopped
+
+	if ctxErr := ctx.Err(); err != nil && (ctxErr != nil || errors.Is(err, os.ErrDeadlineExceeded)) {
+		// The connection is now out of sync with the server, so it can't be
+		// used for further requests.
+		c.conn.Close()
+		if ctxErr == nil {
+			ctxErr = context.DeadlineExceeded
+		}
+		return nil, tracerr.Wrap(ctxErr)
+	}
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	if err := c.conn.SetDeadline(time.Time{}); err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	return in, nil
+}
+
 // Call performs a remote procedure call.
 func (c *KRPCClient) Call(call *types.ProcedureCall) (*types.ProcedureResult, error) {
-	resp, err := c.CallMultiple([]*types.ProcedureCall{call})
+	result, err := c.CallContext(context.Background(), call)
+	return result, tracerr.Wrap(err)
+}
+
+// CallContext performs a remote procedure call. See CallMultipleContext for
+// how ctx is handled. If batching is enabled, the call is sent with other
+// calls made around the same time, and ctx only bounds how long to wait for
+// the result.
+func (c *KRPCClient) CallContext(ctx context.Context, call *types.ProcedureCall) (*types.ProcedureResult, error) {
+	if c.batcher != nil {
+		result, err := c.batcher.add(call).Wait(ctx)
+		return result, tracerr.Wrap(err)
+	}
+	resp, err := c.CallMultipleContext(ctx, []*types.ProcedureCall{call})
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	r := resp[0]
-	if r.Error != nil {
-		return nil, tracerr.Wrap(r.Error)
+	return firstResult(resp)
+}
+
+// firstResult gets the result of a single call.
+func firstResult(results []*types.ProcedureResult) (*types.ProcedureResult, error) {
+	r := results[0]
+	if err := ResultError(r); err != nil {
+		return nil, tracerr.Wrap(err)
 	}
 	return r, nil
 }
+
+// AddStream adds a stream for a procedure call to the server and returns a
+// byte stream of its results. The server shares one stream between identical
+// procedure calls, so it's only removed from the server once every stream for
+// the call, including clones and mapped streams, has been closed. If the
+// client reconnects, the stream is added to the server again and keeps
+// receiving results. Options set how results are buffered until they're
+// received, the rate of updates, and whether the stream starts straight away.
+func (c *KRPCClient) AddStream(call *types.ProcedureCall, opts ...StreamOption) (*Stream[[]byte], error) {
+	if c.StreamClient == nil {
+		return nil, tracerr.Errorf("Streams are not available on an RPC-only client")
+	}
+	cfg := newStreamConfig(opts)
+	c.refMu.Lock()
+	defer c.refMu.Unlock()
+	addCall, err := addStreamCall(call, !cfg.Unstarted)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	result, err := c.Call(addCall)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	var st types.Stream
+	if err := proto.Unmarshal(result.Value, &st); err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+
+	sm := c.getStreamManager(st.Id)
+	sm.setCall(call)
+	if !cfg.Unstarted {
+		sm.setStarted()
+	}
+	if cfg.Rate != 0 {
+		if _, err := c.Call(setStreamRateCall(st.Id, cfg.Rate)); err != nil {
+			if sm.numRefs() == 0 {
+				c.Call(removeStreamCall(st.Id))
+			}
+			return nil, tracerr.Wrap(err)
+		}
+		sm.setRate(cfg.Rate)
+	}
+	return c.newAddedStream(sm, opts), nil
+}
+
+// OpenStream returns a byte stream for a stream that was added to the server
+// by another procedure, such as KRPC.AddEvent. Like streams from AddStream,
+// it's removed from the server once it and every other stream for it have
+// been closed. It isn't added again if the client reconnects, since the
+// procedure that added it isn't known, and ends with ErrStreamLost instead.
+func (c *KRPCClient) OpenStream(id uint64, opts ...StreamOption) (*Stream[[]byte], error) {
+	if c.StreamClient == nil {
+		return nil, tracerr.Errorf("Streams are not available on an RPC-only client")
+	}
+	c.refMu.Lock()
+	defer c.refMu.Unlock()
+	return c.newAddedStream(c.getStreamManager(id), opts), nil
+}
+
+// newAddedStream creates a stream that holds a reference to a stream added to
+// the server, as do its clones. Closing the stream releases the reference.
+// Callers must hold refMu.
+func (c *KRPCClient) newAddedStream(sm *streamManager, opts []StreamOption) *Stream[[]byte] {
+	sm.acquire()
+	stream := sm.newStream(opts...)
+	stream.start = []*streamStart{{
+		caller: c,
+		call: func() *types.ProcedureCall {
+			return startStreamCall(sm.getID())
+		},
+		started: sm.setStarted,
+	}}
+	stream.clone = func() *Stream[[]byte] {
+		c.refMu.Lock()
+		defer c.refMu.Unlock()
+		return c.newAddedStream(sm, opts)
+	}
+	var releaseOnce sync.Once
+	stream.AddCloser(func() error {
+		var err error
+		releaseOnce.Do(func() {
+			err = c.releaseStream(sm)
+		})
+		return tracerr.Wrap(err)
+	})
+	return stream
+}
+
+// releaseStream releases a reference to a stream added to the server, and
+// removes the stream from the server once there are none left.
+func (c *KRPCClient) releaseStream(sm *streamManager) error {
+	c.refMu.Lock()
+	defer c.refMu.Unlock()
+	// Lost streams no longer exist on the server, and their ID may belong to
+	// another stream.
+	if sm.release() > 0 || sm.isLost() {
+		return nil
+	}
+	_, err := c.Call(removeStreamCall(sm.getID()))
+	return tracerr.Wrap(err)
+}
+
+// addStreamCall creates a call to KRPC.AddStream for a procedure call.
+func addStreamCall(call *types.ProcedureCall, start bool) (*types.ProcedureCall, error) {
+	callBytes, err := proto.Marshal(call)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	var startValue uint64
+	if start {
+		startValue = 1
+	}
+	return &types.ProcedureCall{
+		Service:   "KRPC",
+		Procedure: "AddStream",
+		Arguments: []*types.Argument{
+			{Position: 0, Value: callBytes},
+			{Position: 1, Value: proto.EncodeVarint(startValue)},
+		},
+	}, nil
+}
+
+// removeStreamCall creates a call to KRPC.RemoveStream for a stream ID.
+func removeStreamCall(id uint64) *types.ProcedureCall {
+	return &types.ProcedureCall{
+		Service:   "KRPC",
+		Procedure: "RemoveStream",
+		Arguments: []*types.Argument{
+			{Position: 0, Value: proto.EncodeVarint(id)},
+		},
+	}
+}
@@ -2,8 +2,13 @@ package krpcgo
 
 import (
 	"bytes"
+	"context"
+	"net"
 	"testing"
+	"time"
 
+	"github.com/atburke/krpc-go/krpctest"
+	"github.com/atburke/krpc-go/types"
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/require"
 )
@@ -20,3 +25,89 @@ func FuzzReadMessageLength(f *testing.F) {
 		require.Equal(t, i, l)
 	})
 }
+
+func TestCallContext(t *testing.T) {
+	server := krpctest.NewServer(t)
+	server.HandleDefault(func(call *types.ProcedureCall) ([]byte, error) {
+		if call.Procedure == "Hang" {
+			return nil, krpctest.ErrNoResponse
+		}
+		return []byte(call.Procedure), nil
+	})
+	client := connect(t, server, KRPCClientConfig{RPCOnly: true})
+
+	result, err := client.CallContext(context.Background(), &types.ProcedureCall{Service: "Test", Procedure: "Echo"})
+	require.NoError(t, err)
+	require.Equal(t, "Echo", string(result.Value))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = client.CallContext(ctx, &types.ProcedureCall{Service: "Test", Procedure: "Hang"})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// The connection can't be trusted after a call is abandoned.
+	_, err = client.Call(&types.ProcedureCall{Service: "Test", Procedure: "Echo"})
+	require.ErrorIs(t, err, net.ErrClosed)
+}
+
+func TestCallContextCancelWhileWaiting(t *testing.T) {
+	server := krpctest.NewServer(t)
+	client := connect(t, server, KRPCClientConfig{RPCOnly: true})
+
+	// Hold the lock, as if another call were in flight.
+	client.mu <- struct{}{}
+	ctx, cancel := context.WithCancel(context.Background())
+	errC := make(chan error)
+	go func() {
+		_, err := client.CallContext(ctx, &types.ProcedureCall{Service: "Test", Procedure: "Hang"})
+		errC <- err
+	}()
+	cancel()
+	select {
+	case err := <-errC:
+		require.ErrorIs(t, err, context.Canceled)
+	case <-time.After(time.Second):
+		require.Fail(t, "call did not return after cancel")
+	}
+}
+
+func TestCallContextAlreadyCanceled(t *testing.T) {
+	server := krpctest.NewServer(t)
+	server.HandleDefault(func(call *types.ProcedureCall) ([]byte, error) {
+		return []byte(call.Procedure), nil
+	})
+	client := connect(t, server, KRPCClientConfig{RPCOnly: true})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for i := 0; i < 10; i++ {
+		_, err := client.CallContext(ctx, &types.ProcedureCall{Service: "Test", Procedure: "Echo"})
+		require.ErrorIs(t, err, context.Canceled)
+	}
+	server.RequireNotCalled(t, "Test", "Echo")
+
+	// The connection is still usable.
+	result, err := client.Call(&types.ProcedureCall{Service: "Test", Procedure: "Echo"})
+	require.NoError(t, err)
+	require.Equal(t, "Echo", string(result.Value))
+}
+
+// connect connects a client to a test server.
+func connect(t *testing.T, server *krpctest.Server, cfg KRPCClientConfig) *KRPCClient {
+	client := NewKRPCClient(serverConfig(server, cfg))
+	ctx, cancel := context.WithCancel(context.Background())
+	require.NoError(t, client.Connect(ctx))
+	t.Cleanup(func() {
+		client.Close()
+		cancel()
+	})
+	return client
+}
+
+// serverConfig sets the address of a test server in a client config.
+func serverConfig(server *krpctest.Server, cfg KRPCClientConfig) KRPCClientConfig {
+	cfg.Host = server.Host()
+	cfg.RPCPort = server.RPCPort()
+	cfg.StreamPort = server.StreamPort()
+	return cfg
+}
@@ -0,0 +1,336 @@
+package krpcgo
+
+import (
+	"time"
+
+	"github.com/ztrue/tracerr"
+)
+
+// streamSource is a stream that another stream is derived from.
+type streamSource interface {
+	Close() error
+	Dropped() uint64
+	config() StreamConfig
+	startInfo() []*streamStart
+}
+
+// deriveStream creates a stream derived from sources, along with a function
+// that publishes its values. Closing the stream closes the sources. It
+// buffers values and handles overflow the same way as the first source, so
+// that an unread stream doesn't hold up its sources unless they block.
+func deriveStream[T any](id uint64, clone func() *Stream[T], sources ...streamSource) (*Stream[T], func(T)) {
+	return deriveStreamWith(sources[0].config(), id, clone, sources...)
+}
+
+// deriveStreamWith creates a stream derived from sources like deriveStream,
+// which buffers values and handles overflow according to cfg.
+func deriveStreamWith[T any](cfg StreamConfig, id uint64, clone func() *Stream[T], sources ...streamSource) (*Stream[T], func(T)) {
+	dst := newStream(id, make(chan T, cfg.BufferSize), clone)
+	dst.overflow = cfg.Overflow
+	latest := newLatestValue[T]()
+	dst.latest, dst.ready, dst.updated = latest.get, latest.ready, latest.updated
+	dst.sourceDropped = func() uint64 {
+		var dropped uint64
+		for _, src := range sources {
+			dropped += src.Dropped()
+		}
+		return dropped
+	}
+	for _, src := range sources {
+		dst.start = append(dst.start, src.startInfo()...)
+	}
+	dst.AddCloser(func() error {
+		var err error
+		for _, src := range sources {
+			if closeErr := src.Close(); closeErr != nil && err == nil {
+				err = closeErr
+			}
+		}
+		return tracerr.Wrap(err)
+	})
+
+	publish := func(value T) {
+		latest.set(value)
+		dst.send(value)
+	}
+	return dst, publish
+}
+
+// Filter creates a stream of the values of src that keep returns true for.
+func Filter[T any](src *Stream[T], keep func(T) bool) *Stream[T] {
+	dst, publish := deriveStream(src.ID, func() *Stream[T] {
+		return Filter(src.Clone(), keep)
+	}, src)
+
+	go func() {
+		defer close(dst.C)
+		for {
+			select {
+			case value, ok := <-src.C:
+				if !ok {
+					dst.end(src.Err())
+					return
+				}
+				if keep(value) {
+					publish(value)
+				}
+			case <-dst.done:
+				return
+			}
+		}
+	}()
+	return dst
+}
+
+// CombineLatest creates a stream that combines the latest values of two
+// streams whenever either of them updates, once both have a value. The new
+// stream has no ID, and ends as soon as either stream ends.
+func CombineLatest[A, B, T any](a *Stream[A], b *Stream[B], combine func(A, B) T) *Stream[T] {
+	dst, publish := deriveStream(0, func() *Stream[T] {
+		return CombineLatest(a.Clone(), b.Clone(), combine)
+	}, a, b)
+
+	go func() {
+		defer close(dst.C)
+		var valueA A
+		var valueB B
+		var hasA, hasB bool
+		for {
+			select {
+			case value, ok := <-a.C:
+				if !ok {
+					dst.end(a.Err())
+					return
+				}
+				valueA, hasA = value, true
+			case value, ok := <-b.C:
+				if !ok {
+					dst.end(b.Err())
+					return
+				}
+				valueB, hasB = value, true
+			case <-dst.done:
+				return
+			}
+			if hasA && hasB {
+				publish(combine(valueA, valueB))
+			}
+		}
+	}()
+	return dst
+}
+
+// Zip creates a stream that combines the values of two streams in pairs, in
+// the order they arrive. Values waiting to be paired are kept up to the
+// buffer size of a, or just the latest one if a is unbuffered; older ones are
+// dropped. The new stream has no ID, and ends as soon as either stream ends.
+func Zip[A, B, T any](a *Stream[A], b *Stream[B], combine func(A, B) T) *Stream[T] {
+	dst, publish := deriveStream(0, func() *Stream[T] {
+		return Zip(a.Clone(), b.Clone(), combine)
+	}, a, b)
+	limit := cap(a.C)
+	if limit < 1 {
+		limit = 1
+	}
+
+	go func() {
+		defer close(dst.C)
+		var pendingA []A
+		var pendingB []B
+		for {
+			select {
+			case value, ok := <-a.C:
+				if !ok {
+					dst.end(a.Err())
+					return
+				}
+				if len(pendingA) == limit {
+					pendingA = pendingA[1:]
+					dst.dropped.Add(1)
+				}
+				pendingA = append(pendingA, value)
+			case value, ok := <-b.C:
+				if !ok {
+					dst.end(b.Err())
+					return
+				}
+				if len(pendingB) == limit {
+					pendingB = pendingB[1:]
+					dst.dropped.Add(1)
+				}
+				pendingB = append(pendingB, value)
+			case <-dst.done:
+				return
+			}
+			for len(pendingA) > 0 && len(pendingB) > 0 {
+				publish(combine(pendingA[0], pendingB[0]))
+				pendingA, pendingB = pendingA[1:], pendingB[1:]
+			}
+		}
+	}()
+	return dst
+}
+
+// Throttle creates a stream that passes on at most one value of src per
+// interval. The first value is passed on straight away, and after that the
+// latest value received during each interval is passed on at its end.
+func Throttle[T any](src *Stream[T], interval time.Duration) *Stream[T] {
+	dst, publish := deriveStream(src.ID, func() *Stream[T] {
+		return Throttle(src.Clone(), interval)
+	}, src)
+
+	go func() {
+		defer close(dst.C)
+		// timer is running while values are being held back.
+		var timer *time.Timer
+		var timeout <-chan time.Time
+		defer func() {
+			if timer != nil {
+				timer.Stop()
+			}
+		}()
+		var pending T
+		var hasPending bool
+		for {
+			select {
+			case value, ok := <-src.C:
+				if !ok {
+					dst.end(src.Err())
+					return
+				}
+				if timeout != nil {
+					pending, hasPending = value, true
+					continue
+				}
+				publish(value)
+				timer = time.NewTimer(interval)
+				timeout = timer.C
+			case <-timeout:
+				timeout = nil
+				if hasPending {
+					publish(pending)
+					hasPending = false
+					timer = time.NewTimer(interval)
+					timeout = timer.C
+				}
+			case <-dst.done:
+				return
+			}
+		}
+	}()
+	return dst
+}
+
+// Debounce creates a stream that passes on a value of src once no other
+// value has arrived for the given time.
+func Debounce[T any](src *Stream[T], wait time.Duration) *Stream[T] {
+	dst, publish := deriveStream(src.ID, func() *Stream[T] {
+		return Debounce(src.Clone(), wait)
+	}, src)
+
+	go func() {
+		defer close(dst.C)
+		var timer *time.Timer
+		var timeout <-chan time.Time
+		defer func() {
+			if timer != nil {
+				timer.Stop()
+			}
+		}()
+		var pending T
+		for {
+			select {
+			case value, ok := <-src.C:
+				if !ok {
+					dst.end(src.Err())
+					return
+				}
+				pending = value
+				if timer != nil {
+					timer.Stop()
+				}
+				timer = time.NewTimer(wait)
+				timeout = timer.C
+			case <-timeout:
+				timeout = nil
+				publish(pending)
+			case <-dst.done:
+				return
+			}
+		}
+	}()
+	return dst
+}
+
+// SlidingWindow creates a stream of the last size values of src, oldest
+// first, starting once size values have arrived. Each window is a new slice.
+func SlidingWindow[T any](src *Stream[T], size int) *Stream[[]T] {
+	if size < 1 {
+		size = 1
+	}
+	dst, publish := deriveStream(src.ID, func() *Stream[[]T] {
+		return SlidingWindow(src.Clone(), size)
+	}, src)
+
+	go func() {
+		defer close(dst.C)
+		window := make([]T, 0, size)
+		for {
+			select {
+			case value, ok := <-src.C:
+				if !ok {
+					dst.end(src.Err())
+					return
+				}
+				if len(window) == size {
+					copy(window, window[1:])
+					window = window[:size-1]
+				}
+				window = append(window, value)
+				if len(window) == size {
+					publish(append([]T(nil), window...))
+				}
+			case <-dst.done:
+				return
+			}
+		}
+	}()
+	return dst
+}
+
+// Float is a floating point type.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Derivative creates a stream of the rate of change per second of the values
+// of src, from each pair of consecutive values and the times they were
+// received, starting from the second value.
+func Derivative[T Float](src *Stream[T]) *Stream[T] {
+	dst, publish := deriveStream(src.ID, func() *Stream[T] {
+		return Derivative(src.Clone())
+	}, src)
+
+	go func() {
+		defer close(dst.C)
+		var last T
+		var lastTime time.Time
+		for {
+			select {
+			case value, ok := <-src.C:
+				if !ok {
+					dst.end(src.Err())
+					return
+				}
+				now := time.Now()
+				if dt := now.Sub(lastTime).Seconds(); !lastTime.IsZero() && dt > 0 {
+					publish((value - last) / T(dt))
+				}
+				last, lastTime = value, now
+			case <-dst.done:
+				return
+			}
+		}
+	}()
+	return dst
+}
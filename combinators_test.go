@@ -0,0 +1,152 @@
+package krpcgo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// receiveValue receives a value from a stream, failing the test if none
+// arrives.
+func receiveValue[T any](t *testing.T, stream *Stream[T]) T {
+	t.Helper()
+	select {
+	case value, ok := <-stream.C:
+		require.True(t, ok, "Stream ended")
+		return value
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for value")
+	}
+	panic("unreachable")
+}
+
+func TestFilter(t *testing.T) {
+	c := make(chan int)
+	even := Filter(NewStream(1, c), func(v int) bool { return v%2 == 0 })
+	defer even.Close()
+	feed(c, 1, 2, 3, 4)
+	require.Equal(t, 2, receiveValue(t, even))
+	require.Equal(t, 4, receiveValue(t, even))
+}
+
+func TestCombineLatest(t *testing.T) {
+	numbers := make(chan int)
+	words := make(chan string)
+	combined := CombineLatest(NewStream(1, numbers), NewStream(2, words), func(n int, s string) string {
+		return s + string(rune('0'+n))
+	})
+	defer combined.Close()
+
+	numbers <- 1
+	words <- "a"
+	require.Equal(t, "a1", receiveValue(t, combined))
+	numbers <- 2
+	require.Equal(t, "a2", receiveValue(t, combined))
+	words <- "b"
+	require.Equal(t, "b2", receiveValue(t, combined))
+}
+
+func TestZip(t *testing.T) {
+	numbers := make(chan int)
+	words := make(chan string)
+	zipped := Zip(NewStream(1, numbers), NewStream(2, words), func(n int, s string) string {
+		return s + string(rune('0'+n))
+	})
+	defer zipped.Close()
+
+	numbers <- 1
+	words <- "a"
+	require.Equal(t, "a1", receiveValue(t, zipped))
+	words <- "b"
+	numbers <- 2
+	require.Equal(t, "b2", receiveValue(t, zipped))
+}
+
+func TestThrottle(t *testing.T) {
+	c := make(chan int)
+	throttled := Throttle(NewStream(1, c), 100*time.Millisecond)
+	defer throttled.Close()
+
+	start := time.Now()
+	c <- 1
+	require.Equal(t, 1, receiveValue(t, throttled))
+	c <- 2
+	c <- 3
+	require.Equal(t, 3, receiveValue(t, throttled))
+	require.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+}
+
+func TestDebounce(t *testing.T) {
+	c := make(chan int)
+	debounced := Debounce(NewStream(1, c), 50*time.Millisecond)
+	defer debounced.Close()
+
+	c <- 1
+	c <- 2
+	c <- 3
+	require.Equal(t, 3, receiveValue(t, debounced))
+}
+
+func TestSlidingWindow(t *testing.T) {
+	c := make(chan int)
+	windows := SlidingWindow(NewStream(1, c), 3)
+	defer windows.Close()
+
+	feed(c, 1, 2, 3, 4)
+	require.Equal(t, []int{1, 2, 3}, receiveValue(t, windows))
+	require.Equal(t, []int{2, 3, 4}, receiveValue(t, windows))
+}
+
+func TestDerivative(t *testing.T) {
+	c := make(chan float64)
+	rate := Derivative(NewStream(1, c))
+	defer rate.Close()
+
+	c <- 0
+	time.Sleep(100 * time.Millisecond)
+	c <- 10
+	value := receiveValue(t, rate)
+	require.Greater(t, value, 0.0)
+	require.LessOrEqual(t, value, 100.0)
+}
+
+func TestCombinatorEnd(t *testing.T) {
+	t.Run("closing closes the sources", func(t *testing.T) {
+		a := NewStream(1, make(chan int))
+		b := NewStream(2, make(chan int))
+		combined := CombineLatest(Throttle(a, time.Second), b, func(x, y int) int { return x + y })
+		require.NoError(t, combined.Close())
+		requireEnded(t, combined, nil)
+		requireEnded(t, a, nil)
+		requireEnded(t, b, nil)
+	})
+
+	t.Run("sources ending end the stream", func(t *testing.T) {
+		c := make(chan int)
+		windows := SlidingWindow(Filter(NewStream(1, c), func(int) bool { return true }), 2)
+		close(c)
+		requireEnded(t, windows, nil)
+	})
+
+	t.Run("unread streams don't hold up their sources", func(t *testing.T) {
+		sm := newStreamManager(0)
+		raw := sm.newStream(WithBuffer(2))
+		mapped := MapStream(raw, func(b []byte) string { return string(b) })
+		for _, s := range []string{"a", "b", "c", "d", "e", "f"} {
+			sm.write([]byte(s))
+		}
+		// The mapped stream holds at most one value, and the rest are dropped
+		// by the server stream, where the client counts them.
+		require.Eventually(t, func() bool {
+			return mapped.Dropped() >= 3
+		}, time.Second, time.Millisecond)
+		require.Equal(t, sm.dropped.Load(), mapped.Dropped())
+		require.Equal(t, raw.Dropped(), mapped.Dropped())
+		value, err := mapped.Get()
+		require.NoError(t, err)
+		require.Equal(t, "f", value)
+		require.NoError(t, mapped.Close())
+		requireEnded(t, raw, nil)
+	})
+}
@@ -5,8 +5,8 @@
 package dockingcamera
 
 import (
+	"context"
 	krpcgo "github.com/atburke/krpc-go"
-	krpc "github.com/atburke/krpc-go/krpc"
 	encode "github.com/atburke/krpc-go/lib/encode"
 	service "github.com/atburke/krpc-go/lib/service"
 	spacecenter "github.com/atburke/krpc-go/spacecenter"
@@ -22,7 +22,7 @@ type Camera struct {
 }
 
 // NewCamera creates a new Camera.
-func NewCamera(id uint64, client *krpcgo.KRPCClient) *Camera {
+func NewCamera(id uint64, client krpcgo.Caller) *Camera {
 	c := &Camera{BaseClass: service.BaseClass{Client: client}}
 	c.SetID(id)
 	return c
@@ -30,11 +30,11 @@ func NewCamera(id uint64, client *krpcgo.KRPCClient) *Camera {
 
 // DockingCamera - camera Service
 type DockingCamera struct {
-	Client *krpcgo.KRPCClient
+	Client krpcgo.Caller
 }
 
 // New creates a new DockingCamera.
-func New(client *krpcgo.KRPCClient) *DockingCamera {
+func New(client krpcgo.Caller) *DockingCamera {
 	return &DockingCamera{Client: client}
 }
 
@@ -42,6 +42,13 @@ func New(client *krpcgo.KRPCClient) *DockingCamera {
 //
 // Allowed game scenes: any.
 func (s *DockingCamera) Camera(part *spacecenter.Part) (*Camera, error) {
+	return s.CameraContext(context.Background(), part)
+}
+
+// CameraContext - get a Camera part
+//
+// Allowed game scenes: any.
+func (s *DockingCamera) CameraContext(ctx context.Context, part *spacecenter.Part) (*Camera, error) {
 	var err error
 	var argBytes []byte
 	var vv Camera
@@ -57,7 +64,7 @@ func (s *DockingCamera) Camera(part *spacecenter.Part) (*Camera, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -69,17 +76,89 @@ func (s *DockingCamera) Camera(part *spacecenter.Part) (*Camera, error) {
 	return &vv, nil
 }
 
+// CameraAsync - get a Camera part
+//
+// Allowed game scenes: any.
+func (s *DockingCamera) CameraAsync(part *spacecenter.Part) (*krpcgo.Future[*Camera], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Camera",
+		Service:   "DockingCamera",
+	}
+	argBytes, err = encode.Marshal(part)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Camera, error) {
+		var vv Camera
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// CameraCall builds a call to Camera without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *DockingCamera) CameraCall(part *spacecenter.Part) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Camera",
+		Service:   "DockingCamera",
+	}
+	argBytes, err = encode.Marshal(part)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeCamera decodes the result of a call built with CameraCall.
+func (s *DockingCamera) DecodeCamera(result *types.ProcedureResult) (*Camera, error) {
+	var vv Camera
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
 // Available - check if the Camera API is avaiable
 //
 // Allowed game scenes: any.
 func (s *DockingCamera) Available() (bool, error) {
+	return s.AvailableContext(context.Background())
+}
+
+// AvailableContext - check if the Camera API is avaiable
+//
+// Allowed game scenes: any.
+func (s *DockingCamera) AvailableContext(ctx context.Context) (bool, error) {
 	var err error
 	var vv bool
 	request := &types.ProcedureCall{
 		Procedure: "get_Available",
 		Service:   "DockingCamera",
 	}
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -90,36 +169,81 @@ func (s *DockingCamera) Available() (bool, error) {
 	return vv, nil
 }
 
+// AvailableAsync - check if the Camera API is avaiable
+//
+// Allowed game scenes: any.
+func (s *DockingCamera) AvailableAsync() (*krpcgo.Future[bool], error) {
+	request := &types.ProcedureCall{
+		Procedure: "get_Available",
+		Service:   "DockingCamera",
+	}
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (bool, error) {
+		var vv bool
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
 // AvailableStream - check if the Camera API is avaiable
 //
 // Allowed game scenes: any.
-func (s *DockingCamera) AvailableStream() (*krpcgo.Stream[bool], error) {
+func (s *DockingCamera) AvailableStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[bool], error) {
 	var err error
 	request := &types.ProcedureCall{
 		Procedure: "get_Available",
 		Service:   "DockingCamera",
 	}
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) bool {
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (bool, error) {
 		var value bool
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
 	return stream, nil
 }
 
+// AvailableCall builds a call to Available without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *DockingCamera) AvailableCall() (*types.ProcedureCall, error) {
+	request := &types.ProcedureCall{
+		Procedure: "get_Available",
+		Service:   "DockingCamera",
+	}
+	return request, nil
+}
+
+// DecodeAvailable decodes the result of a call built with AvailableCall.
+func (s *DockingCamera) DecodeAvailable(result *types.ProcedureResult) (bool, error) {
+	var vv bool
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
 // Part - get the part containing this Camera.
 //
 // Allowed game scenes: any.
 func (s *Camera) Part() (*spacecenter.Part, error) {
+	return s.PartContext(context.Background())
+}
+
+// PartContext - get the part containing this Camera.
+//
+// Allowed game scenes: any.
+func (s *Camera) PartContext(ctx context.Context) (*spacecenter.Part, error) {
 	var err error
 	var argBytes []byte
 	var vv spacecenter.Part
@@ -135,7 +259,7 @@ func (s *Camera) Part() (*spacecenter.Part, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -147,10 +271,82 @@ func (s *Camera) Part() (*spacecenter.Part, error) {
 	return &vv, nil
 }
 
+// PartAsync - get the part containing this Camera.
+//
+// Allowed game scenes: any.
+func (s *Camera) PartAsync() (*krpcgo.Future[*spacecenter.Part], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Camera_get_Part",
+		Service:   "DockingCamera",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*spacecenter.Part, error) {
+		var vv spacecenter.Part
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// PartCall builds a call to Part without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Camera) PartCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Camera_get_Part",
+		Service:   "DockingCamera",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodePart decodes the result of a call built with PartCall.
+func (s *Camera) DecodePart(result *types.ProcedureResult) (*spacecenter.Part, error) {
+	var vv spacecenter.Part
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
 // Image - get the image.
 //
 // Allowed game scenes: any.
 func (s *Camera) Image() ([]byte, error) {
+	return s.ImageContext(context.Background())
+}
+
+// ImageContext - get the image.
+//
+// Allowed game scenes: any.
+func (s *Camera) ImageContext(ctx context.Context) ([]byte, error) {
 	var err error
 	var argBytes []byte
 	var vv []byte
@@ -166,7 +362,7 @@ func (s *Camera) Image() ([]byte, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -177,10 +373,39 @@ func (s *Camera) Image() ([]byte, error) {
 	return vv, nil
 }
 
+// ImageAsync - get the image.
+//
+// Allowed game scenes: any.
+func (s *Camera) ImageAsync() (*krpcgo.Future[[]byte], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Camera_get_Image",
+		Service:   "DockingCamera",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) ([]byte, error) {
+		var vv []byte
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
 // ImageStream - get the image.
 //
 // Allowed game scenes: any.
-func (s *Camera) ImageStream() (*krpcgo.Stream[[]byte], error) {
+func (s *Camera) ImageStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[[]byte], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
@@ -195,19 +420,64 @@ func (s *Camera) ImageStream() (*krpcgo.Stream[[]byte], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) []byte {
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) ([]byte, error) {
 		var value []byte
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
 	return stream, nil
 }
+
+// ImageCall builds a call to Image without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Camera) ImageCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Camera_get_Image",
+		Service:   "DockingCamera",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeImage decodes the result of a call built with ImageCall.
+func (s *Camera) DecodeImage(result *types.ProcedureResult) ([]byte, error) {
+	var vv []byte
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+func init() {
+	krpcgo.RegisterReturnTypes("DockingCamera", map[string]*types.Type{
+		"Camera": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Camera",
+			Service: "DockingCamera",
+		},
+		"Camera_get_Image": &types.Type{Code: types.Type_BYTES},
+		"Camera_get_Part": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Part",
+			Service: "SpaceCenter",
+		},
+		"get_Available": &types.Type{Code: types.Type_BOOL},
+	})
+}
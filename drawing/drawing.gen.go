@@ -5,8 +5,8 @@
 package drawing
 
 import (
+	"context"
 	krpcgo "github.com/atburke/krpc-go"
-	krpc "github.com/atburke/krpc-go/krpc"
 	encode "github.com/atburke/krpc-go/lib/encode"
 	service "github.com/atburke/krpc-go/lib/service"
 	spacecenter "github.com/atburke/krpc-go/spacecenter"
@@ -23,7 +23,7 @@ type Line struct {
 }
 
 // NewLine creates a new Line.
-func NewLine(id uint64, client *krpcgo.KRPCClient) *Line {
+func NewLine(id uint64, client krpcgo.Caller) *Line {
 	c := &Line{BaseClass: service.BaseClass{Client: client}}
 	c.SetID(id)
 	return c
@@ -35,7 +35,7 @@ type Polygon struct {
 }
 
 // NewPolygon creates a new Polygon.
-func NewPolygon(id uint64, client *krpcgo.KRPCClient) *Polygon {
+func NewPolygon(id uint64, client krpcgo.Caller) *Polygon {
 	c := &Polygon{BaseClass: service.BaseClass{Client: client}}
 	c.SetID(id)
 	return c
@@ -47,7 +47,7 @@ type Text struct {
 }
 
 // NewText creates a new Text.
-func NewText(id uint64, client *krpcgo.KRPCClient) *Text {
+func NewText(id uint64, client krpcgo.Caller) *Text {
 	c := &Text{BaseClass: service.BaseClass{Client: client}}
 	c.SetID(id)
 	return c
@@ -55,11 +55,11 @@ func NewText(id uint64, client *krpcgo.KRPCClient) *Text {
 
 // Drawing - provides functionality for drawing objects in the flight scene.
 type Drawing struct {
-	Client *krpcgo.KRPCClient
+	Client krpcgo.Caller
 }
 
 // New creates a new Drawing.
-func New(client *krpcgo.KRPCClient) *Drawing {
+func New(client krpcgo.Caller) *Drawing {
 	return &Drawing{Client: client}
 }
 
@@ -67,6 +67,13 @@ func New(client *krpcgo.KRPCClient) *Drawing {
 //
 // Allowed game scenes: any.
 func (s *Drawing) AddLine(start types.Tuple3[float64, float64, float64], end types.Tuple3[float64, float64, float64], referenceFrame *spacecenter.ReferenceFrame, visible bool) (*Line, error) {
+	return s.AddLineContext(context.Background(), start, end, referenceFrame, visible)
+}
+
+// AddLineContext - draw a line in the scene.
+//
+// Allowed game scenes: any.
+func (s *Drawing) AddLineContext(ctx context.Context, start types.Tuple3[float64, float64, float64], end types.Tuple3[float64, float64, float64], referenceFrame *spacecenter.ReferenceFrame, visible bool) (*Line, error) {
 	var err error
 	var argBytes []byte
 	var vv Line
@@ -106,7 +113,7 @@ func (s *Drawing) AddLine(start types.Tuple3[float64, float64, float64], end typ
 		Position: uint32(0x3),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -118,37 +125,35 @@ func (s *Drawing) AddLine(start types.Tuple3[float64, float64, float64], end typ
 	return &vv, nil
 }
 
-// AddDirection - draw a direction vector in the scene, starting from the origin
-// of the given reference frame.
+// AddLineAsync - draw a line in the scene.
 //
 // Allowed game scenes: any.
-func (s *Drawing) AddDirection(direction types.Tuple3[float64, float64, float64], referenceFrame *spacecenter.ReferenceFrame, length float32, visible bool) (*Line, error) {
+func (s *Drawing) AddLineAsync(start types.Tuple3[float64, float64, float64], end types.Tuple3[float64, float64, float64], referenceFrame *spacecenter.ReferenceFrame, visible bool) (*krpcgo.Future[*Line], error) {
 	var err error
 	var argBytes []byte
-	var vv Line
 	request := &types.ProcedureCall{
-		Procedure: "AddDirection",
+		Procedure: "AddLine",
 		Service:   "Drawing",
 	}
-	argBytes, err = encode.Marshal(direction)
+	argBytes, err = encode.Marshal(start)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(referenceFrame)
+	argBytes, err = encode.Marshal(end)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(length)
+	argBytes, err = encode.Marshal(referenceFrame)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x2),
@@ -156,17 +161,76 @@ func (s *Drawing) AddDirection(direction types.Tuple3[float64, float64, float64]
 	})
 	argBytes, err = encode.Marshal(visible)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x3),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Line, error) {
+		var vv Line
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// AddLineCall builds a call to AddLine without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Drawing) AddLineCall(start types.Tuple3[float64, float64, float64], end types.Tuple3[float64, float64, float64], referenceFrame *spacecenter.ReferenceFrame, visible bool) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "AddLine",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(start)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(end)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(referenceFrame)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x2),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(visible)
 	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x3),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeAddLine decodes the result of a call built with AddLineCall.
+func (s *Drawing) DecodeAddLine(result *types.ProcedureResult) (*Line, error) {
+	var vv Line
+	if err := krpcgo.ResultError(result); err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -174,16 +238,24 @@ func (s *Drawing) AddDirection(direction types.Tuple3[float64, float64, float64]
 	return &vv, nil
 }
 
-// AddDirectionFromCom - draw a direction vector in the scene, from the center
-// of mass of the active vessel.
+// AddDirection - draw a direction vector in the scene, starting from the origin
+// of the given reference frame.
 //
 // Allowed game scenes: any.
-func (s *Drawing) AddDirectionFromCom(direction types.Tuple3[float64, float64, float64], referenceFrame *spacecenter.ReferenceFrame, length float32, visible bool) (*Line, error) {
+func (s *Drawing) AddDirection(direction types.Tuple3[float64, float64, float64], referenceFrame *spacecenter.ReferenceFrame, length float32, visible bool) (*Line, error) {
+	return s.AddDirectionContext(context.Background(), direction, referenceFrame, length, visible)
+}
+
+// AddDirectionContext - draw a direction vector in the scene, starting from the
+// origin of the given reference frame.
+//
+// Allowed game scenes: any.
+func (s *Drawing) AddDirectionContext(ctx context.Context, direction types.Tuple3[float64, float64, float64], referenceFrame *spacecenter.ReferenceFrame, length float32, visible bool) (*Line, error) {
 	var err error
 	var argBytes []byte
 	var vv Line
 	request := &types.ProcedureCall{
-		Procedure: "AddDirectionFromCom",
+		Procedure: "AddDirection",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(direction)
@@ -218,7 +290,7 @@ func (s *Drawing) AddDirectionFromCom(direction types.Tuple3[float64, float64, f
 		Position: uint32(0x3),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -230,20 +302,20 @@ func (s *Drawing) AddDirectionFromCom(direction types.Tuple3[float64, float64, f
 	return &vv, nil
 }
 
-// AddPolygon - draw a polygon in the scene, defined by a list of vertices.
+// AddDirectionAsync - draw a direction vector in the scene, starting from the
+// origin of the given reference frame.
 //
 // Allowed game scenes: any.
-func (s *Drawing) AddPolygon(vertices []types.Tuple3[float64, float64, float64], referenceFrame *spacecenter.ReferenceFrame, visible bool) (*Polygon, error) {
+func (s *Drawing) AddDirectionAsync(direction types.Tuple3[float64, float64, float64], referenceFrame *spacecenter.ReferenceFrame, length float32, visible bool) (*krpcgo.Future[*Line], error) {
 	var err error
 	var argBytes []byte
-	var vv Polygon
 	request := &types.ProcedureCall{
-		Procedure: "AddPolygon",
+		Procedure: "AddDirection",
 		Service:   "Drawing",
 	}
-	argBytes, err = encode.Marshal(vertices)
+	argBytes, err = encode.Marshal(direction)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
@@ -251,46 +323,53 @@ func (s *Drawing) AddPolygon(vertices []types.Tuple3[float64, float64, float64],
 	})
 	argBytes, err = encode.Marshal(referenceFrame)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(visible)
+	argBytes, err = encode.Marshal(length)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x2),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
+	argBytes, err = encode.Marshal(visible)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	vv.Client = s.Client
-	return &vv, nil
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x3),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Line, error) {
+		var vv Line
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
 }
 
-// AddText - draw text in the scene.
-//
-// Allowed game scenes: any.
-func (s *Drawing) AddText(text string, referenceFrame *spacecenter.ReferenceFrame, position types.Tuple3[float64, float64, float64], rotation types.Tuple4[float64, float64, float64, float64], visible bool) (*Text, error) {
+// AddDirectionCall builds a call to AddDirection without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *Drawing) AddDirectionCall(direction types.Tuple3[float64, float64, float64], referenceFrame *spacecenter.ReferenceFrame, length float32, visible bool) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv Text
 	request := &types.ProcedureCall{
-		Procedure: "AddText",
+		Procedure: "AddDirection",
 		Service:   "Drawing",
 	}
-	argBytes, err = encode.Marshal(text)
+	argBytes, err = encode.Marshal(direction)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
@@ -298,41 +377,38 @@ func (s *Drawing) AddText(text string, referenceFrame *spacecenter.ReferenceFram
 	})
 	argBytes, err = encode.Marshal(referenceFrame)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(position)
+	argBytes, err = encode.Marshal(length)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x2),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(rotation)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x3),
-		Value:    argBytes,
-	})
 	argBytes, err = encode.Marshal(visible)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x4),
+		Position: uint32(0x3),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
+	return request, nil
+}
+
+// DecodeAddDirection decodes the result of a call built with AddDirectionCall.
+func (s *Drawing) DecodeAddDirection(result *types.ProcedureResult) (*Line, error) {
+	var vv Line
+	if err := krpcgo.ResultError(result); err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -340,97 +416,82 @@ func (s *Drawing) AddText(text string, referenceFrame *spacecenter.ReferenceFram
 	return &vv, nil
 }
 
-// Clear - remove all objects being drawn.
+// AddDirectionFromCom - draw a direction vector in the scene, from the center
+// of mass of the active vessel.
 //
 // Allowed game scenes: any.
-func (s *Drawing) Clear(clientOnly bool) error {
-	var err error
-	var argBytes []byte
-	request := &types.ProcedureCall{
-		Procedure: "Clear",
-		Service:   "Drawing",
-	}
-	argBytes, err = encode.Marshal(clientOnly)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x0),
-		Value:    argBytes,
-	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+func (s *Drawing) AddDirectionFromCom(direction types.Tuple3[float64, float64, float64], referenceFrame *spacecenter.ReferenceFrame, length float32, visible bool) (*Line, error) {
+	return s.AddDirectionFromComContext(context.Background(), direction, referenceFrame, length, visible)
 }
 
-// Remove - remove the object.
+// AddDirectionFromComContext - draw a direction vector in the scene, from the
+// center of mass of the active vessel.
 //
 // Allowed game scenes: any.
-func (s *Line) Remove() error {
+func (s *Drawing) AddDirectionFromComContext(ctx context.Context, direction types.Tuple3[float64, float64, float64], referenceFrame *spacecenter.ReferenceFrame, length float32, visible bool) (*Line, error) {
 	var err error
 	var argBytes []byte
+	var vv Line
 	request := &types.ProcedureCall{
-		Procedure: "Line_Remove",
+		Procedure: "AddDirectionFromCom",
 		Service:   "Drawing",
 	}
-	argBytes, err = encode.Marshal(s)
+	argBytes, err = encode.Marshal(direction)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return &vv, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	argBytes, err = encode.Marshal(referenceFrame)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return &vv, tracerr.Wrap(err)
 	}
-	return nil
-}
-
-// Start - start position of the line.
-//
-// Allowed game scenes: any.
-func (s *Line) Start() (types.Tuple3[float64, float64, float64], error) {
-	var err error
-	var argBytes []byte
-	var vv types.Tuple3[float64, float64, float64]
-	request := &types.ProcedureCall{
-		Procedure: "Line_get_Start",
-		Service:   "Drawing",
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(length)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
 	}
-	argBytes, err = encode.Marshal(s)
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x2),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(visible)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return &vv, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x0),
+		Position: uint32(0x3),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return &vv, tracerr.Wrap(err)
 	}
 	err = encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return &vv, tracerr.Wrap(err)
 	}
-	return vv, nil
+	vv.Client = s.Client
+	return &vv, nil
 }
 
-// StartStream - start position of the line.
+// AddDirectionFromComAsync - draw a direction vector in the scene, from the
+// center of mass of the active vessel.
 //
 // Allowed game scenes: any.
-func (s *Line) StartStream() (*krpcgo.Stream[types.Tuple3[float64, float64, float64]], error) {
+func (s *Drawing) AddDirectionFromComAsync(direction types.Tuple3[float64, float64, float64], referenceFrame *spacecenter.ReferenceFrame, length float32, visible bool) (*krpcgo.Future[*Line], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Line_get_Start",
+		Procedure: "AddDirectionFromCom",
 		Service:   "Drawing",
 	}
-	argBytes, err = encode.Marshal(s)
+	argBytes, err = encode.Marshal(direction)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
@@ -438,94 +499,4458 @@ func (s *Line) StartStream() (*krpcgo.Stream[types.Tuple3[float64, float64, floa
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	argBytes, err = encode.Marshal(referenceFrame)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) types.Tuple3[float64, float64, float64] {
-		var value types.Tuple3[float64, float64, float64]
-		encode.Unmarshal(b, &value)
-		return value
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
 	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	argBytes, err = encode.Marshal(length)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x2),
+		Value:    argBytes,
 	})
-	return stream, nil
+	argBytes, err = encode.Marshal(visible)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x3),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Line, error) {
+		var vv Line
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
 }
 
-// SetStart - start position of the line.
-//
-// Allowed game scenes: any.
-func (s *Line) SetStart(value types.Tuple3[float64, float64, float64]) error {
+// AddDirectionFromComCall builds a call to AddDirectionFromCom without making
+// it, such as to make several calls with CallMultiple, add a stream with
+// AddStream or use it in an expression.
+func (s *Drawing) AddDirectionFromComCall(direction types.Tuple3[float64, float64, float64], referenceFrame *spacecenter.ReferenceFrame, length float32, visible bool) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Line_set_Start",
+		Procedure: "AddDirectionFromCom",
 		Service:   "Drawing",
 	}
-	argBytes, err = encode.Marshal(s)
+	argBytes, err = encode.Marshal(direction)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
+	argBytes, err = encode.Marshal(referenceFrame)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	argBytes, err = encode.Marshal(length)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x2),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(visible)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x3),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeAddDirectionFromCom decodes the result of a call built with
+// AddDirectionFromComCall.
+func (s *Drawing) DecodeAddDirectionFromCom(result *types.ProcedureResult) (*Line, error) {
+	var vv Line
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// AddPolygon - draw a polygon in the scene, defined by a list of vertices.
+//
+// Allowed game scenes: any.
+func (s *Drawing) AddPolygon(vertices []types.Tuple3[float64, float64, float64], referenceFrame *spacecenter.ReferenceFrame, visible bool) (*Polygon, error) {
+	return s.AddPolygonContext(context.Background(), vertices, referenceFrame, visible)
+}
+
+// AddPolygonContext - draw a polygon in the scene, defined by a list of
+// vertices.
+//
+// Allowed game scenes: any.
+func (s *Drawing) AddPolygonContext(ctx context.Context, vertices []types.Tuple3[float64, float64, float64], referenceFrame *spacecenter.ReferenceFrame, visible bool) (*Polygon, error) {
+	var err error
+	var argBytes []byte
+	var vv Polygon
+	request := &types.ProcedureCall{
+		Procedure: "AddPolygon",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(vertices)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(referenceFrame)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(visible)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x2),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// AddPolygonAsync - draw a polygon in the scene, defined by a list of vertices.
+//
+// Allowed game scenes: any.
+func (s *Drawing) AddPolygonAsync(vertices []types.Tuple3[float64, float64, float64], referenceFrame *spacecenter.ReferenceFrame, visible bool) (*krpcgo.Future[*Polygon], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "AddPolygon",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(vertices)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(referenceFrame)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(visible)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x2),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Polygon, error) {
+		var vv Polygon
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// AddPolygonCall builds a call to AddPolygon without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Drawing) AddPolygonCall(vertices []types.Tuple3[float64, float64, float64], referenceFrame *spacecenter.ReferenceFrame, visible bool) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "AddPolygon",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(vertices)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(referenceFrame)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(visible)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x2),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeAddPolygon decodes the result of a call built with AddPolygonCall.
+func (s *Drawing) DecodeAddPolygon(result *types.ProcedureResult) (*Polygon, error) {
+	var vv Polygon
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// AddText - draw text in the scene.
+//
+// Allowed game scenes: any.
+func (s *Drawing) AddText(text string, referenceFrame *spacecenter.ReferenceFrame, position types.Tuple3[float64, float64, float64], rotation types.Tuple4[float64, float64, float64, float64], visible bool) (*Text, error) {
+	return s.AddTextContext(context.Background(), text, referenceFrame, position, rotation, visible)
+}
+
+// AddTextContext - draw text in the scene.
+//
+// Allowed game scenes: any.
+func (s *Drawing) AddTextContext(ctx context.Context, text string, referenceFrame *spacecenter.ReferenceFrame, position types.Tuple3[float64, float64, float64], rotation types.Tuple4[float64, float64, float64, float64], visible bool) (*Text, error) {
+	var err error
+	var argBytes []byte
+	var vv Text
+	request := &types.ProcedureCall{
+		Procedure: "AddText",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(text)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(referenceFrame)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(position)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x2),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(rotation)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x3),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(visible)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x4),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// AddTextAsync - draw text in the scene.
+//
+// Allowed game scenes: any.
+func (s *Drawing) AddTextAsync(text string, referenceFrame *spacecenter.ReferenceFrame, position types.Tuple3[float64, float64, float64], rotation types.Tuple4[float64, float64, float64, float64], visible bool) (*krpcgo.Future[*Text], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "AddText",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(text)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(referenceFrame)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(position)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x2),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(rotation)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x3),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(visible)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x4),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Text, error) {
+		var vv Text
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// AddTextCall builds a call to AddText without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Drawing) AddTextCall(text string, referenceFrame *spacecenter.ReferenceFrame, position types.Tuple3[float64, float64, float64], rotation types.Tuple4[float64, float64, float64, float64], visible bool) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "AddText",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(text)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(referenceFrame)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(position)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x2),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(rotation)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x3),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(visible)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x4),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeAddText decodes the result of a call built with AddTextCall.
+func (s *Drawing) DecodeAddText(result *types.ProcedureResult) (*Text, error) {
+	var vv Text
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// Clear - remove all objects being drawn.
+//
+// Allowed game scenes: any.
+func (s *Drawing) Clear(clientOnly bool) error {
+	return s.ClearContext(context.Background(), clientOnly)
+}
+
+// ClearContext - remove all objects being drawn.
+//
+// Allowed game scenes: any.
+func (s *Drawing) ClearContext(ctx context.Context, clientOnly bool) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Clear",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(clientOnly)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// ClearAsync - remove all objects being drawn.
+//
+// Allowed game scenes: any.
+func (s *Drawing) ClearAsync(clientOnly bool) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Clear",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(clientOnly)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// ClearCall builds a call to Clear without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Drawing) ClearCall(clientOnly bool) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Clear",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(clientOnly)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// Remove - remove the object.
+//
+// Allowed game scenes: any.
+func (s *Line) Remove() error {
+	return s.RemoveContext(context.Background())
+}
+
+// RemoveContext - remove the object.
+//
+// Allowed game scenes: any.
+func (s *Line) RemoveContext(ctx context.Context) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_Remove",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// RemoveAsync - remove the object.
+//
+// Allowed game scenes: any.
+func (s *Line) RemoveAsync() (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_Remove",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// RemoveCall builds a call to Remove without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Line) RemoveCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_Remove",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// Start - start position of the line.
+//
+// Allowed game scenes: any.
+func (s *Line) Start() (types.Tuple3[float64, float64, float64], error) {
+	return s.StartContext(context.Background())
+}
+
+// StartContext - start position of the line.
+//
+// Allowed game scenes: any.
+func (s *Line) StartContext(ctx context.Context) (types.Tuple3[float64, float64, float64], error) {
+	var err error
+	var argBytes []byte
+	var vv types.Tuple3[float64, float64, float64]
+	request := &types.ProcedureCall{
+		Procedure: "Line_get_Start",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// StartAsync - start position of the line.
+//
+// Allowed game scenes: any.
+func (s *Line) StartAsync() (*krpcgo.Future[types.Tuple3[float64, float64, float64]], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_get_Start",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (types.Tuple3[float64, float64, float64], error) {
+		var vv types.Tuple3[float64, float64, float64]
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// StartStream - start position of the line.
+//
+// Allowed game scenes: any.
+func (s *Line) StartStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[types.Tuple3[float64, float64, float64]], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_get_Start",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (types.Tuple3[float64, float64, float64], error) {
+		var value types.Tuple3[float64, float64, float64]
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// StartCall builds a call to Start without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Line) StartCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_get_Start",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeStart decodes the result of a call built with StartCall.
+func (s *Line) DecodeStart(result *types.ProcedureResult) (types.Tuple3[float64, float64, float64], error) {
+	var vv types.Tuple3[float64, float64, float64]
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetStart - start position of the line.
+//
+// Allowed game scenes: any.
+func (s *Line) SetStart(value types.Tuple3[float64, float64, float64]) error {
+	return s.SetStartContext(context.Background(), value)
+}
+
+// SetStartContext - start position of the line.
+//
+// Allowed game scenes: any.
+func (s *Line) SetStartContext(ctx context.Context, value types.Tuple3[float64, float64, float64]) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_set_Start",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetStartAsync - start position of the line.
+//
+// Allowed game scenes: any.
+func (s *Line) SetStartAsync(value types.Tuple3[float64, float64, float64]) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_set_Start",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetStartCall builds a call to SetStart without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Line) SetStartCall(value types.Tuple3[float64, float64, float64]) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_set_Start",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// End - end position of the line.
+//
+// Allowed game scenes: any.
+func (s *Line) End() (types.Tuple3[float64, float64, float64], error) {
+	return s.EndContext(context.Background())
+}
+
+// EndContext - end position of the line.
+//
+// Allowed game scenes: any.
+func (s *Line) EndContext(ctx context.Context) (types.Tuple3[float64, float64, float64], error) {
+	var err error
+	var argBytes []byte
+	var vv types.Tuple3[float64, float64, float64]
+	request := &types.ProcedureCall{
+		Procedure: "Line_get_End",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// EndAsync - end position of the line.
+//
+// Allowed game scenes: any.
+func (s *Line) EndAsync() (*krpcgo.Future[types.Tuple3[float64, float64, float64]], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_get_End",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (types.Tuple3[float64, float64, float64], error) {
+		var vv types.Tuple3[float64, float64, float64]
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// EndStream - end position of the line.
+//
+// Allowed game scenes: any.
+func (s *Line) EndStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[types.Tuple3[float64, float64, float64]], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_get_End",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (types.Tuple3[float64, float64, float64], error) {
+		var value types.Tuple3[float64, float64, float64]
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// EndCall builds a call to End without making it, such as to make several calls
+// with CallMultiple, add a stream with AddStream or use it in an expression.
+func (s *Line) EndCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_get_End",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeEnd decodes the result of a call built with EndCall.
+func (s *Line) DecodeEnd(result *types.ProcedureResult) (types.Tuple3[float64, float64, float64], error) {
+	var vv types.Tuple3[float64, float64, float64]
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetEnd - end position of the line.
+//
+// Allowed game scenes: any.
+func (s *Line) SetEnd(value types.Tuple3[float64, float64, float64]) error {
+	return s.SetEndContext(context.Background(), value)
+}
+
+// SetEndContext - end position of the line.
+//
+// Allowed game scenes: any.
+func (s *Line) SetEndContext(ctx context.Context, value types.Tuple3[float64, float64, float64]) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_set_End",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetEndAsync - end position of the line.
+//
+// Allowed game scenes: any.
+func (s *Line) SetEndAsync(value types.Tuple3[float64, float64, float64]) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_set_End",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetEndCall builds a call to SetEnd without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Line) SetEndCall(value types.Tuple3[float64, float64, float64]) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_set_End",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// Color - set the color
+//
+// Allowed game scenes: any.
+func (s *Line) Color() (types.Tuple3[float64, float64, float64], error) {
+	return s.ColorContext(context.Background())
+}
+
+// ColorContext - set the color
+//
+// Allowed game scenes: any.
+func (s *Line) ColorContext(ctx context.Context) (types.Tuple3[float64, float64, float64], error) {
+	var err error
+	var argBytes []byte
+	var vv types.Tuple3[float64, float64, float64]
+	request := &types.ProcedureCall{
+		Procedure: "Line_get_Color",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// ColorAsync - set the color
+//
+// Allowed game scenes: any.
+func (s *Line) ColorAsync() (*krpcgo.Future[types.Tuple3[float64, float64, float64]], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_get_Color",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (types.Tuple3[float64, float64, float64], error) {
+		var vv types.Tuple3[float64, float64, float64]
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// ColorStream - set the color
+//
+// Allowed game scenes: any.
+func (s *Line) ColorStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[types.Tuple3[float64, float64, float64]], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_get_Color",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (types.Tuple3[float64, float64, float64], error) {
+		var value types.Tuple3[float64, float64, float64]
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// ColorCall builds a call to Color without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Line) ColorCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_get_Color",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeColor decodes the result of a call built with ColorCall.
+func (s *Line) DecodeColor(result *types.ProcedureResult) (types.Tuple3[float64, float64, float64], error) {
+	var vv types.Tuple3[float64, float64, float64]
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetColor - set the color
+//
+// Allowed game scenes: any.
+func (s *Line) SetColor(value types.Tuple3[float64, float64, float64]) error {
+	return s.SetColorContext(context.Background(), value)
+}
+
+// SetColorContext - set the color
+//
+// Allowed game scenes: any.
+func (s *Line) SetColorContext(ctx context.Context, value types.Tuple3[float64, float64, float64]) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_set_Color",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetColorAsync - set the color
+//
+// Allowed game scenes: any.
+func (s *Line) SetColorAsync(value types.Tuple3[float64, float64, float64]) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_set_Color",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetColorCall builds a call to SetColor without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Line) SetColorCall(value types.Tuple3[float64, float64, float64]) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_set_Color",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// Thickness - set the thickness
+//
+// Allowed game scenes: any.
+func (s *Line) Thickness() (float32, error) {
+	return s.ThicknessContext(context.Background())
+}
+
+// ThicknessContext - set the thickness
+//
+// Allowed game scenes: any.
+func (s *Line) ThicknessContext(ctx context.Context) (float32, error) {
+	var err error
+	var argBytes []byte
+	var vv float32
+	request := &types.ProcedureCall{
+		Procedure: "Line_get_Thickness",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// ThicknessAsync - set the thickness
+//
+// Allowed game scenes: any.
+func (s *Line) ThicknessAsync() (*krpcgo.Future[float32], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_get_Thickness",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (float32, error) {
+		var vv float32
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// ThicknessStream - set the thickness
+//
+// Allowed game scenes: any.
+func (s *Line) ThicknessStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[float32], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_get_Thickness",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (float32, error) {
+		var value float32
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// ThicknessCall builds a call to Thickness without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Line) ThicknessCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_get_Thickness",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeThickness decodes the result of a call built with ThicknessCall.
+func (s *Line) DecodeThickness(result *types.ProcedureResult) (float32, error) {
+	var vv float32
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetThickness - set the thickness
+//
+// Allowed game scenes: any.
+func (s *Line) SetThickness(value float32) error {
+	return s.SetThicknessContext(context.Background(), value)
+}
+
+// SetThicknessContext - set the thickness
+//
+// Allowed game scenes: any.
+func (s *Line) SetThicknessContext(ctx context.Context, value float32) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_set_Thickness",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetThicknessAsync - set the thickness
+//
+// Allowed game scenes: any.
+func (s *Line) SetThicknessAsync(value float32) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_set_Thickness",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetThicknessCall builds a call to SetThickness without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *Line) SetThicknessCall(value float32) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_set_Thickness",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// ReferenceFrame - reference frame for the positions of the object.
+//
+// Allowed game scenes: any.
+func (s *Line) ReferenceFrame() (*spacecenter.ReferenceFrame, error) {
+	return s.ReferenceFrameContext(context.Background())
+}
+
+// ReferenceFrameContext - reference frame for the positions of the object.
+//
+// Allowed game scenes: any.
+func (s *Line) ReferenceFrameContext(ctx context.Context) (*spacecenter.ReferenceFrame, error) {
+	var err error
+	var argBytes []byte
+	var vv spacecenter.ReferenceFrame
+	request := &types.ProcedureCall{
+		Procedure: "Line_get_ReferenceFrame",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// ReferenceFrameAsync - reference frame for the positions of the object.
+//
+// Allowed game scenes: any.
+func (s *Line) ReferenceFrameAsync() (*krpcgo.Future[*spacecenter.ReferenceFrame], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_get_ReferenceFrame",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*spacecenter.ReferenceFrame, error) {
+		var vv spacecenter.ReferenceFrame
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// ReferenceFrameCall builds a call to ReferenceFrame without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *Line) ReferenceFrameCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_get_ReferenceFrame",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeReferenceFrame decodes the result of a call built with
+// ReferenceFrameCall.
+func (s *Line) DecodeReferenceFrame(result *types.ProcedureResult) (*spacecenter.ReferenceFrame, error) {
+	var vv spacecenter.ReferenceFrame
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// SetReferenceFrame - reference frame for the positions of the object.
+//
+// Allowed game scenes: any.
+func (s *Line) SetReferenceFrame(value *spacecenter.ReferenceFrame) error {
+	return s.SetReferenceFrameContext(context.Background(), value)
+}
+
+// SetReferenceFrameContext - reference frame for the positions of the object.
+//
+// Allowed game scenes: any.
+func (s *Line) SetReferenceFrameContext(ctx context.Context, value *spacecenter.ReferenceFrame) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_set_ReferenceFrame",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetReferenceFrameAsync - reference frame for the positions of the object.
+//
+// Allowed game scenes: any.
+func (s *Line) SetReferenceFrameAsync(value *spacecenter.ReferenceFrame) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_set_ReferenceFrame",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetReferenceFrameCall builds a call to SetReferenceFrame without making it,
+// such as to make several calls with CallMultiple, add a stream with AddStream
+// or use it in an expression.
+func (s *Line) SetReferenceFrameCall(value *spacecenter.ReferenceFrame) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_set_ReferenceFrame",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// Visible - whether the object is visible.
+//
+// Allowed game scenes: any.
+func (s *Line) Visible() (bool, error) {
+	return s.VisibleContext(context.Background())
+}
+
+// VisibleContext - whether the object is visible.
+//
+// Allowed game scenes: any.
+func (s *Line) VisibleContext(ctx context.Context) (bool, error) {
+	var err error
+	var argBytes []byte
+	var vv bool
+	request := &types.ProcedureCall{
+		Procedure: "Line_get_Visible",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// VisibleAsync - whether the object is visible.
+//
+// Allowed game scenes: any.
+func (s *Line) VisibleAsync() (*krpcgo.Future[bool], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_get_Visible",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (bool, error) {
+		var vv bool
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// VisibleStream - whether the object is visible.
+//
+// Allowed game scenes: any.
+func (s *Line) VisibleStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[bool], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_get_Visible",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (bool, error) {
+		var value bool
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// VisibleCall builds a call to Visible without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Line) VisibleCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_get_Visible",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeVisible decodes the result of a call built with VisibleCall.
+func (s *Line) DecodeVisible(result *types.ProcedureResult) (bool, error) {
+	var vv bool
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetVisible - whether the object is visible.
+//
+// Allowed game scenes: any.
+func (s *Line) SetVisible(value bool) error {
+	return s.SetVisibleContext(context.Background(), value)
+}
+
+// SetVisibleContext - whether the object is visible.
+//
+// Allowed game scenes: any.
+func (s *Line) SetVisibleContext(ctx context.Context, value bool) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_set_Visible",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetVisibleAsync - whether the object is visible.
+//
+// Allowed game scenes: any.
+func (s *Line) SetVisibleAsync(value bool) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_set_Visible",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetVisibleCall builds a call to SetVisible without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Line) SetVisibleCall(value bool) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_set_Visible",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// Material - material used to render the object. Creates the material from a
+// shader with the given name.
+//
+// Allowed game scenes: any.
+func (s *Line) Material() (string, error) {
+	return s.MaterialContext(context.Background())
+}
+
+// MaterialContext - material used to render the object. Creates the material
+// from a shader with the given name.
+//
+// Allowed game scenes: any.
+func (s *Line) MaterialContext(ctx context.Context) (string, error) {
+	var err error
+	var argBytes []byte
+	var vv string
+	request := &types.ProcedureCall{
+		Procedure: "Line_get_Material",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// MaterialAsync - material used to render the object. Creates the material from
+// a shader with the given name.
+//
+// Allowed game scenes: any.
+func (s *Line) MaterialAsync() (*krpcgo.Future[string], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_get_Material",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (string, error) {
+		var vv string
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// MaterialStream - material used to render the object. Creates the material
+// from a shader with the given name.
+//
+// Allowed game scenes: any.
+func (s *Line) MaterialStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[string], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_get_Material",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (string, error) {
+		var value string
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// MaterialCall builds a call to Material without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Line) MaterialCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_get_Material",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeMaterial decodes the result of a call built with MaterialCall.
+func (s *Line) DecodeMaterial(result *types.ProcedureResult) (string, error) {
+	var vv string
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetMaterial - material used to render the object. Creates the material from a
+// shader with the given name.
+//
+// Allowed game scenes: any.
+func (s *Line) SetMaterial(value string) error {
+	return s.SetMaterialContext(context.Background(), value)
+}
+
+// SetMaterialContext - material used to render the object. Creates the material
+// from a shader with the given name.
+//
+// Allowed game scenes: any.
+func (s *Line) SetMaterialContext(ctx context.Context, value string) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_set_Material",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetMaterialAsync - material used to render the object. Creates the material
+// from a shader with the given name.
+//
+// Allowed game scenes: any.
+func (s *Line) SetMaterialAsync(value string) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_set_Material",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetMaterialCall builds a call to SetMaterial without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *Line) SetMaterialCall(value string) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Line_set_Material",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// Remove - remove the object.
+//
+// Allowed game scenes: any.
+func (s *Polygon) Remove() error {
+	return s.RemoveContext(context.Background())
+}
+
+// RemoveContext - remove the object.
+//
+// Allowed game scenes: any.
+func (s *Polygon) RemoveContext(ctx context.Context) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_Remove",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// RemoveAsync - remove the object.
+//
+// Allowed game scenes: any.
+func (s *Polygon) RemoveAsync() (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_Remove",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// RemoveCall builds a call to Remove without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Polygon) RemoveCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_Remove",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// Vertices - vertices for the polygon.
+//
+// Allowed game scenes: any.
+func (s *Polygon) Vertices() ([]types.Tuple3[float64, float64, float64], error) {
+	return s.VerticesContext(context.Background())
+}
+
+// VerticesContext - vertices for the polygon.
+//
+// Allowed game scenes: any.
+func (s *Polygon) VerticesContext(ctx context.Context) ([]types.Tuple3[float64, float64, float64], error) {
+	var err error
+	var argBytes []byte
+	var vv []types.Tuple3[float64, float64, float64]
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_get_Vertices",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// VerticesAsync - vertices for the polygon.
+//
+// Allowed game scenes: any.
+func (s *Polygon) VerticesAsync() (*krpcgo.Future[[]types.Tuple3[float64, float64, float64]], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_get_Vertices",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) ([]types.Tuple3[float64, float64, float64], error) {
+		var vv []types.Tuple3[float64, float64, float64]
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// VerticesStream - vertices for the polygon.
+//
+// Allowed game scenes: any.
+func (s *Polygon) VerticesStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[[]types.Tuple3[float64, float64, float64]], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_get_Vertices",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) ([]types.Tuple3[float64, float64, float64], error) {
+		var value []types.Tuple3[float64, float64, float64]
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// VerticesCall builds a call to Vertices without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Polygon) VerticesCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_get_Vertices",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeVertices decodes the result of a call built with VerticesCall.
+func (s *Polygon) DecodeVertices(result *types.ProcedureResult) ([]types.Tuple3[float64, float64, float64], error) {
+	var vv []types.Tuple3[float64, float64, float64]
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetVertices - vertices for the polygon.
+//
+// Allowed game scenes: any.
+func (s *Polygon) SetVertices(value []types.Tuple3[float64, float64, float64]) error {
+	return s.SetVerticesContext(context.Background(), value)
+}
+
+// SetVerticesContext - vertices for the polygon.
+//
+// Allowed game scenes: any.
+func (s *Polygon) SetVerticesContext(ctx context.Context, value []types.Tuple3[float64, float64, float64]) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_set_Vertices",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetVerticesAsync - vertices for the polygon.
+//
+// Allowed game scenes: any.
+func (s *Polygon) SetVerticesAsync(value []types.Tuple3[float64, float64, float64]) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_set_Vertices",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetVerticesCall builds a call to SetVertices without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *Polygon) SetVerticesCall(value []types.Tuple3[float64, float64, float64]) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_set_Vertices",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// Color - set the color
+//
+// Allowed game scenes: any.
+func (s *Polygon) Color() (types.Tuple3[float64, float64, float64], error) {
+	return s.ColorContext(context.Background())
+}
+
+// ColorContext - set the color
+//
+// Allowed game scenes: any.
+func (s *Polygon) ColorContext(ctx context.Context) (types.Tuple3[float64, float64, float64], error) {
+	var err error
+	var argBytes []byte
+	var vv types.Tuple3[float64, float64, float64]
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_get_Color",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// ColorAsync - set the color
+//
+// Allowed game scenes: any.
+func (s *Polygon) ColorAsync() (*krpcgo.Future[types.Tuple3[float64, float64, float64]], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_get_Color",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (types.Tuple3[float64, float64, float64], error) {
+		var vv types.Tuple3[float64, float64, float64]
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// ColorStream - set the color
+//
+// Allowed game scenes: any.
+func (s *Polygon) ColorStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[types.Tuple3[float64, float64, float64]], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_get_Color",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (types.Tuple3[float64, float64, float64], error) {
+		var value types.Tuple3[float64, float64, float64]
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// ColorCall builds a call to Color without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Polygon) ColorCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_get_Color",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeColor decodes the result of a call built with ColorCall.
+func (s *Polygon) DecodeColor(result *types.ProcedureResult) (types.Tuple3[float64, float64, float64], error) {
+	var vv types.Tuple3[float64, float64, float64]
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetColor - set the color
+//
+// Allowed game scenes: any.
+func (s *Polygon) SetColor(value types.Tuple3[float64, float64, float64]) error {
+	return s.SetColorContext(context.Background(), value)
+}
+
+// SetColorContext - set the color
+//
+// Allowed game scenes: any.
+func (s *Polygon) SetColorContext(ctx context.Context, value types.Tuple3[float64, float64, float64]) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_set_Color",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetColorAsync - set the color
+//
+// Allowed game scenes: any.
+func (s *Polygon) SetColorAsync(value types.Tuple3[float64, float64, float64]) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_set_Color",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetColorCall builds a call to SetColor without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Polygon) SetColorCall(value types.Tuple3[float64, float64, float64]) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_set_Color",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// Thickness - set the thickness
+//
+// Allowed game scenes: any.
+func (s *Polygon) Thickness() (float32, error) {
+	return s.ThicknessContext(context.Background())
+}
+
+// ThicknessContext - set the thickness
+//
+// Allowed game scenes: any.
+func (s *Polygon) ThicknessContext(ctx context.Context) (float32, error) {
+	var err error
+	var argBytes []byte
+	var vv float32
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_get_Thickness",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// ThicknessAsync - set the thickness
+//
+// Allowed game scenes: any.
+func (s *Polygon) ThicknessAsync() (*krpcgo.Future[float32], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_get_Thickness",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (float32, error) {
+		var vv float32
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// ThicknessStream - set the thickness
+//
+// Allowed game scenes: any.
+func (s *Polygon) ThicknessStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[float32], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_get_Thickness",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (float32, error) {
+		var value float32
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// ThicknessCall builds a call to Thickness without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Polygon) ThicknessCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_get_Thickness",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeThickness decodes the result of a call built with ThicknessCall.
+func (s *Polygon) DecodeThickness(result *types.ProcedureResult) (float32, error) {
+	var vv float32
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetThickness - set the thickness
+//
+// Allowed game scenes: any.
+func (s *Polygon) SetThickness(value float32) error {
+	return s.SetThicknessContext(context.Background(), value)
+}
+
+// SetThicknessContext - set the thickness
+//
+// Allowed game scenes: any.
+func (s *Polygon) SetThicknessContext(ctx context.Context, value float32) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_set_Thickness",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetThicknessAsync - set the thickness
+//
+// Allowed game scenes: any.
+func (s *Polygon) SetThicknessAsync(value float32) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_set_Thickness",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetThicknessCall builds a call to SetThickness without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *Polygon) SetThicknessCall(value float32) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_set_Thickness",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// ReferenceFrame - reference frame for the positions of the object.
+//
+// Allowed game scenes: any.
+func (s *Polygon) ReferenceFrame() (*spacecenter.ReferenceFrame, error) {
+	return s.ReferenceFrameContext(context.Background())
+}
+
+// ReferenceFrameContext - reference frame for the positions of the object.
+//
+// Allowed game scenes: any.
+func (s *Polygon) ReferenceFrameContext(ctx context.Context) (*spacecenter.ReferenceFrame, error) {
+	var err error
+	var argBytes []byte
+	var vv spacecenter.ReferenceFrame
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_get_ReferenceFrame",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// ReferenceFrameAsync - reference frame for the positions of the object.
+//
+// Allowed game scenes: any.
+func (s *Polygon) ReferenceFrameAsync() (*krpcgo.Future[*spacecenter.ReferenceFrame], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_get_ReferenceFrame",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*spacecenter.ReferenceFrame, error) {
+		var vv spacecenter.ReferenceFrame
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// ReferenceFrameCall builds a call to ReferenceFrame without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *Polygon) ReferenceFrameCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_get_ReferenceFrame",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeReferenceFrame decodes the result of a call built with
+// ReferenceFrameCall.
+func (s *Polygon) DecodeReferenceFrame(result *types.ProcedureResult) (*spacecenter.ReferenceFrame, error) {
+	var vv spacecenter.ReferenceFrame
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// SetReferenceFrame - reference frame for the positions of the object.
+//
+// Allowed game scenes: any.
+func (s *Polygon) SetReferenceFrame(value *spacecenter.ReferenceFrame) error {
+	return s.SetReferenceFrameContext(context.Background(), value)
+}
+
+// SetReferenceFrameContext - reference frame for the positions of the object.
+//
+// Allowed game scenes: any.
+func (s *Polygon) SetReferenceFrameContext(ctx context.Context, value *spacecenter.ReferenceFrame) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_set_ReferenceFrame",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetReferenceFrameAsync - reference frame for the positions of the object.
+//
+// Allowed game scenes: any.
+func (s *Polygon) SetReferenceFrameAsync(value *spacecenter.ReferenceFrame) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_set_ReferenceFrame",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetReferenceFrameCall builds a call to SetReferenceFrame without making it,
+// such as to make several calls with CallMultiple, add a stream with AddStream
+// or use it in an expression.
+func (s *Polygon) SetReferenceFrameCall(value *spacecenter.ReferenceFrame) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_set_ReferenceFrame",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// Visible - whether the object is visible.
+//
+// Allowed game scenes: any.
+func (s *Polygon) Visible() (bool, error) {
+	return s.VisibleContext(context.Background())
+}
+
+// VisibleContext - whether the object is visible.
+//
+// Allowed game scenes: any.
+func (s *Polygon) VisibleContext(ctx context.Context) (bool, error) {
+	var err error
+	var argBytes []byte
+	var vv bool
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_get_Visible",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// VisibleAsync - whether the object is visible.
+//
+// Allowed game scenes: any.
+func (s *Polygon) VisibleAsync() (*krpcgo.Future[bool], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_get_Visible",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (bool, error) {
+		var vv bool
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// VisibleStream - whether the object is visible.
+//
+// Allowed game scenes: any.
+func (s *Polygon) VisibleStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[bool], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_get_Visible",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (bool, error) {
+		var value bool
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// VisibleCall builds a call to Visible without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Polygon) VisibleCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_get_Visible",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeVisible decodes the result of a call built with VisibleCall.
+func (s *Polygon) DecodeVisible(result *types.ProcedureResult) (bool, error) {
+	var vv bool
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetVisible - whether the object is visible.
+//
+// Allowed game scenes: any.
+func (s *Polygon) SetVisible(value bool) error {
+	return s.SetVisibleContext(context.Background(), value)
+}
+
+// SetVisibleContext - whether the object is visible.
+//
+// Allowed game scenes: any.
+func (s *Polygon) SetVisibleContext(ctx context.Context, value bool) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_set_Visible",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetVisibleAsync - whether the object is visible.
+//
+// Allowed game scenes: any.
+func (s *Polygon) SetVisibleAsync(value bool) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_set_Visible",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetVisibleCall builds a call to SetVisible without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Polygon) SetVisibleCall(value bool) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_set_Visible",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// Material - material used to render the object. Creates the material from a
+// shader with the given name.
+//
+// Allowed game scenes: any.
+func (s *Polygon) Material() (string, error) {
+	return s.MaterialContext(context.Background())
+}
+
+// MaterialContext - material used to render the object. Creates the material
+// from a shader with the given name.
+//
+// Allowed game scenes: any.
+func (s *Polygon) MaterialContext(ctx context.Context) (string, error) {
+	var err error
+	var argBytes []byte
+	var vv string
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_get_Material",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// MaterialAsync - material used to render the object. Creates the material from
+// a shader with the given name.
+//
+// Allowed game scenes: any.
+func (s *Polygon) MaterialAsync() (*krpcgo.Future[string], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_get_Material",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (string, error) {
+		var vv string
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// MaterialStream - material used to render the object. Creates the material
+// from a shader with the given name.
+//
+// Allowed game scenes: any.
+func (s *Polygon) MaterialStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[string], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_get_Material",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (string, error) {
+		var value string
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// MaterialCall builds a call to Material without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Polygon) MaterialCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_get_Material",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeMaterial decodes the result of a call built with MaterialCall.
+func (s *Polygon) DecodeMaterial(result *types.ProcedureResult) (string, error) {
+	var vv string
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetMaterial - material used to render the object. Creates the material from a
+// shader with the given name.
+//
+// Allowed game scenes: any.
+func (s *Polygon) SetMaterial(value string) error {
+	return s.SetMaterialContext(context.Background(), value)
+}
+
+// SetMaterialContext - material used to render the object. Creates the material
+// from a shader with the given name.
+//
+// Allowed game scenes: any.
+func (s *Polygon) SetMaterialContext(ctx context.Context, value string) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_set_Material",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetMaterialAsync - material used to render the object. Creates the material
+// from a shader with the given name.
+//
+// Allowed game scenes: any.
+func (s *Polygon) SetMaterialAsync(value string) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_set_Material",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetMaterialCall builds a call to SetMaterial without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *Polygon) SetMaterialCall(value string) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Polygon_set_Material",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// AvailableFonts - a list of all available fonts.
+//
+// Allowed game scenes: any.
+func (s *Text) AvailableFonts() ([]string, error) {
+	return s.AvailableFontsContext(context.Background())
+}
+
+// AvailableFontsContext - a list of all available fonts.
+//
+// Allowed game scenes: any.
+func (s *Text) AvailableFontsContext(ctx context.Context) ([]string, error) {
+	var err error
+	var vv []string
+	request := &types.ProcedureCall{
+		Procedure: "Text_static_AvailableFonts",
+		Service:   "Drawing",
+	}
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// AvailableFontsAsync - a list of all available fonts.
+//
+// Allowed game scenes: any.
+func (s *Text) AvailableFontsAsync() (*krpcgo.Future[[]string], error) {
+	request := &types.ProcedureCall{
+		Procedure: "Text_static_AvailableFonts",
+		Service:   "Drawing",
+	}
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) ([]string, error) {
+		var vv []string
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// AvailableFontsStream - a list of all available fonts.
+//
+// Allowed game scenes: any.
+func (s *Text) AvailableFontsStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[[]string], error) {
+	var err error
+	request := &types.ProcedureCall{
+		Procedure: "Text_static_AvailableFonts",
+		Service:   "Drawing",
+	}
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) ([]string, error) {
+		var value []string
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// AvailableFontsCall builds a call to AvailableFonts without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *Text) AvailableFontsCall() (*types.ProcedureCall, error) {
+	request := &types.ProcedureCall{
+		Procedure: "Text_static_AvailableFonts",
+		Service:   "Drawing",
+	}
+	return request, nil
+}
+
+// DecodeAvailableFonts decodes the result of a call built with
+// AvailableFontsCall.
+func (s *Text) DecodeAvailableFonts(result *types.ProcedureResult) ([]string, error) {
+	var vv []string
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// Remove - remove the object.
+//
+// Allowed game scenes: any.
+func (s *Text) Remove() error {
+	return s.RemoveContext(context.Background())
+}
+
+// RemoveContext - remove the object.
+//
+// Allowed game scenes: any.
+func (s *Text) RemoveContext(ctx context.Context) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Text_Remove",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// RemoveAsync - remove the object.
+//
+// Allowed game scenes: any.
+func (s *Text) RemoveAsync() (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Text_Remove",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// RemoveCall builds a call to Remove without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Text) RemoveCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Text_Remove",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// Position - position of the text.
+//
+// Allowed game scenes: any.
+func (s *Text) Position() (types.Tuple3[float64, float64, float64], error) {
+	return s.PositionContext(context.Background())
+}
+
+// PositionContext - position of the text.
+//
+// Allowed game scenes: any.
+func (s *Text) PositionContext(ctx context.Context) (types.Tuple3[float64, float64, float64], error) {
+	var err error
+	var argBytes []byte
+	var vv types.Tuple3[float64, float64, float64]
+	request := &types.ProcedureCall{
+		Procedure: "Text_get_Position",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// PositionAsync - position of the text.
+//
+// Allowed game scenes: any.
+func (s *Text) PositionAsync() (*krpcgo.Future[types.Tuple3[float64, float64, float64]], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Text_get_Position",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (types.Tuple3[float64, float64, float64], error) {
+		var vv types.Tuple3[float64, float64, float64]
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// PositionStream - position of the text.
+//
+// Allowed game scenes: any.
+func (s *Text) PositionStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[types.Tuple3[float64, float64, float64]], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Text_get_Position",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (types.Tuple3[float64, float64, float64], error) {
+		var value types.Tuple3[float64, float64, float64]
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// PositionCall builds a call to Position without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Text) PositionCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Text_get_Position",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodePosition decodes the result of a call built with PositionCall.
+func (s *Text) DecodePosition(result *types.ProcedureResult) (types.Tuple3[float64, float64, float64], error) {
+	var vv types.Tuple3[float64, float64, float64]
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetPosition - position of the text.
+//
+// Allowed game scenes: any.
+func (s *Text) SetPosition(value types.Tuple3[float64, float64, float64]) error {
+	return s.SetPositionContext(context.Background(), value)
+}
+
+// SetPositionContext - position of the text.
+//
+// Allowed game scenes: any.
+func (s *Text) SetPositionContext(ctx context.Context, value types.Tuple3[float64, float64, float64]) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Text_set_Position",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetPositionAsync - position of the text.
+//
+// Allowed game scenes: any.
+func (s *Text) SetPositionAsync(value types.Tuple3[float64, float64, float64]) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Text_set_Position",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetPositionCall builds a call to SetPosition without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *Text) SetPositionCall(value types.Tuple3[float64, float64, float64]) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Text_set_Position",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// Rotation - rotation of the text as a quaternion.
+//
+// Allowed game scenes: any.
+func (s *Text) Rotation() (types.Tuple4[float64, float64, float64, float64], error) {
+	return s.RotationContext(context.Background())
+}
+
+// RotationContext - rotation of the text as a quaternion.
+//
+// Allowed game scenes: any.
+func (s *Text) RotationContext(ctx context.Context) (types.Tuple4[float64, float64, float64, float64], error) {
+	var err error
+	var argBytes []byte
+	var vv types.Tuple4[float64, float64, float64, float64]
+	request := &types.ProcedureCall{
+		Procedure: "Text_get_Rotation",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// RotationAsync - rotation of the text as a quaternion.
+//
+// Allowed game scenes: any.
+func (s *Text) RotationAsync() (*krpcgo.Future[types.Tuple4[float64, float64, float64, float64]], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Text_get_Rotation",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (types.Tuple4[float64, float64, float64, float64], error) {
+		var vv types.Tuple4[float64, float64, float64, float64]
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// RotationStream - rotation of the text as a quaternion.
+//
+// Allowed game scenes: any.
+func (s *Text) RotationStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[types.Tuple4[float64, float64, float64, float64]], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Text_get_Rotation",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (types.Tuple4[float64, float64, float64, float64], error) {
+		var value types.Tuple4[float64, float64, float64, float64]
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// RotationCall builds a call to Rotation without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Text) RotationCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Text_get_Rotation",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeRotation decodes the result of a call built with RotationCall.
+func (s *Text) DecodeRotation(result *types.ProcedureResult) (types.Tuple4[float64, float64, float64, float64], error) {
+	var vv types.Tuple4[float64, float64, float64, float64]
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetRotation - rotation of the text as a quaternion.
+//
+// Allowed game scenes: any.
+func (s *Text) SetRotation(value types.Tuple4[float64, float64, float64, float64]) error {
+	return s.SetRotationContext(context.Background(), value)
+}
+
+// SetRotationContext - rotation of the text as a quaternion.
+//
+// Allowed game scenes: any.
+func (s *Text) SetRotationContext(ctx context.Context, value types.Tuple4[float64, float64, float64, float64]) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Text_set_Rotation",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
 		return tracerr.Wrap(err)
 	}
 	return nil
 }
 
-// End - end position of the line.
+// SetRotationAsync - rotation of the text as a quaternion.
 //
 // Allowed game scenes: any.
-func (s *Line) End() (types.Tuple3[float64, float64, float64], error) {
+func (s *Text) SetRotationAsync(value types.Tuple4[float64, float64, float64, float64]) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
-	var vv types.Tuple3[float64, float64, float64]
 	request := &types.ProcedureCall{
-		Procedure: "Line_get_End",
+		Procedure: "Text_set_Rotation",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetRotationCall builds a call to SetRotation without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *Text) SetRotationCall(value types.Tuple4[float64, float64, float64, float64]) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Text_set_Rotation",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// Content - the text string
+//
+// Allowed game scenes: any.
+func (s *Text) Content() (string, error) {
+	return s.ContentContext(context.Background())
+}
+
+// ContentContext - the text string
+//
+// Allowed game scenes: any.
+func (s *Text) ContentContext(ctx context.Context) (string, error) {
+	var err error
+	var argBytes []byte
+	var vv string
+	request := &types.ProcedureCall{
+		Procedure: "Text_get_Content",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// ContentAsync - the text string
+//
+// Allowed game scenes: any.
+func (s *Text) ContentAsync() (*krpcgo.Future[string], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Text_get_Content",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (string, error) {
+		var vv string
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// ContentStream - the text string
+//
+// Allowed game scenes: any.
+func (s *Text) ContentStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[string], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Text_get_Content",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (string, error) {
+		var value string
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// ContentCall builds a call to Content without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Text) ContentCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Text_get_Content",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeContent decodes the result of a call built with ContentCall.
+func (s *Text) DecodeContent(result *types.ProcedureResult) (string, error) {
+	var vv string
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetContent - the text string
+//
+// Allowed game scenes: any.
+func (s *Text) SetContent(value string) error {
+	return s.SetContentContext(context.Background(), value)
+}
+
+// SetContentContext - the text string
+//
+// Allowed game scenes: any.
+func (s *Text) SetContentContext(ctx context.Context, value string) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Text_set_Content",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	return vv, nil
+	return nil
 }
 
-// EndStream - end position of the line.
+// SetContentAsync - the text string
 //
 // Allowed game scenes: any.
-func (s *Line) EndStream() (*krpcgo.Stream[types.Tuple3[float64, float64, float64]], error) {
+func (s *Text) SetContentAsync(value string) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Line_get_End",
+		Procedure: "Text_set_Content",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -536,36 +4961,33 @@ func (s *Line) EndStream() (*krpcgo.Stream[types.Tuple3[float64, float64, float6
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) types.Tuple3[float64, float64, float64] {
-		var value types.Tuple3[float64, float64, float64]
-		encode.Unmarshal(b, &value)
-		return value
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
 	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetEnd - end position of the line.
-//
-// Allowed game scenes: any.
-func (s *Line) SetEnd(value types.Tuple3[float64, float64, float64]) error {
+// SetContentCall builds a call to SetContent without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Text) SetContentCall(value string) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Line_set_End",
+		Procedure: "Text_set_Content",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
@@ -573,28 +4995,31 @@ func (s *Line) SetEnd(value types.Tuple3[float64, float64, float64]) error {
 	})
 	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return request, nil
 }
 
-// Color - set the color
+// Font - name of the font
 //
 // Allowed game scenes: any.
-func (s *Line) Color() (types.Tuple3[float64, float64, float64], error) {
+func (s *Text) Font() (string, error) {
+	return s.FontContext(context.Background())
+}
+
+// FontContext - name of the font
+//
+// Allowed game scenes: any.
+func (s *Text) FontContext(ctx context.Context) (string, error) {
 	var err error
 	var argBytes []byte
-	var vv types.Tuple3[float64, float64, float64]
+	var vv string
 	request := &types.ProcedureCall{
-		Procedure: "Line_get_Color",
+		Procedure: "Text_get_Font",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -605,7 +5030,7 @@ func (s *Line) Color() (types.Tuple3[float64, float64, float64], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -616,14 +5041,14 @@ func (s *Line) Color() (types.Tuple3[float64, float64, float64], error) {
 	return vv, nil
 }
 
-// ColorStream - set the color
+// FontAsync - name of the font
 //
 // Allowed game scenes: any.
-func (s *Line) ColorStream() (*krpcgo.Stream[types.Tuple3[float64, float64, float64]], error) {
+func (s *Text) FontAsync() (*krpcgo.Future[string], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Line_get_Color",
+		Procedure: "Text_get_Font",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -634,129 +5059,96 @@ func (s *Line) ColorStream() (*krpcgo.Stream[types.Tuple3[float64, float64, floa
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) types.Tuple3[float64, float64, float64] {
-		var value types.Tuple3[float64, float64, float64]
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (string, error) {
+		var vv string
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetColor - set the color
+// FontStream - name of the font
 //
 // Allowed game scenes: any.
-func (s *Line) SetColor(value types.Tuple3[float64, float64, float64]) error {
+func (s *Text) FontStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[string], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Line_set_Color",
+		Procedure: "Text_get_Font",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (string, error) {
+		var value string
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return stream, nil
 }
 
-// Thickness - set the thickness
-//
-// Allowed game scenes: any.
-func (s *Line) Thickness() (float32, error) {
+// FontCall builds a call to Font without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Text) FontCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv float32
 	request := &types.ProcedureCall{
-		Procedure: "Line_get_Thickness",
+		Procedure: "Text_get_Font",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
+	return request, nil
+}
+
+// DecodeFont decodes the result of a call built with FontCall.
+func (s *Text) DecodeFont(result *types.ProcedureResult) (string, error) {
+	var vv string
+	if err := krpcgo.ResultError(result); err != nil {
 		return vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
 	return vv, nil
 }
 
-// ThicknessStream - set the thickness
+// SetFont - name of the font
 //
 // Allowed game scenes: any.
-func (s *Line) ThicknessStream() (*krpcgo.Stream[float32], error) {
-	var err error
-	var argBytes []byte
-	request := &types.ProcedureCall{
-		Procedure: "Line_get_Thickness",
-		Service:   "Drawing",
-	}
-	argBytes, err = encode.Marshal(s)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x0),
-		Value:    argBytes,
-	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) float32 {
-		var value float32
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
-	})
-	return stream, nil
+func (s *Text) SetFont(value string) error {
+	return s.SetFontContext(context.Background(), value)
 }
 
-// SetThickness - set the thickness
+// SetFontContext - name of the font
 //
 // Allowed game scenes: any.
-func (s *Line) SetThickness(value float32) error {
+func (s *Text) SetFontContext(ctx context.Context, value string) error {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Line_set_Thickness",
+		Procedure: "Text_set_Font",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -775,57 +5167,58 @@ func (s *Line) SetThickness(value float32) error {
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
 		return tracerr.Wrap(err)
 	}
 	return nil
 }
 
-// ReferenceFrame - reference frame for the positions of the object.
+// SetFontAsync - name of the font
 //
 // Allowed game scenes: any.
-func (s *Line) ReferenceFrame() (*spacecenter.ReferenceFrame, error) {
+func (s *Text) SetFontAsync(value string) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
-	var vv spacecenter.ReferenceFrame
 	request := &types.ProcedureCall{
-		Procedure: "Line_get_ReferenceFrame",
+		Procedure: "Text_set_Font",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	vv.Client = s.Client
-	return &vv, nil
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
 }
 
-// SetReferenceFrame - reference frame for the positions of the object.
-//
-// Allowed game scenes: any.
-func (s *Line) SetReferenceFrame(value *spacecenter.ReferenceFrame) error {
+// SetFontCall builds a call to SetFont without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Text) SetFontCall(value string) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Line_set_ReferenceFrame",
+		Procedure: "Text_set_Font",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
@@ -833,28 +5226,31 @@ func (s *Line) SetReferenceFrame(value *spacecenter.ReferenceFrame) error {
 	})
 	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return request, nil
 }
 
-// Visible - whether the object is visible.
+// Size - font size.
 //
 // Allowed game scenes: any.
-func (s *Line) Visible() (bool, error) {
+func (s *Text) Size() (int32, error) {
+	return s.SizeContext(context.Background())
+}
+
+// SizeContext - font size.
+//
+// Allowed game scenes: any.
+func (s *Text) SizeContext(ctx context.Context) (int32, error) {
 	var err error
 	var argBytes []byte
-	var vv bool
+	var vv int32
 	request := &types.ProcedureCall{
-		Procedure: "Line_get_Visible",
+		Procedure: "Text_get_Size",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -865,7 +5261,7 @@ func (s *Line) Visible() (bool, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -876,14 +5272,14 @@ func (s *Line) Visible() (bool, error) {
 	return vv, nil
 }
 
-// VisibleStream - whether the object is visible.
+// SizeAsync - font size.
 //
 // Allowed game scenes: any.
-func (s *Line) VisibleStream() (*krpcgo.Stream[bool], error) {
+func (s *Text) SizeAsync() (*krpcgo.Future[int32], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Line_get_Visible",
+		Procedure: "Text_get_Size",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -894,96 +5290,129 @@ func (s *Line) VisibleStream() (*krpcgo.Stream[bool], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) bool {
-		var value bool
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (int32, error) {
+		var vv int32
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetVisible - whether the object is visible.
+// SizeStream - font size.
 //
 // Allowed game scenes: any.
-func (s *Line) SetVisible(value bool) error {
+func (s *Text) SizeStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[int32], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Line_set_Visible",
+		Procedure: "Text_get_Size",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (int32, error) {
+		var value int32
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// SizeCall builds a call to Size without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Text) SizeCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Text_get_Size",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
+		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	return request, nil
+}
+
+// DecodeSize decodes the result of a call built with SizeCall.
+func (s *Text) DecodeSize(result *types.ProcedureResult) (int32, error) {
+	var vv int32
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	return nil
+	return vv, nil
 }
 
-// Material - material used to render the object. Creates the material from a
-// shader with the given name.
+// SetSize - font size.
 //
 // Allowed game scenes: any.
-func (s *Line) Material() (string, error) {
+func (s *Text) SetSize(value int32) error {
+	return s.SetSizeContext(context.Background(), value)
+}
+
+// SetSizeContext - font size.
+//
+// Allowed game scenes: any.
+func (s *Text) SetSizeContext(ctx context.Context, value int32) error {
 	var err error
 	var argBytes []byte
-	var vv string
 	request := &types.ProcedureCall{
-		Procedure: "Line_get_Material",
+		Procedure: "Text_set_Size",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	return vv, nil
+	return nil
 }
 
-// MaterialStream - material used to render the object. Creates the material
-// from a shader with the given name.
+// SetSizeAsync - font size.
 //
 // Allowed game scenes: any.
-func (s *Line) MaterialStream() (*krpcgo.Stream[string], error) {
+func (s *Text) SetSizeAsync(value int32) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Line_get_Material",
+		Procedure: "Text_set_Size",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -994,37 +5423,33 @@ func (s *Line) MaterialStream() (*krpcgo.Stream[string], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) string {
-		var value string
-		encode.Unmarshal(b, &value)
-		return value
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
 	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetMaterial - material used to render the object. Creates the material from a
-// shader with the given name.
-//
-// Allowed game scenes: any.
-func (s *Line) SetMaterial(value string) error {
+// SetSizeCall builds a call to SetSize without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Text) SetSizeCall(value int32) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Line_set_Material",
+		Procedure: "Text_set_Size",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
@@ -1032,82 +5457,89 @@ func (s *Line) SetMaterial(value string) error {
 	})
 	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return request, nil
 }
 
-// Remove - remove the object.
+// CharacterSize - character size.
 //
 // Allowed game scenes: any.
-func (s *Polygon) Remove() error {
+func (s *Text) CharacterSize() (float32, error) {
+	return s.CharacterSizeContext(context.Background())
+}
+
+// CharacterSizeContext - character size.
+//
+// Allowed game scenes: any.
+func (s *Text) CharacterSizeContext(ctx context.Context) (float32, error) {
 	var err error
 	var argBytes []byte
+	var vv float32
 	request := &types.ProcedureCall{
-		Procedure: "Polygon_Remove",
+		Procedure: "Text_get_CharacterSize",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	return nil
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
 }
 
-// Vertices - vertices for the polygon.
+// CharacterSizeAsync - character size.
 //
 // Allowed game scenes: any.
-func (s *Polygon) Vertices() ([]types.Tuple3[float64, float64, float64], error) {
+func (s *Text) CharacterSizeAsync() (*krpcgo.Future[float32], error) {
 	var err error
 	var argBytes []byte
-	var vv []types.Tuple3[float64, float64, float64]
 	request := &types.ProcedureCall{
-		Procedure: "Polygon_get_Vertices",
+		Procedure: "Text_get_CharacterSize",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	return vv, nil
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (float32, error) {
+		var vv float32
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
 }
 
-// VerticesStream - vertices for the polygon.
+// CharacterSizeStream - character size.
 //
 // Allowed game scenes: any.
-func (s *Polygon) VerticesStream() (*krpcgo.Stream[[]types.Tuple3[float64, float64, float64]], error) {
+func (s *Text) CharacterSizeStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[float32], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Polygon_get_Vertices",
+		Procedure: "Text_get_CharacterSize",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1118,94 +5550,101 @@ func (s *Polygon) VerticesStream() (*krpcgo.Stream[[]types.Tuple3[float64, float
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) []types.Tuple3[float64, float64, float64] {
-		var value []types.Tuple3[float64, float64, float64]
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (float32, error) {
+		var value float32
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
 	return stream, nil
 }
 
-// SetVertices - vertices for the polygon.
-//
-// Allowed game scenes: any.
-func (s *Polygon) SetVertices(value []types.Tuple3[float64, float64, float64]) error {
+// CharacterSizeCall builds a call to CharacterSize without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *Text) CharacterSizeCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Polygon_set_Vertices",
+		Procedure: "Text_get_CharacterSize",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
-	if err != nil {
-		return tracerr.Wrap(err)
+	return request, nil
+}
+
+// DecodeCharacterSize decodes the result of a call built with
+// CharacterSizeCall.
+func (s *Text) DecodeCharacterSize(result *types.ProcedureResult) (float32, error) {
+	var vv float32
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
 	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
-	})
-	_, err = s.Client.Call(request)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	return nil
+	return vv, nil
 }
 
-// Color - set the color
+// SetCharacterSize - character size.
 //
 // Allowed game scenes: any.
-func (s *Polygon) Color() (types.Tuple3[float64, float64, float64], error) {
+func (s *Text) SetCharacterSize(value float32) error {
+	return s.SetCharacterSizeContext(context.Background(), value)
+}
+
+// SetCharacterSizeContext - character size.
+//
+// Allowed game scenes: any.
+func (s *Text) SetCharacterSizeContext(ctx context.Context, value float32) error {
 	var err error
 	var argBytes []byte
-	var vv types.Tuple3[float64, float64, float64]
 	request := &types.ProcedureCall{
-		Procedure: "Polygon_get_Color",
+		Procedure: "Text_set_CharacterSize",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	return vv, nil
+	return nil
 }
 
-// ColorStream - set the color
+// SetCharacterSizeAsync - character size.
 //
 // Allowed game scenes: any.
-func (s *Polygon) ColorStream() (*krpcgo.Stream[types.Tuple3[float64, float64, float64]], error) {
+func (s *Text) SetCharacterSizeAsync(value float32) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Polygon_get_Color",
+		Procedure: "Text_set_CharacterSize",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1216,36 +5655,33 @@ func (s *Polygon) ColorStream() (*krpcgo.Stream[types.Tuple3[float64, float64, f
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) types.Tuple3[float64, float64, float64] {
-		var value types.Tuple3[float64, float64, float64]
-		encode.Unmarshal(b, &value)
-		return value
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
 	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetColor - set the color
-//
-// Allowed game scenes: any.
-func (s *Polygon) SetColor(value types.Tuple3[float64, float64, float64]) error {
+// SetCharacterSizeCall builds a call to SetCharacterSize without making it,
+// such as to make several calls with CallMultiple, add a stream with AddStream
+// or use it in an expression.
+func (s *Text) SetCharacterSizeCall(value float32) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Polygon_set_Color",
+		Procedure: "Text_set_CharacterSize",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
@@ -1253,28 +5689,31 @@ func (s *Polygon) SetColor(value types.Tuple3[float64, float64, float64]) error
 	})
 	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return request, nil
 }
 
-// Thickness - set the thickness
+// Style - font style.
 //
 // Allowed game scenes: any.
-func (s *Polygon) Thickness() (float32, error) {
+func (s *Text) Style() (ui.FontStyle, error) {
+	return s.StyleContext(context.Background())
+}
+
+// StyleContext - font style.
+//
+// Allowed game scenes: any.
+func (s *Text) StyleContext(ctx context.Context) (ui.FontStyle, error) {
 	var err error
 	var argBytes []byte
-	var vv float32
+	var vv ui.FontStyle
 	request := &types.ProcedureCall{
-		Procedure: "Polygon_get_Thickness",
+		Procedure: "Text_get_Style",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1285,7 +5724,7 @@ func (s *Polygon) Thickness() (float32, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -1296,14 +5735,14 @@ func (s *Polygon) Thickness() (float32, error) {
 	return vv, nil
 }
 
-// ThicknessStream - set the thickness
+// StyleAsync - font style.
 //
 // Allowed game scenes: any.
-func (s *Polygon) ThicknessStream() (*krpcgo.Stream[float32], error) {
+func (s *Text) StyleAsync() (*krpcgo.Future[ui.FontStyle], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Polygon_get_Thickness",
+		Procedure: "Text_get_Style",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1314,95 +5753,96 @@ func (s *Polygon) ThicknessStream() (*krpcgo.Stream[float32], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) float32 {
-		var value float32
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (ui.FontStyle, error) {
+		var vv ui.FontStyle
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetThickness - set the thickness
+// StyleStream - font style.
 //
 // Allowed game scenes: any.
-func (s *Polygon) SetThickness(value float32) error {
+func (s *Text) StyleStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[ui.FontStyle], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Polygon_set_Thickness",
+		Procedure: "Text_get_Style",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (ui.FontStyle, error) {
+		var value ui.FontStyle
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return stream, nil
 }
 
-// ReferenceFrame - reference frame for the positions of the object.
-//
-// Allowed game scenes: any.
-func (s *Polygon) ReferenceFrame() (*spacecenter.ReferenceFrame, error) {
+// StyleCall builds a call to Style without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Text) StyleCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv spacecenter.ReferenceFrame
 	request := &types.ProcedureCall{
-		Procedure: "Polygon_get_ReferenceFrame",
+		Procedure: "Text_get_Style",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
+	return request, nil
+}
+
+// DecodeStyle decodes the result of a call built with StyleCall.
+func (s *Text) DecodeStyle(result *types.ProcedureResult) (ui.FontStyle, error) {
+	var vv ui.FontStyle
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	vv.Client = s.Client
-	return &vv, nil
+	return vv, nil
 }
 
-// SetReferenceFrame - reference frame for the positions of the object.
+// SetStyle - font style.
 //
 // Allowed game scenes: any.
-func (s *Polygon) SetReferenceFrame(value *spacecenter.ReferenceFrame) error {
+func (s *Text) SetStyle(value ui.FontStyle) error {
+	return s.SetStyleContext(context.Background(), value)
+}
+
+// SetStyleContext - font style.
+//
+// Allowed game scenes: any.
+func (s *Text) SetStyleContext(ctx context.Context, value ui.FontStyle) error {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Polygon_set_ReferenceFrame",
+		Procedure: "Text_set_Style",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1421,91 +5861,58 @@ func (s *Polygon) SetReferenceFrame(value *spacecenter.ReferenceFrame) error {
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
 		return tracerr.Wrap(err)
 	}
 	return nil
 }
 
-// Visible - whether the object is visible.
+// SetStyleAsync - font style.
 //
 // Allowed game scenes: any.
-func (s *Polygon) Visible() (bool, error) {
+func (s *Text) SetStyleAsync(value ui.FontStyle) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
-	var vv bool
 	request := &types.ProcedureCall{
-		Procedure: "Polygon_get_Visible",
+		Procedure: "Text_set_Style",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	return vv, nil
-}
-
-// VisibleStream - whether the object is visible.
-//
-// Allowed game scenes: any.
-func (s *Polygon) VisibleStream() (*krpcgo.Stream[bool], error) {
-	var err error
-	var argBytes []byte
-	request := &types.ProcedureCall{
-		Procedure: "Polygon_get_Visible",
-		Service:   "Drawing",
-	}
-	argBytes, err = encode.Marshal(s)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x0),
+		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) bool {
-		var value bool
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetVisible - whether the object is visible.
-//
-// Allowed game scenes: any.
-func (s *Polygon) SetVisible(value bool) error {
+// SetStyleCall builds a call to SetStyle without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Text) SetStyleCall(value ui.FontStyle) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Polygon_set_Visible",
+		Procedure: "Text_set_Style",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
@@ -1513,29 +5920,31 @@ func (s *Polygon) SetVisible(value bool) error {
 	})
 	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return request, nil
 }
 
-// Material - material used to render the object. Creates the material from a
-// shader with the given name.
+// Alignment - alignment.
 //
 // Allowed game scenes: any.
-func (s *Polygon) Material() (string, error) {
+func (s *Text) Alignment() (ui.TextAlignment, error) {
+	return s.AlignmentContext(context.Background())
+}
+
+// AlignmentContext - alignment.
+//
+// Allowed game scenes: any.
+func (s *Text) AlignmentContext(ctx context.Context) (ui.TextAlignment, error) {
 	var err error
 	var argBytes []byte
-	var vv string
+	var vv ui.TextAlignment
 	request := &types.ProcedureCall{
-		Procedure: "Polygon_get_Material",
+		Procedure: "Text_get_Alignment",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1546,7 +5955,7 @@ func (s *Polygon) Material() (string, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -1557,15 +5966,14 @@ func (s *Polygon) Material() (string, error) {
 	return vv, nil
 }
 
-// MaterialStream - material used to render the object. Creates the material
-// from a shader with the given name.
+// AlignmentAsync - alignment.
 //
 // Allowed game scenes: any.
-func (s *Polygon) MaterialStream() (*krpcgo.Stream[string], error) {
+func (s *Text) AlignmentAsync() (*krpcgo.Future[ui.TextAlignment], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Polygon_get_Material",
+		Procedure: "Text_get_Alignment",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1576,112 +5984,96 @@ func (s *Polygon) MaterialStream() (*krpcgo.Stream[string], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) string {
-		var value string
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (ui.TextAlignment, error) {
+		var vv ui.TextAlignment
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetMaterial - material used to render the object. Creates the material from a
-// shader with the given name.
+// AlignmentStream - alignment.
 //
 // Allowed game scenes: any.
-func (s *Polygon) SetMaterial(value string) error {
+func (s *Text) AlignmentStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[ui.TextAlignment], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Polygon_set_Material",
+		Procedure: "Text_get_Alignment",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (ui.TextAlignment, error) {
+		var value ui.TextAlignment
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return stream, nil
 }
 
-// AvailableFonts - a list of all available fonts.
-//
-// Allowed game scenes: any.
-func (s *Text) AvailableFonts() ([]string, error) {
+// AlignmentCall builds a call to Alignment without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Text) AlignmentCall() (*types.ProcedureCall, error) {
 	var err error
-	var vv []string
+	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_static_AvailableFonts",
+		Procedure: "Text_get_Alignment",
 		Service:   "Drawing",
 	}
-	result, err := s.Client.Call(request)
+	argBytes, err = encode.Marshal(s)
 	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeAlignment decodes the result of a call built with AlignmentCall.
+func (s *Text) DecodeAlignment(result *types.ProcedureResult) (ui.TextAlignment, error) {
+	var vv ui.TextAlignment
+	if err := krpcgo.ResultError(result); err != nil {
 		return vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
 	return vv, nil
 }
 
-// AvailableFontsStream - a list of all available fonts.
+// SetAlignment - alignment.
 //
 // Allowed game scenes: any.
-func (s *Text) AvailableFontsStream() (*krpcgo.Stream[[]string], error) {
-	var err error
-	request := &types.ProcedureCall{
-		Procedure: "Text_static_AvailableFonts",
-		Service:   "Drawing",
-	}
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) []string {
-		var value []string
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
-	})
-	return stream, nil
+func (s *Text) SetAlignment(value ui.TextAlignment) error {
+	return s.SetAlignmentContext(context.Background(), value)
 }
 
-// Remove - remove the object.
+// SetAlignmentContext - alignment.
 //
 // Allowed game scenes: any.
-func (s *Text) Remove() error {
+func (s *Text) SetAlignmentContext(ctx context.Context, value ui.TextAlignment) error {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_Remove",
+		Procedure: "Text_set_Alignment",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1692,51 +6084,29 @@ func (s *Text) Remove() error {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
 		return tracerr.Wrap(err)
 	}
-	return nil
-}
-
-// Position - position of the text.
-//
-// Allowed game scenes: any.
-func (s *Text) Position() (types.Tuple3[float64, float64, float64], error) {
-	var err error
-	var argBytes []byte
-	var vv types.Tuple3[float64, float64, float64]
-	request := &types.ProcedureCall{
-		Procedure: "Text_get_Position",
-		Service:   "Drawing",
-	}
-	argBytes, err = encode.Marshal(s)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
 	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x0),
+		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	return vv, nil
+	return nil
 }
 
-// PositionStream - position of the text.
+// SetAlignmentAsync - alignment.
 //
 // Allowed game scenes: any.
-func (s *Text) PositionStream() (*krpcgo.Stream[types.Tuple3[float64, float64, float64]], error) {
+func (s *Text) SetAlignmentAsync(value ui.TextAlignment) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_Position",
+		Procedure: "Text_set_Alignment",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1747,36 +6117,33 @@ func (s *Text) PositionStream() (*krpcgo.Stream[types.Tuple3[float64, float64, f
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) types.Tuple3[float64, float64, float64] {
-		var value types.Tuple3[float64, float64, float64]
-		encode.Unmarshal(b, &value)
-		return value
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
 	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetPosition - position of the text.
-//
-// Allowed game scenes: any.
-func (s *Text) SetPosition(value types.Tuple3[float64, float64, float64]) error {
+// SetAlignmentCall builds a call to SetAlignment without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *Text) SetAlignmentCall(value ui.TextAlignment) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_set_Position",
+		Procedure: "Text_set_Alignment",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
@@ -1784,28 +6151,31 @@ func (s *Text) SetPosition(value types.Tuple3[float64, float64, float64]) error
 	})
 	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return request, nil
 }
 
-// Rotation - rotation of the text as a quaternion.
+// LineSpacing - line spacing.
 //
 // Allowed game scenes: any.
-func (s *Text) Rotation() (types.Tuple4[float64, float64, float64, float64], error) {
+func (s *Text) LineSpacing() (float32, error) {
+	return s.LineSpacingContext(context.Background())
+}
+
+// LineSpacingContext - line spacing.
+//
+// Allowed game scenes: any.
+func (s *Text) LineSpacingContext(ctx context.Context) (float32, error) {
 	var err error
 	var argBytes []byte
-	var vv types.Tuple4[float64, float64, float64, float64]
+	var vv float32
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_Rotation",
+		Procedure: "Text_get_LineSpacing",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1816,7 +6186,7 @@ func (s *Text) Rotation() (types.Tuple4[float64, float64, float64, float64], err
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -1827,14 +6197,14 @@ func (s *Text) Rotation() (types.Tuple4[float64, float64, float64, float64], err
 	return vv, nil
 }
 
-// RotationStream - rotation of the text as a quaternion.
+// LineSpacingAsync - line spacing.
 //
 // Allowed game scenes: any.
-func (s *Text) RotationStream() (*krpcgo.Stream[types.Tuple4[float64, float64, float64, float64]], error) {
+func (s *Text) LineSpacingAsync() (*krpcgo.Future[float32], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_Rotation",
+		Procedure: "Text_get_LineSpacing",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1845,94 +6215,129 @@ func (s *Text) RotationStream() (*krpcgo.Stream[types.Tuple4[float64, float64, f
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) types.Tuple4[float64, float64, float64, float64] {
-		var value types.Tuple4[float64, float64, float64, float64]
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (float32, error) {
+		var vv float32
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetRotation - rotation of the text as a quaternion.
+// LineSpacingStream - line spacing.
 //
 // Allowed game scenes: any.
-func (s *Text) SetRotation(value types.Tuple4[float64, float64, float64, float64]) error {
+func (s *Text) LineSpacingStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[float32], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_set_Rotation",
+		Procedure: "Text_get_LineSpacing",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (float32, error) {
+		var value float32
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// LineSpacingCall builds a call to LineSpacing without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *Text) LineSpacingCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Text_get_LineSpacing",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
+		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	return request, nil
+}
+
+// DecodeLineSpacing decodes the result of a call built with LineSpacingCall.
+func (s *Text) DecodeLineSpacing(result *types.ProcedureResult) (float32, error) {
+	var vv float32
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	return nil
+	return vv, nil
 }
 
-// Content - the text string
+// SetLineSpacing - line spacing.
 //
 // Allowed game scenes: any.
-func (s *Text) Content() (string, error) {
+func (s *Text) SetLineSpacing(value float32) error {
+	return s.SetLineSpacingContext(context.Background(), value)
+}
+
+// SetLineSpacingContext - line spacing.
+//
+// Allowed game scenes: any.
+func (s *Text) SetLineSpacingContext(ctx context.Context, value float32) error {
 	var err error
 	var argBytes []byte
-	var vv string
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_Content",
+		Procedure: "Text_set_LineSpacing",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	return vv, nil
+	return nil
 }
 
-// ContentStream - the text string
+// SetLineSpacingAsync - line spacing.
 //
 // Allowed game scenes: any.
-func (s *Text) ContentStream() (*krpcgo.Stream[string], error) {
+func (s *Text) SetLineSpacingAsync(value float32) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_Content",
+		Procedure: "Text_set_LineSpacing",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1943,36 +6348,33 @@ func (s *Text) ContentStream() (*krpcgo.Stream[string], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) string {
-		var value string
-		encode.Unmarshal(b, &value)
-		return value
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
 	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetContent - the text string
-//
-// Allowed game scenes: any.
-func (s *Text) SetContent(value string) error {
+// SetLineSpacingCall builds a call to SetLineSpacing without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *Text) SetLineSpacingCall(value float32) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_set_Content",
+		Procedure: "Text_set_LineSpacing",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
@@ -1980,28 +6382,31 @@ func (s *Text) SetContent(value string) error {
 	})
 	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return request, nil
 }
 
-// Font - name of the font
+// Anchor - anchor.
 //
 // Allowed game scenes: any.
-func (s *Text) Font() (string, error) {
+func (s *Text) Anchor() (ui.TextAnchor, error) {
+	return s.AnchorContext(context.Background())
+}
+
+// AnchorContext - anchor.
+//
+// Allowed game scenes: any.
+func (s *Text) AnchorContext(ctx context.Context) (ui.TextAnchor, error) {
 	var err error
 	var argBytes []byte
-	var vv string
+	var vv ui.TextAnchor
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_Font",
+		Procedure: "Text_get_Anchor",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2012,7 +6417,7 @@ func (s *Text) Font() (string, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -2023,14 +6428,43 @@ func (s *Text) Font() (string, error) {
 	return vv, nil
 }
 
-// FontStream - name of the font
+// AnchorAsync - anchor.
+//
+// Allowed game scenes: any.
+func (s *Text) AnchorAsync() (*krpcgo.Future[ui.TextAnchor], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Text_get_Anchor",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (ui.TextAnchor, error) {
+		var vv ui.TextAnchor
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// AnchorStream - anchor.
 //
 // Allowed game scenes: any.
-func (s *Text) FontStream() (*krpcgo.Stream[string], error) {
+func (s *Text) AnchorStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[ui.TextAnchor], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_Font",
+		Procedure: "Text_get_Anchor",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2041,94 +6475,100 @@ func (s *Text) FontStream() (*krpcgo.Stream[string], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) string {
-		var value string
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (ui.TextAnchor, error) {
+		var value ui.TextAnchor
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
 	return stream, nil
 }
 
-// SetFont - name of the font
-//
-// Allowed game scenes: any.
-func (s *Text) SetFont(value string) error {
+// AnchorCall builds a call to Anchor without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Text) AnchorCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_set_Font",
+		Procedure: "Text_get_Anchor",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
-	if err != nil {
-		return tracerr.Wrap(err)
+	return request, nil
+}
+
+// DecodeAnchor decodes the result of a call built with AnchorCall.
+func (s *Text) DecodeAnchor(result *types.ProcedureResult) (ui.TextAnchor, error) {
+	var vv ui.TextAnchor
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
 	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
-	})
-	_, err = s.Client.Call(request)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	return nil
+	return vv, nil
 }
 
-// Size - font size.
+// SetAnchor - anchor.
 //
 // Allowed game scenes: any.
-func (s *Text) Size() (int32, error) {
+func (s *Text) SetAnchor(value ui.TextAnchor) error {
+	return s.SetAnchorContext(context.Background(), value)
+}
+
+// SetAnchorContext - anchor.
+//
+// Allowed game scenes: any.
+func (s *Text) SetAnchorContext(ctx context.Context, value ui.TextAnchor) error {
 	var err error
 	var argBytes []byte
-	var vv int32
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_Size",
+		Procedure: "Text_set_Anchor",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	return vv, nil
+	return nil
 }
 
-// SizeStream - font size.
+// SetAnchorAsync - anchor.
 //
 // Allowed game scenes: any.
-func (s *Text) SizeStream() (*krpcgo.Stream[int32], error) {
+func (s *Text) SetAnchorAsync(value ui.TextAnchor) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_Size",
+		Procedure: "Text_set_Anchor",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2139,36 +6579,33 @@ func (s *Text) SizeStream() (*krpcgo.Stream[int32], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) int32 {
-		var value int32
-		encode.Unmarshal(b, &value)
-		return value
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
 	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetSize - font size.
-//
-// Allowed game scenes: any.
-func (s *Text) SetSize(value int32) error {
+// SetAnchorCall builds a call to SetAnchor without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Text) SetAnchorCall(value ui.TextAnchor) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_set_Size",
+		Procedure: "Text_set_Anchor",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
@@ -2176,28 +6613,31 @@ func (s *Text) SetSize(value int32) error {
 	})
 	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return request, nil
 }
 
-// CharacterSize - character size.
+// Color - set the color
 //
 // Allowed game scenes: any.
-func (s *Text) CharacterSize() (float32, error) {
+func (s *Text) Color() (types.Tuple3[float64, float64, float64], error) {
+	return s.ColorContext(context.Background())
+}
+
+// ColorContext - set the color
+//
+// Allowed game scenes: any.
+func (s *Text) ColorContext(ctx context.Context) (types.Tuple3[float64, float64, float64], error) {
 	var err error
 	var argBytes []byte
-	var vv float32
+	var vv types.Tuple3[float64, float64, float64]
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_CharacterSize",
+		Procedure: "Text_get_Color",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2208,7 +6648,7 @@ func (s *Text) CharacterSize() (float32, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -2219,14 +6659,14 @@ func (s *Text) CharacterSize() (float32, error) {
 	return vv, nil
 }
 
-// CharacterSizeStream - character size.
+// ColorAsync - set the color
 //
 // Allowed game scenes: any.
-func (s *Text) CharacterSizeStream() (*krpcgo.Stream[float32], error) {
+func (s *Text) ColorAsync() (*krpcgo.Future[types.Tuple3[float64, float64, float64]], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_CharacterSize",
+		Procedure: "Text_get_Color",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2237,94 +6677,129 @@ func (s *Text) CharacterSizeStream() (*krpcgo.Stream[float32], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) float32 {
-		var value float32
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (types.Tuple3[float64, float64, float64], error) {
+		var vv types.Tuple3[float64, float64, float64]
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetCharacterSize - character size.
+// ColorStream - set the color
 //
 // Allowed game scenes: any.
-func (s *Text) SetCharacterSize(value float32) error {
+func (s *Text) ColorStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[types.Tuple3[float64, float64, float64]], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_set_CharacterSize",
+		Procedure: "Text_get_Color",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (types.Tuple3[float64, float64, float64], error) {
+		var value types.Tuple3[float64, float64, float64]
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// ColorCall builds a call to Color without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Text) ColorCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Text_get_Color",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
+		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	return request, nil
+}
+
+// DecodeColor decodes the result of a call built with ColorCall.
+func (s *Text) DecodeColor(result *types.ProcedureResult) (types.Tuple3[float64, float64, float64], error) {
+	var vv types.Tuple3[float64, float64, float64]
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	return nil
+	return vv, nil
 }
 
-// Style - font style.
+// SetColor - set the color
 //
 // Allowed game scenes: any.
-func (s *Text) Style() (ui.FontStyle, error) {
+func (s *Text) SetColor(value types.Tuple3[float64, float64, float64]) error {
+	return s.SetColorContext(context.Background(), value)
+}
+
+// SetColorContext - set the color
+//
+// Allowed game scenes: any.
+func (s *Text) SetColorContext(ctx context.Context, value types.Tuple3[float64, float64, float64]) error {
 	var err error
 	var argBytes []byte
-	var vv ui.FontStyle
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_Style",
+		Procedure: "Text_set_Color",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	return vv, nil
+	return nil
 }
 
-// StyleStream - font style.
+// SetColorAsync - set the color
 //
 // Allowed game scenes: any.
-func (s *Text) StyleStream() (*krpcgo.Stream[ui.FontStyle], error) {
+func (s *Text) SetColorAsync(value types.Tuple3[float64, float64, float64]) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_Style",
+		Procedure: "Text_set_Color",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2335,36 +6810,33 @@ func (s *Text) StyleStream() (*krpcgo.Stream[ui.FontStyle], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) ui.FontStyle {
-		var value ui.FontStyle
-		encode.Unmarshal(b, &value)
-		return value
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
 	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetStyle - font style.
-//
-// Allowed game scenes: any.
-func (s *Text) SetStyle(value ui.FontStyle) error {
+// SetColorCall builds a call to SetColor without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Text) SetColorCall(value types.Tuple3[float64, float64, float64]) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_set_Style",
+		Procedure: "Text_set_Color",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
@@ -2372,57 +6844,61 @@ func (s *Text) SetStyle(value ui.FontStyle) error {
 	})
 	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return request, nil
 }
 
-// Alignment - alignment.
+// ReferenceFrame - reference frame for the positions of the object.
 //
 // Allowed game scenes: any.
-func (s *Text) Alignment() (ui.TextAlignment, error) {
+func (s *Text) ReferenceFrame() (*spacecenter.ReferenceFrame, error) {
+	return s.ReferenceFrameContext(context.Background())
+}
+
+// ReferenceFrameContext - reference frame for the positions of the object.
+//
+// Allowed game scenes: any.
+func (s *Text) ReferenceFrameContext(ctx context.Context) (*spacecenter.ReferenceFrame, error) {
 	var err error
 	var argBytes []byte
-	var vv ui.TextAlignment
+	var vv spacecenter.ReferenceFrame
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_Alignment",
+		Procedure: "Text_get_ReferenceFrame",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return &vv, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return &vv, tracerr.Wrap(err)
 	}
 	err = encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return &vv, tracerr.Wrap(err)
 	}
-	return vv, nil
+	vv.Client = s.Client
+	return &vv, nil
 }
 
-// AlignmentStream - alignment.
+// ReferenceFrameAsync - reference frame for the positions of the object.
 //
 // Allowed game scenes: any.
-func (s *Text) AlignmentStream() (*krpcgo.Stream[ui.TextAlignment], error) {
+func (s *Text) ReferenceFrameAsync() (*krpcgo.Future[*spacecenter.ReferenceFrame], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_Alignment",
+		Procedure: "Text_get_ReferenceFrame",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2433,94 +6909,102 @@ func (s *Text) AlignmentStream() (*krpcgo.Stream[ui.TextAlignment], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) ui.TextAlignment {
-		var value ui.TextAlignment
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*spacecenter.ReferenceFrame, error) {
+		var vv spacecenter.ReferenceFrame
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetAlignment - alignment.
-//
-// Allowed game scenes: any.
-func (s *Text) SetAlignment(value ui.TextAlignment) error {
+// ReferenceFrameCall builds a call to ReferenceFrame without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *Text) ReferenceFrameCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_set_Alignment",
+		Procedure: "Text_get_ReferenceFrame",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
-	if err != nil {
-		return tracerr.Wrap(err)
+	return request, nil
+}
+
+// DecodeReferenceFrame decodes the result of a call built with
+// ReferenceFrameCall.
+func (s *Text) DecodeReferenceFrame(result *types.ProcedureResult) (*spacecenter.ReferenceFrame, error) {
+	var vv spacecenter.ReferenceFrame
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
 	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
-	})
-	_, err = s.Client.Call(request)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return &vv, tracerr.Wrap(err)
 	}
-	return nil
+	vv.Client = s.Client
+	return &vv, nil
 }
 
-// LineSpacing - line spacing.
+// SetReferenceFrame - reference frame for the positions of the object.
 //
 // Allowed game scenes: any.
-func (s *Text) LineSpacing() (float32, error) {
+func (s *Text) SetReferenceFrame(value *spacecenter.ReferenceFrame) error {
+	return s.SetReferenceFrameContext(context.Background(), value)
+}
+
+// SetReferenceFrameContext - reference frame for the positions of the object.
+//
+// Allowed game scenes: any.
+func (s *Text) SetReferenceFrameContext(ctx context.Context, value *spacecenter.ReferenceFrame) error {
 	var err error
 	var argBytes []byte
-	var vv float32
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_LineSpacing",
+		Procedure: "Text_set_ReferenceFrame",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	return vv, nil
+	return nil
 }
 
-// LineSpacingStream - line spacing.
+// SetReferenceFrameAsync - reference frame for the positions of the object.
 //
 // Allowed game scenes: any.
-func (s *Text) LineSpacingStream() (*krpcgo.Stream[float32], error) {
+func (s *Text) SetReferenceFrameAsync(value *spacecenter.ReferenceFrame) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_LineSpacing",
+		Procedure: "Text_set_ReferenceFrame",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2531,36 +7015,33 @@ func (s *Text) LineSpacingStream() (*krpcgo.Stream[float32], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) float32 {
-		var value float32
-		encode.Unmarshal(b, &value)
-		return value
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
 	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetLineSpacing - line spacing.
-//
-// Allowed game scenes: any.
-func (s *Text) SetLineSpacing(value float32) error {
+// SetReferenceFrameCall builds a call to SetReferenceFrame without making it,
+// such as to make several calls with CallMultiple, add a stream with AddStream
+// or use it in an expression.
+func (s *Text) SetReferenceFrameCall(value *spacecenter.ReferenceFrame) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_set_LineSpacing",
+		Procedure: "Text_set_ReferenceFrame",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
@@ -2568,28 +7049,31 @@ func (s *Text) SetLineSpacing(value float32) error {
 	})
 	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return request, nil
 }
 
-// Anchor - anchor.
+// Visible - whether the object is visible.
 //
 // Allowed game scenes: any.
-func (s *Text) Anchor() (ui.TextAnchor, error) {
+func (s *Text) Visible() (bool, error) {
+	return s.VisibleContext(context.Background())
+}
+
+// VisibleContext - whether the object is visible.
+//
+// Allowed game scenes: any.
+func (s *Text) VisibleContext(ctx context.Context) (bool, error) {
 	var err error
 	var argBytes []byte
-	var vv ui.TextAnchor
+	var vv bool
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_Anchor",
+		Procedure: "Text_get_Visible",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2600,7 +7084,7 @@ func (s *Text) Anchor() (ui.TextAnchor, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -2611,14 +7095,14 @@ func (s *Text) Anchor() (ui.TextAnchor, error) {
 	return vv, nil
 }
 
-// AnchorStream - anchor.
+// VisibleAsync - whether the object is visible.
 //
 // Allowed game scenes: any.
-func (s *Text) AnchorStream() (*krpcgo.Stream[ui.TextAnchor], error) {
+func (s *Text) VisibleAsync() (*krpcgo.Future[bool], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_Anchor",
+		Procedure: "Text_get_Visible",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2629,129 +7113,96 @@ func (s *Text) AnchorStream() (*krpcgo.Stream[ui.TextAnchor], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) ui.TextAnchor {
-		var value ui.TextAnchor
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (bool, error) {
+		var vv bool
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetAnchor - anchor.
+// VisibleStream - whether the object is visible.
 //
 // Allowed game scenes: any.
-func (s *Text) SetAnchor(value ui.TextAnchor) error {
+func (s *Text) VisibleStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[bool], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_set_Anchor",
+		Procedure: "Text_get_Visible",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (bool, error) {
+		var value bool
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return stream, nil
 }
 
-// Color - set the color
-//
-// Allowed game scenes: any.
-func (s *Text) Color() (types.Tuple3[float64, float64, float64], error) {
+// VisibleCall builds a call to Visible without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Text) VisibleCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv types.Tuple3[float64, float64, float64]
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_Color",
+		Procedure: "Text_get_Visible",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
+	return request, nil
+}
+
+// DecodeVisible decodes the result of a call built with VisibleCall.
+func (s *Text) DecodeVisible(result *types.ProcedureResult) (bool, error) {
+	var vv bool
+	if err := krpcgo.ResultError(result); err != nil {
 		return vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
 	return vv, nil
 }
 
-// ColorStream - set the color
+// SetVisible - whether the object is visible.
 //
 // Allowed game scenes: any.
-func (s *Text) ColorStream() (*krpcgo.Stream[types.Tuple3[float64, float64, float64]], error) {
-	var err error
-	var argBytes []byte
-	request := &types.ProcedureCall{
-		Procedure: "Text_get_Color",
-		Service:   "Drawing",
-	}
-	argBytes, err = encode.Marshal(s)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x0),
-		Value:    argBytes,
-	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) types.Tuple3[float64, float64, float64] {
-		var value types.Tuple3[float64, float64, float64]
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
-	})
-	return stream, nil
+func (s *Text) SetVisible(value bool) error {
+	return s.SetVisibleContext(context.Background(), value)
 }
 
-// SetColor - set the color
+// SetVisibleContext - whether the object is visible.
 //
 // Allowed game scenes: any.
-func (s *Text) SetColor(value types.Tuple3[float64, float64, float64]) error {
+func (s *Text) SetVisibleContext(ctx context.Context, value bool) error {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_set_Color",
+		Procedure: "Text_set_Visible",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2770,57 +7221,58 @@ func (s *Text) SetColor(value types.Tuple3[float64, float64, float64]) error {
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
 		return tracerr.Wrap(err)
 	}
 	return nil
 }
 
-// ReferenceFrame - reference frame for the positions of the object.
+// SetVisibleAsync - whether the object is visible.
 //
 // Allowed game scenes: any.
-func (s *Text) ReferenceFrame() (*spacecenter.ReferenceFrame, error) {
+func (s *Text) SetVisibleAsync(value bool) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
-	var vv spacecenter.ReferenceFrame
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_ReferenceFrame",
+		Procedure: "Text_set_Visible",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	vv.Client = s.Client
-	return &vv, nil
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
 }
 
-// SetReferenceFrame - reference frame for the positions of the object.
-//
-// Allowed game scenes: any.
-func (s *Text) SetReferenceFrame(value *spacecenter.ReferenceFrame) error {
+// SetVisibleCall builds a call to SetVisible without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Text) SetVisibleCall(value bool) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_set_ReferenceFrame",
+		Procedure: "Text_set_Visible",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
@@ -2828,28 +7280,33 @@ func (s *Text) SetReferenceFrame(value *spacecenter.ReferenceFrame) error {
 	})
 	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return request, nil
 }
 
-// Visible - whether the object is visible.
+// Material - material used to render the object. Creates the material from a
+// shader with the given name.
 //
 // Allowed game scenes: any.
-func (s *Text) Visible() (bool, error) {
+func (s *Text) Material() (string, error) {
+	return s.MaterialContext(context.Background())
+}
+
+// MaterialContext - material used to render the object. Creates the material
+// from a shader with the given name.
+//
+// Allowed game scenes: any.
+func (s *Text) MaterialContext(ctx context.Context) (string, error) {
 	var err error
 	var argBytes []byte
-	var vv bool
+	var vv string
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_Visible",
+		Procedure: "Text_get_Material",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2860,7 +7317,7 @@ func (s *Text) Visible() (bool, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -2871,14 +7328,15 @@ func (s *Text) Visible() (bool, error) {
 	return vv, nil
 }
 
-// VisibleStream - whether the object is visible.
+// MaterialAsync - material used to render the object. Creates the material from
+// a shader with the given name.
 //
 // Allowed game scenes: any.
-func (s *Text) VisibleStream() (*krpcgo.Stream[bool], error) {
+func (s *Text) MaterialAsync() (*krpcgo.Future[string], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_Visible",
+		Procedure: "Text_get_Material",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2889,96 +7347,133 @@ func (s *Text) VisibleStream() (*krpcgo.Stream[bool], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) bool {
-		var value bool
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (string, error) {
+		var vv string
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetVisible - whether the object is visible.
+// MaterialStream - material used to render the object. Creates the material
+// from a shader with the given name.
 //
 // Allowed game scenes: any.
-func (s *Text) SetVisible(value bool) error {
+func (s *Text) MaterialStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[string], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_set_Visible",
+		Procedure: "Text_get_Material",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (string, error) {
+		var value string
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// MaterialCall builds a call to Material without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Text) MaterialCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Text_get_Material",
+		Service:   "Drawing",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
+		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	return request, nil
+}
+
+// DecodeMaterial decodes the result of a call built with MaterialCall.
+func (s *Text) DecodeMaterial(result *types.ProcedureResult) (string, error) {
+	var vv string
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	return nil
+	return vv, nil
 }
 
-// Material - material used to render the object. Creates the material from a
+// SetMaterial - material used to render the object. Creates the material from a
 // shader with the given name.
 //
 // Allowed game scenes: any.
-func (s *Text) Material() (string, error) {
+func (s *Text) SetMaterial(value string) error {
+	return s.SetMaterialContext(context.Background(), value)
+}
+
+// SetMaterialContext - material used to render the object. Creates the material
+// from a shader with the given name.
+//
+// Allowed game scenes: any.
+func (s *Text) SetMaterialContext(ctx context.Context, value string) error {
 	var err error
 	var argBytes []byte
-	var vv string
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_Material",
+		Procedure: "Text_set_Material",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	return vv, nil
+	return nil
 }
 
-// MaterialStream - material used to render the object. Creates the material
+// SetMaterialAsync - material used to render the object. Creates the material
 // from a shader with the given name.
 //
 // Allowed game scenes: any.
-func (s *Text) MaterialStream() (*krpcgo.Stream[string], error) {
+func (s *Text) SetMaterialAsync(value string) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_Material",
+		Procedure: "Text_set_Material",
 		Service:   "Drawing",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2989,28 +7484,24 @@ func (s *Text) MaterialStream() (*krpcgo.Stream[string], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) string {
-		var value string
-		encode.Unmarshal(b, &value)
-		return value
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
 	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetMaterial - material used to render the object. Creates the material from a
-// shader with the given name.
-//
-// Allowed game scenes: any.
-func (s *Text) SetMaterial(value string) error {
+// SetMaterialCall builds a call to SetMaterial without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *Text) SetMaterialCall(value string) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
@@ -3019,7 +7510,7 @@ func (s *Text) SetMaterial(value string) error {
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
@@ -3027,15 +7518,122 @@ func (s *Text) SetMaterial(value string) error {
 	})
 	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return request, nil
+}
+func init() {
+	krpcgo.RegisterReturnTypes("Drawing", map[string]*types.Type{
+		"AddDirection": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Line",
+			Service: "Drawing",
+		},
+		"AddDirectionFromCom": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Line",
+			Service: "Drawing",
+		},
+		"AddLine": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Line",
+			Service: "Drawing",
+		},
+		"AddPolygon": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Polygon",
+			Service: "Drawing",
+		},
+		"AddText": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Text",
+			Service: "Drawing",
+		},
+		"Line_get_Color": &types.Type{
+			Code:  types.Type_TUPLE,
+			Types: []*types.Type{&types.Type{Code: types.Type_DOUBLE}, &types.Type{Code: types.Type_DOUBLE}, &types.Type{Code: types.Type_DOUBLE}},
+		},
+		"Line_get_End": &types.Type{
+			Code:  types.Type_TUPLE,
+			Types: []*types.Type{&types.Type{Code: types.Type_DOUBLE}, &types.Type{Code: types.Type_DOUBLE}, &types.Type{Code: types.Type_DOUBLE}},
+		},
+		"Line_get_Material": &types.Type{Code: types.Type_STRING},
+		"Line_get_ReferenceFrame": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "ReferenceFrame",
+			Service: "SpaceCenter",
+		},
+		"Line_get_Start": &types.Type{
+			Code:  types.Type_TUPLE,
+			Types: []*types.Type{&types.Type{Code: types.Type_DOUBLE}, &types.Type{Code: types.Type_DOUBLE}, &types.Type{Code: types.Type_DOUBLE}},
+		},
+		"Line_get_Thickness": &types.Type{Code: types.Type_FLOAT},
+		"Line_get_Visible":   &types.Type{Code: types.Type_BOOL},
+		"Polygon_get_Color": &types.Type{
+			Code:  types.Type_TUPLE,
+			Types: []*types.Type{&types.Type{Code: types.Type_DOUBLE}, &types.Type{Code: types.Type_DOUBLE}, &types.Type{Code: types.Type_DOUBLE}},
+		},
+		"Polygon_get_Material": &types.Type{Code: types.Type_STRING},
+		"Polygon_get_ReferenceFrame": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "ReferenceFrame",
+			Service: "SpaceCenter",
+		},
+		"Polygon_get_Thickness": &types.Type{Code: types.Type_FLOAT},
+		"Polygon_get_Vertices": &types.Type{
+			Code: types.Type_LIST,
+			Types: []*types.Type{&types.Type{
+				Code:  types.Type_TUPLE,
+				Types: []*types.Type{&types.Type{Code: types.Type_DOUBLE}, &types.Type{Code: types.Type_DOUBLE}, &types.Type{Code: types.Type_DOUBLE}},
+			}},
+		},
+		"Polygon_get_Visible": &types.Type{Code: types.Type_BOOL},
+		"Text_get_Alignment": &types.Type{
+			Code:    types.Type_ENUMERATION,
+			Name:    "TextAlignment",
+			Service: "UI",
+		},
+		"Text_get_Anchor": &types.Type{
+			Code:    types.Type_ENUMERATION,
+			Name:    "TextAnchor",
+			Service: "UI",
+		},
+		"Text_get_CharacterSize": &types.Type{Code: types.Type_FLOAT},
+		"Text_get_Color": &types.Type{
+			Code:  types.Type_TUPLE,
+			Types: []*types.Type{&types.Type{Code: types.Type_DOUBLE}, &types.Type{Code: types.Type_DOUBLE}, &types.Type{Code: types.Type_DOUBLE}},
+		},
+		"Text_get_Content":     &types.Type{Code: types.Type_STRING},
+		"Text_get_Font":        &types.Type{Code: types.Type_STRING},
+		"Text_get_LineSpacing": &types.Type{Code: types.Type_FLOAT},
+		"Text_get_Material":    &types.Type{Code: types.Type_STRING},
+		"Text_get_Position": &types.Type{
+			Code:  types.Type_TUPLE,
+			Types: []*types.Type{&types.Type{Code: types.Type_DOUBLE}, &types.Type{Code: types.Type_DOUBLE}, &types.Type{Code: types.Type_DOUBLE}},
+		},
+		"Text_get_ReferenceFrame": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "ReferenceFrame",
+			Service: "SpaceCenter",
+		},
+		"Text_get_Rotation": &types.Type{
+			Code:  types.Type_TUPLE,
+			Types: []*types.Type{&types.Type{Code: types.Type_DOUBLE}, &types.Type{Code: types.Type_DOUBLE}, &types.Type{Code: types.Type_DOUBLE}, &types.Type{Code: types.Type_DOUBLE}},
+		},
+		"Text_get_Size": &types.Type{Code: types.Type_SINT32},
+		"Text_get_Style": &types.Type{
+			Code:    types.Type_ENUMERATION,
+			Name:    "FontStyle",
+			Service: "UI",
+		},
+		"Text_get_Visible": &types.Type{Code: types.Type_BOOL},
+		"Text_static_AvailableFonts": &types.Type{
+			Code:  types.Type_LIST,
+			Types: []*types.Type{&types.Type{Code: types.Type_STRING}},
+		},
+	})
 }
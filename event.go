@@ -0,0 +1,117 @@
+package krpcgo
+
+import (
+	"context"
+
+	"github.com/atburke/krpc-go/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/ztrue/tracerr"
+)
+
+// Event is a server side event, such as one created with KRPC.AddEvent from
+// an expression. The server evaluates the expression and the event occurs
+// once it's true.
+type Event struct {
+	stream *Stream[bool]
+	// finished is closed when the event occurs or its stream ends, after err
+	// is set.
+	finished chan struct{}
+	err      error
+}
+
+// NewEvent opens and starts the stream of an event created on the server.
+func NewEvent(caller Caller, event *types.Event) (*Event, error) {
+	e, err := NewEventContext(context.Background(), caller, event)
+	return e, tracerr.Wrap(err)
+}
+
+// NewEventContext opens and starts the stream of an event created on the
+// server, giving up on starting it when ctx ends.
+func NewEventContext(ctx context.Context, caller Caller, event *types.Event) (*Event, error) {
+	stream, err := NewEventStreamContext(ctx, caller, event)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+
+	e := &Event{
+		stream:   stream,
+		finished: make(chan struct{}),
+	}
+	go func() {
+		defer close(e.finished)
+		_, e.err = e.stream.WaitUntil(context.Background(), func(occurred bool) bool {
+			return occurred
+		})
+	}()
+	return e, nil
+}
+
+// NewEventStream opens the stream of an event created on the server, which is
+// true once the event has occurred. The stream is started unless opts include
+// Unstarted. The event is removed from the server if its stream can't be
+// opened or started.
+func NewEventStream(caller Caller, event *types.Event, opts ...StreamOption) (*Stream[bool], error) {
+	stream, err := NewEventStreamContext(context.Background(), caller, event, opts...)
+	return stream, tracerr.Wrap(err)
+}
+
+// NewEventStreamContext opens the stream of an event created on the server,
+// like NewEventStream, giving up on starting it when ctx ends.
+func NewEventStreamContext(ctx context.Context, caller Caller, event *types.Event, opts ...StreamOption) (*Stream[bool], error) {
+	if event.Stream == nil {
+		return nil, tracerr.Errorf("Event has no stream")
+	}
+	raw, err := caller.OpenStream(event.Stream.Id, opts...)
+	if err != nil {
+		// Nothing else can remove the event from the server.
+		caller.Call(removeStreamCall(event.Stream.Id))
+		return nil, tracerr.Wrap(err)
+	}
+	if !newStreamConfig(opts).Unstarted {
+		if err := StartAll(ctx, raw); err != nil {
+			raw.Close()
+			return nil, tracerr.Wrap(err)
+		}
+	}
+	return MapStream(raw, func(b []byte) bool {
+		value, _ := proto.DecodeVarint(b)
+		return value != 0
+	}), nil
+}
+
+// Done returns a channel that is closed when the event occurs, or when its
+// stream ends first, such as because the event was removed or the client was
+// closed. Err tells them apart.
+func (e *Event) Done() <-chan struct{} {
+	return e.finished
+}
+
+// Err returns why the event's stream ended before the event occurred, once
+// Done is closed: ErrStreamClosed if the event was removed, or the error that
+// ended the stream, such as ErrClosed if the client was closed. It returns nil
+// if the event occurred or Done isn't closed yet.
+func (e *Event) Err() error {
+	select {
+	case <-e.finished:
+		return e.err
+	default:
+		return nil
+	}
+}
+
+// Wait waits until the event occurs or ctx is done. It fails if the event is
+// removed or the connection ends first, with the same errors as
+// Stream.Latest.
+func (e *Event) Wait(ctx context.Context) error {
+	select {
+	case <-e.finished:
+		return tracerr.Wrap(e.err)
+	case <-ctx.Done():
+		return tracerr.Wrap(ctx.Err())
+	}
+}
+
+// Remove removes the event from the server.
+func (e *Event) Remove() error {
+	return tracerr.Wrap(e.stream.Close())
+}
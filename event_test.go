@@ -0,0 +1,110 @@
+package krpcgo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/atburke/krpc-go/krpctest"
+	"github.com/atburke/krpc-go/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+)
+
+// addEvent adds an event on a test server.
+func addEvent(t *testing.T, client *KRPCClient) *Event {
+	t.Helper()
+	result, err := client.Call(&types.ProcedureCall{Service: "KRPC", Procedure: "AddEvent"})
+	require.NoError(t, err)
+	var event types.Event
+	require.NoError(t, proto.Unmarshal(result.Value, &event))
+	e, err := NewEvent(client, &event)
+	require.NoError(t, err)
+	return e
+}
+
+func TestEvent(t *testing.T) {
+	server := krpctest.NewServer(t)
+	client := connect(t, server, KRPCClientConfig{})
+
+	event := addEvent(t, client)
+	server.RequireCalled(t, "KRPC", "StartStream")
+	id, ok := server.StreamID("KRPC", "AddEvent")
+	require.True(t, ok)
+
+	require.NoError(t, server.Push(id, proto.EncodeVarint(0)))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, event.Wait(ctx), context.DeadlineExceeded)
+
+	require.NoError(t, server.Push(id, proto.EncodeVarint(1)))
+	select {
+	case <-event.Done():
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for event")
+	}
+	require.NoError(t, event.Err())
+	require.NoError(t, event.Wait(context.Background()))
+
+	require.NoError(t, event.Remove())
+	require.Empty(t, server.StreamIDs())
+}
+
+func TestEventRemoved(t *testing.T) {
+	server := krpctest.NewServer(t)
+	client := connect(t, server, KRPCClientConfig{})
+
+	event := addEvent(t, client)
+	require.NoError(t, event.Err())
+	require.NoError(t, event.Remove())
+	select {
+	case <-event.Done():
+	case <-time.After(time.Second):
+		t.Fatal("Removed event not done")
+	}
+	require.ErrorIs(t, event.Err(), ErrStreamClosed)
+	require.ErrorIs(t, event.Wait(context.Background()), ErrStreamClosed)
+}
+
+func TestEventClientClosed(t *testing.T) {
+	server := krpctest.NewServer(t)
+	client := connect(t, server, KRPCClientConfig{})
+
+	event := addEvent(t, client)
+	client.Close()
+	select {
+	case <-event.Done():
+	case <-time.After(time.Second):
+		t.Fatal("Event not done after closing the client")
+	}
+	require.ErrorIs(t, event.Err(), ErrClosed)
+}
+
+func TestEventContext(t *testing.T) {
+	server := krpctest.NewServer(t)
+	client := connect(t, server, KRPCClientConfig{})
+
+	result, err := client.Call(&types.ProcedureCall{Service: "KRPC", Procedure: "AddEvent"})
+	require.NoError(t, err)
+	var event types.Event
+	require.NoError(t, proto.Unmarshal(result.Value, &event))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = NewEventContext(ctx, client, &event)
+	require.ErrorIs(t, err, context.Canceled)
+	server.RequireNotCalled(t, "KRPC", "StartStream")
+	require.Empty(t, server.StreamIDs())
+}
+
+func TestEventNotOpened(t *testing.T) {
+	server := krpctest.NewServer(t)
+	client := connect(t, server, KRPCClientConfig{RPCOnly: true})
+
+	result, err := client.Call(&types.ProcedureCall{Service: "KRPC", Procedure: "AddEvent"})
+	require.NoError(t, err)
+	var event types.Event
+	require.NoError(t, proto.Unmarshal(result.Value, &event))
+	_, err = NewEvent(client, &event)
+	require.Error(t, err)
+	require.Empty(t, server.StreamIDs())
+}
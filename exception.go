@@ -0,0 +1,90 @@
+package krpcgo
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/atburke/krpc-go/types"
+)
+
+// ExceptionConstructor creates an error from the description of a server
+// exception.
+type ExceptionConstructor func(msg string) error
+
+var (
+	exceptionsMu sync.RWMutex
+	exceptions   = make(map[string]map[string]ExceptionConstructor)
+)
+
+// RegisterException registers the error type for an exception thrown by a
+// service, so that calls return it in place of a *types.Error. Generated
+// service packages register their exceptions when they are imported.
+func RegisterException(service, name string, ctor ExceptionConstructor) {
+	exceptionsMu.Lock()
+	defer exceptionsMu.Unlock()
+	if exceptions[service] == nil {
+		exceptions[service] = make(map[string]ExceptionConstructor)
+	}
+	exceptions[service][name] = ctor
+}
+
+// exceptionError is a server error translated to a registered error type.
+type exceptionError struct {
+	err       error
+	serverErr *types.Error
+}
+
+// Error returns a human-readable error.
+func (e *exceptionError) Error() string {
+	return e.serverErr.Error()
+}
+
+// Unwrap returns the registered error.
+func (e *exceptionError) Unwrap() error {
+	return e.err
+}
+
+// As lets the original server error, including its stack trace, be retrieved
+// with errors.As. Registered errors that are pointers, as generated ones are,
+// can also be retrieved as values, such as with
+// errors.As(err, &krpc.ErrInvalidOperation{}).
+func (e *exceptionError) As(target any) bool {
+	if t, ok := target.(**types.Error); ok {
+		*t = e.serverErr
+		return true
+	}
+	errValue := reflect.ValueOf(e.err)
+	targetValue := reflect.ValueOf(target)
+	if errValue.Kind() == reflect.Pointer && !errValue.IsNil() &&
+		targetValue.Kind() == reflect.Pointer && !targetValue.IsNil() &&
+		targetValue.Elem().Type() == errValue.Elem().Type() {
+		targetValue.Elem().Set(errValue.Elem())
+		return true
+	}
+	return false
+}
+
+// ResultError gets the error of a procedure result, such as one of the results
+// of CallMultiple, translated to its registered error type like the errors of
+// Call. It returns nil if the procedure succeeded.
+func ResultError(result *types.ProcedureResult) error {
+	if result.Error == nil {
+		return nil
+	}
+	return translateError(result.Error)
+}
+
+// translateError converts a server error into its registered error type. If
+// no type is registered for it, the server error is returned as is.
+func translateError(serverErr *types.Error) error {
+	exceptionsMu.RLock()
+	ctor := exceptions[serverErr.Service][serverErr.Name]
+	exceptionsMu.RUnlock()
+	if ctor == nil {
+		return serverErr
+	}
+	return &exceptionError{
+		err:       ctor(serverErr.Description),
+		serverErr: serverErr,
+	}
+}
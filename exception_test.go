@@ -0,0 +1,54 @@
+package krpcgo
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/atburke/krpc-go/krpctest"
+	"github.com/atburke/krpc-go/types"
+	"github.com/stretchr/testify/require"
+)
+
+type errTestException struct {
+	msg string
+}
+
+func (err errTestException) Error() string {
+	return err.msg
+}
+
+func TestTranslateError(t *testing.T) {
+	RegisterException("Test", "TestException", func(msg string) error {
+		return &errTestException{msg: msg}
+	})
+	server := krpctest.NewServer(t)
+	server.HandleDefault(func(call *types.ProcedureCall) ([]byte, error) {
+		return nil, &types.Error{
+			Service:     "Test",
+			Name:        call.Procedure,
+			Description: "it broke",
+			StackTrace:  "at Test",
+		}
+	})
+	client := connect(t, server, KRPCClientConfig{RPCOnly: true})
+
+	_, err := client.Call(&types.ProcedureCall{Service: "Test", Procedure: "TestException"})
+	// Like generated services, the error is registered as a pointer.
+	var testErr *errTestException
+	require.True(t, errors.As(err, &testErr))
+	require.Equal(t, "it broke", testErr.msg)
+	// It can also be retrieved as a value.
+	var testErrValue errTestException
+	require.True(t, errors.As(err, &testErrValue))
+	require.Equal(t, "it broke", testErrValue.msg)
+	var serverErr *types.Error
+	require.True(t, errors.As(err, &serverErr))
+	require.Equal(t, "at Test", serverErr.StackTrace)
+
+	// Unregistered exceptions are returned as is.
+	_, err = client.Call(&types.ProcedureCall{Service: "Test", Procedure: "OtherException"})
+	require.False(t, errors.As(err, &testErr))
+	require.False(t, errors.As(err, &testErrValue))
+	require.True(t, errors.As(err, &serverErr))
+	require.Equal(t, "OtherException", serverErr.Name)
+}
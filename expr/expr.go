@@ -0,0 +1,433 @@
+// Package expr builds kRPC server side expressions, such as the conditions of
+// events.
+//
+// Expressions are built from constants and procedure calls with the methods
+// of Expr, and aren't sent to the server until they're compiled with Build,
+// Event or Stream:
+//
+//	event, err := expr.Call(flight.MeanAltitudeCall()).Gt(expr.Double(10000)).Event(ctx, client)
+//
+// Errors, such as comparing values of different types, are kept until the
+// expression is compiled, so they only need to be checked once.
+package expr
+
+import (
+	"context"
+
+	krpcgo "github.com/atburke/krpc-go"
+	"github.com/atburke/krpc-go/krpc"
+	"github.com/atburke/krpc-go/lib/encode"
+	"github.com/atburke/krpc-go/types"
+	"github.com/ztrue/tracerr"
+)
+
+// Type is the type of the value of an expression.
+type Type int
+
+const (
+	// TypeUnknown is the type of procedure call results whose return type
+	// isn't registered, or isn't one of the other types. The server checks
+	// the types of expressions using them.
+	TypeUnknown Type = iota
+	TypeDouble
+	TypeFloat
+	TypeInt
+	TypeBool
+	TypeString
+)
+
+// typeProcedures are the procedures that create the server side Type of
+// each type.
+var typeProcedures = map[Type]string{
+	TypeDouble: "Type_static_Double",
+	TypeFloat:  "Type_static_Float",
+	TypeInt:    "Type_static_Int",
+	TypeBool:   "Type_static_Bool",
+	TypeString: "Type_static_String",
+}
+
+func (t Type) String() string {
+	switch t {
+	case TypeDouble:
+		return "double"
+	case TypeFloat:
+		return "float"
+	case TypeInt:
+		return "int"
+	case TypeBool:
+		return "bool"
+	case TypeString:
+		return "string"
+	default:
+		return "unknown"
+	}
+}
+
+// kRPCTypes are the types of procedure results that expressions have types
+// for. Expressions only use 32-bit ints.
+var kRPCTypes = map[types.Type_TypeCode]Type{
+	types.Type_DOUBLE: TypeDouble,
+	types.Type_FLOAT:  TypeFloat,
+	types.Type_SINT32: TypeInt,
+	types.Type_BOOL:   TypeBool,
+	types.Type_STRING: TypeString,
+}
+
+// isNumeric checks if t is a number type, or might be one.
+func (t Type) isNumeric() bool {
+	return t == TypeUnknown || t == TypeDouble || t == TypeFloat || t == TypeInt
+}
+
+// Expr is an expression that hasn't been sent to the server yet. Exprs are
+// immutable, so they can be reused in several expressions.
+type Expr struct {
+	typ Type
+	// err is the first error in building the expression.
+	err error
+	// build creates the expression on the server.
+	build func(b *builder) (*krpc.Expression, error)
+}
+
+// builder creates expressions on the server.
+type builder struct {
+	ctx    context.Context
+	caller krpcgo.Caller
+}
+
+// call calls a procedure of the KRPC service and decodes its result into
+// result.
+func (b *builder) call(result interface{}, procedure string, args ...interface{}) error {
+	request := &types.ProcedureCall{
+		Service:   "KRPC",
+		Procedure: procedure,
+	}
+	for i, arg := range args {
+		argBytes, err := encode.Marshal(arg)
+		if err != nil {
+			return tracerr.Wrap(err)
+		}
+		request.Arguments = append(request.Arguments, &types.Argument{
+			Position: uint32(i),
+			Value:    argBytes,
+		})
+	}
+	res, err := b.caller.CallContext(b.ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return tracerr.Wrap(encode.Unmarshal(res.Value, result))
+}
+
+// expression calls a procedure that creates an expression.
+func (b *builder) expression(procedure string, args ...interface{}) (*krpc.Expression, error) {
+	var e krpc.Expression
+	if err := b.call(&e, procedure, args...); err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	e.Client = b.caller
+	return &e, nil
+}
+
+// constant creates an expression of a constant value.
+func constant(typ Type, procedure string, value interface{}) *Expr {
+	return &Expr{
+		typ: typ,
+		build: func(b *builder) (*krpc.Expression, error) {
+			return b.expression(procedure, value)
+		},
+	}
+}
+
+// Double creates a constant double.
+func Double(value float64) *Expr {
+	return constant(TypeDouble, "Expression_static_ConstantDouble", value)
+}
+
+// Float creates a constant float.
+func Float(value float32) *Expr {
+	return constant(TypeFloat, "Expression_static_ConstantFloat", value)
+}
+
+// Int creates a constant int.
+func Int(value int32) *Expr {
+	return constant(TypeInt, "Expression_static_ConstantInt", value)
+}
+
+// Bool creates a constant bool.
+func Bool(value bool) *Expr {
+	return constant(TypeBool, "Expression_static_ConstantBool", value)
+}
+
+// String creates a constant string.
+func String(value string) *Expr {
+	return constant(TypeString, "Expression_static_ConstantString", value)
+}
+
+// Call creates an expression of the result of a procedure call, which the
+// server makes every time it evaluates the expression. It takes the results
+// of the generated ...Call functions as is, so that their errors are kept with
+// the expression. Its type is the procedure's return type if it's registered
+// with krpcgo.RegisterReturnType, as generated services do, and unknown
+// otherwise.
+func Call(call *types.ProcedureCall, err error) *Expr {
+	typ := TypeUnknown
+	if err == nil && call == nil {
+		err = tracerr.Errorf("Missing procedure call")
+	} else if call != nil {
+		if returnType := krpcgo.ReturnType(call); returnType != nil {
+			typ = kRPCTypes[returnType.Code]
+		}
+	}
+	return &Expr{
+		typ: typ,
+		err: tracerr.Wrap(err),
+		build: func(b *builder) (*krpc.Expression, error) {
+			return b.expression("Expression_static_Call", call)
+		},
+	}
+}
+
+// Type gets the type of the expression's value.
+func (e *Expr) Type() Type {
+	return e.typ
+}
+
+// Err gets the first error in building the expression.
+func (e *Expr) Err() error {
+	return e.err
+}
+
+// unary creates an expression that applies an operator to e.
+func (e *Expr) unary(name string, typ Type, check func(Type) bool) *Expr {
+	result := &Expr{
+		typ: typ,
+		err: e.err,
+		build: func(b *builder) (*krpc.Expression, error) {
+			arg, err := e.build(b)
+			if err != nil {
+				return nil, tracerr.Wrap(err)
+			}
+			return b.expression("Expression_static_"+name, arg)
+		},
+	}
+	if result.err == nil && !check(e.typ) {
+		result.err = tracerr.Errorf("%v can't be applied to %v", name, e.typ)
+	}
+	return result
+}
+
+// binary creates an expression that applies an operator to e and other. Both
+// operands must have the same type, unless one of them is unknown, and check
+// must accept it. The result has the type returned by resultType.
+func (e *Expr) binary(name string, other *Expr, check func(Type) bool, resultType func(Type) Type) *Expr {
+	if other == nil {
+		other = &Expr{err: tracerr.Errorf("Missing operand of %v", name)}
+	}
+	typ := e.typ
+	if typ == TypeUnknown {
+		typ = other.typ
+	}
+	result := &Expr{
+		typ: resultType(typ),
+		err: e.err,
+		build: func(b *builder) (*krpc.Expression, error) {
+			arg0, err := e.build(b)
+			if err != nil {
+				return nil, tracerr.Wrap(err)
+			}
+			arg1, err := other.build(b)
+			if err != nil {
+				return nil, tracerr.Wrap(err)
+			}
+			return b.expression("Expression_static_"+name, arg0, arg1)
+		},
+	}
+	switch {
+	case result.err != nil:
+	case other.err != nil:
+		result.err = other.err
+	case e.typ != TypeUnknown && other.typ != TypeUnknown && e.typ != other.typ:
+		result.err = tracerr.Errorf("%v of %v and %v: operands must have the same type, use Cast to convert them", name, e.typ, other.typ)
+	case !check(typ):
+		result.err = tracerr.Errorf("%v can't be applied to %v", name, typ)
+	}
+	return result
+}
+
+func anyType(Type) bool {
+	return true
+}
+
+func isBool(t Type) bool {
+	return t == TypeUnknown || t == TypeBool
+}
+
+func isInt(t Type) bool {
+	return t == TypeUnknown || t == TypeInt
+}
+
+func sameType(t Type) Type {
+	return t
+}
+
+func boolType(Type) Type {
+	return TypeBool
+}
+
+// Eq compares if e equals other.
+func (e *Expr) Eq(other *Expr) *Expr {
+	return e.binary("Equal", other, anyType, boolType)
+}
+
+// Ne compares if e doesn't equal other.
+func (e *Expr) Ne(other *Expr) *Expr {
+	return e.binary("NotEqual", other, anyType, boolType)
+}
+
+// Gt compares if e is greater than other.
+func (e *Expr) Gt(other *Expr) *Expr {
+	return e.binary("GreaterThan", other, Type.isNumeric, boolType)
+}
+
+// Ge compares if e is greater than or equal to other.
+func (e *Expr) Ge(other *Expr) *Expr {
+	return e.binary("GreaterThanOrEqual", other, Type.isNumeric, boolType)
+}
+
+// Lt compares if e is less than other.
+func (e *Expr) Lt(other *Expr) *Expr {
+	return e.binary("LessThan", other, Type.isNumeric, boolType)
+}
+
+// Le compares if e is less than or equal to other.
+func (e *Expr) Le(other *Expr) *Expr {
+	return e.binary("LessThanOrEqual", other, Type.isNumeric, boolType)
+}
+
+// And is the logical and of e and other.
+func (e *Expr) And(other *Expr) *Expr {
+	return e.binary("And", other, isBool, boolType)
+}
+
+// Or is the logical or of e and other.
+func (e *Expr) Or(other *Expr) *Expr {
+	return e.binary("Or", other, isBool, boolType)
+}
+
+// Xor is the logical exclusive or of e and other.
+func (e *Expr) Xor(other *Expr) *Expr {
+	return e.binary("ExclusiveOr", other, isBool, boolType)
+}
+
+// Not is the logical negation of e.
+func (e *Expr) Not() *Expr {
+	return e.unary("Not", TypeBool, isBool)
+}
+
+// Add adds other to e.
+func (e *Expr) Add(other *Expr) *Expr {
+	return e.binary("Add", other, Type.isNumeric, sameType)
+}
+
+// Sub subtracts other from e.
+func (e *Expr) Sub(other *Expr) *Expr {
+	return e.binary("Subtract", other, Type.isNumeric, sameType)
+}
+
+// Mul multiplies e by other.
+func (e *Expr) Mul(other *Expr) *Expr {
+	return e.binary("Multiply", other, Type.isNumeric, sameType)
+}
+
+// Div divides e by other.
+func (e *Expr) Div(other *Expr) *Expr {
+	return e.binary("Divide", other, Type.isNumeric, sameType)
+}
+
+// Mod is the remainder of dividing e by other.
+func (e *Expr) Mod(other *Expr) *Expr {
+	return e.binary("Modulo", other, Type.isNumeric, sameType)
+}
+
+// Pow raises e to the power of other.
+func (e *Expr) Pow(other *Expr) *Expr {
+	return e.binary("Power", other, Type.isNumeric, sameType)
+}
+
+// Lsh shifts e left by other bits.
+func (e *Expr) Lsh(other *Expr) *Expr {
+	return e.binary("LeftShift", other, isInt, sameType)
+}
+
+// Rsh shifts e right by other bits.
+func (e *Expr) Rsh(other *Expr) *Expr {
+	return e.binary("RightShift", other, isInt, sameType)
+}
+
+// Cast converts e to another type.
+func (e *Expr) Cast(typ Type) *Expr {
+	result := &Expr{
+		typ: typ,
+		err: e.err,
+		build: func(b *builder) (*krpc.Expression, error) {
+			arg, err := e.build(b)
+			if err != nil {
+				return nil, tracerr.Wrap(err)
+			}
+			var t krpc.Type
+			if err := b.call(&t, typeProcedures[typ]); err != nil {
+				return nil, tracerr.Wrap(err)
+			}
+			return b.expression("Expression_static_Cast", arg, &t)
+		},
+	}
+	if _, ok := typeProcedures[typ]; result.err == nil && !ok {
+		result.err = tracerr.Errorf("Can't cast to %v", typ)
+	}
+	return result
+}
+
+// Build creates the expression on the server.
+func (e *Expr) Build(ctx context.Context, caller krpcgo.Caller) (*krpc.Expression, error) {
+	if e.err != nil {
+		return nil, tracerr.Wrap(e.err)
+	}
+	expression, err := e.build(&builder{ctx: ctx, caller: caller})
+	return expression, tracerr.Wrap(err)
+}
+
+// addEvent creates the expression on the server and adds an event for it.
+func (e *Expr) addEvent(ctx context.Context, caller krpcgo.Caller) (*types.Event, error) {
+	if e.err == nil && !isBool(e.typ) {
+		return nil, tracerr.Errorf("Events need a bool expression, not %v", e.typ)
+	}
+	expression, err := e.Build(ctx, caller)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	var event types.Event
+	err = (&builder{ctx: ctx, caller: caller}).call(&event, "AddEvent", expression)
+	return &event, tracerr.Wrap(err)
+}
+
+// Event creates an event on the server that occurs once the expression is
+// true. The expression must be a bool.
+func (e *Expr) Event(ctx context.Context, caller krpcgo.Caller) (*krpcgo.Event, error) {
+	event, err := e.addEvent(ctx, caller)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	ev, err := krpcgo.NewEventContext(ctx, caller, event)
+	return ev, tracerr.Wrap(err)
+}
+
+// Stream creates a stream of the value of a bool expression, which the server
+// evaluates every update. Closing the stream removes it from the server.
+func (e *Expr) Stream(ctx context.Context, caller krpcgo.Caller, opts ...krpcgo.StreamOption) (*krpcgo.Stream[bool], error) {
+	event, err := e.addEvent(ctx, caller)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream, err := krpcgo.NewEventStreamContext(ctx, caller, event, opts...)
+	return stream, tracerr.Wrap(err)
+}
@@ -0,0 +1,184 @@
+package expr
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	krpcgo "github.com/atburke/krpc-go"
+	"github.com/atburke/krpc-go/krpctest"
+	"github.com/atburke/krpc-go/lib/encode"
+	"github.com/atburke/krpc-go/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+)
+
+// testServer is a test server that gives each new expression and type the
+// next ID.
+type testServer struct {
+	*krpctest.Server
+
+	mu    sync.Mutex
+	calls []*types.ProcedureCall
+}
+
+// newServer creates a test server and a client connected to it.
+func newServer(t *testing.T) (*testServer, *krpcgo.KRPCClient) {
+	server := &testServer{Server: krpctest.NewServer(t)}
+	server.HandleDefault(func(call *types.ProcedureCall) ([]byte, error) {
+		server.mu.Lock()
+		defer server.mu.Unlock()
+		server.calls = append(server.calls, call)
+		return encode.Marshal(uint64(len(server.calls)))
+	})
+	client := krpcgo.NewKRPCClient(krpcgo.KRPCClientConfig{
+		Host:       server.Host(),
+		RPCPort:    server.RPCPort(),
+		StreamPort: server.StreamPort(),
+	})
+	require.NoError(t, client.Connect(context.Background()))
+	t.Cleanup(func() { client.Close() })
+	return server, client
+}
+
+// expressionCalls gets the calls that created expressions and types.
+func (s *testServer) expressionCalls() []*types.ProcedureCall {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls
+}
+
+// argIDs decodes the IDs of the expressions passed to a call.
+func argIDs(t *testing.T, call *types.ProcedureCall) []uint64 {
+	var ids []uint64
+	for _, arg := range call.Arguments {
+		var id uint64
+		require.NoError(t, encode.Unmarshal(arg.Value, &id))
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+var altitudeCall = &types.ProcedureCall{Service: "SpaceCenter", Procedure: "Flight_get_MeanAltitude"}
+
+func TestBuild(t *testing.T) {
+	server, client := newServer(t)
+
+	e := Call(altitudeCall, nil).Gt(Int(10000).Cast(TypeDouble))
+	require.NoError(t, e.Err())
+	require.Equal(t, TypeBool, e.Type())
+	expression, err := e.Build(context.Background(), client)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), expression.ID())
+
+	calls := server.expressionCalls()
+	var procedures []string
+	for _, call := range calls {
+		procedures = append(procedures, call.Procedure)
+	}
+	require.Equal(t, []string{
+		"Expression_static_Call",
+		"Expression_static_ConstantInt",
+		"Type_static_Double",
+		"Expression_static_Cast",
+		"Expression_static_GreaterThan",
+	}, procedures)
+
+	var call types.ProcedureCall
+	require.NoError(t, encode.Unmarshal(calls[0].Arguments[0].Value, &call))
+	require.True(t, proto.Equal(altitudeCall, &call))
+	require.Equal(t, []uint64{2, 3}, argIDs(t, calls[3]))
+	require.Equal(t, []uint64{1, 4}, argIDs(t, calls[4]))
+}
+
+func TestBuildErrors(t *testing.T) {
+	errCall := errors.New("bad call")
+	tcs := []struct {
+		name string
+		expr *Expr
+	}{
+		{name: "different types", expr: Double(1).Gt(Int(1))},
+		{name: "not numbers", expr: String("a").Add(String("b"))},
+		{name: "not bools", expr: Int(1).And(Int(2))},
+		{name: "not ints", expr: Double(1).Lsh(Double(2))},
+		{name: "missing operand", expr: Double(1).Eq(nil)},
+		{name: "unknown cast", expr: Double(1).Cast(TypeUnknown)},
+		{name: "nested", expr: Bool(true).Or(Double(1).Lt(Float(2))).Not()},
+		{name: "call error", expr: Call(nil, errCall).Add(Double(1))},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			server, client := newServer(t)
+			require.Error(t, tc.expr.Err())
+			_, err := tc.expr.Build(context.Background(), client)
+			require.Error(t, err)
+			require.Empty(t, server.expressionCalls())
+		})
+	}
+
+	_, err := Call(nil, errCall).Build(context.Background(), nil)
+	require.ErrorIs(t, err, errCall)
+
+	// Unknown types are left for the server to check.
+	require.NoError(t, Call(altitudeCall, nil).Gt(Float(1)).Err())
+	require.Equal(t, TypeFloat, Call(altitudeCall, nil).Mul(Float(2)).Type())
+
+	// Registered return types are checked before the server sees them.
+	krpcgo.RegisterReturnType("Test", "Name", &types.Type{Code: types.Type_STRING})
+	nameCall := &types.ProcedureCall{Service: "Test", Procedure: "Name"}
+	require.Equal(t, TypeString, Call(nameCall, nil).Type())
+	require.Error(t, Call(nameCall, nil).Gt(Double(1)).Err())
+	require.NoError(t, Call(nameCall, nil).Eq(String("a")).Err())
+}
+
+func TestEvent(t *testing.T) {
+	server, client := newServer(t)
+	ctx := context.Background()
+
+	_, err := Double(1).Event(ctx, client)
+	require.Error(t, err)
+
+	event, err := Call(altitudeCall, nil).Gt(Double(10000)).Event(ctx, client)
+	require.NoError(t, err)
+	addEvent := server.RequireCalled(t, "KRPC", "AddEvent")
+	require.Equal(t, []uint64{3}, argIDs(t, addEvent))
+	id, ok := server.StreamID("KRPC", "AddEvent")
+	require.True(t, ok)
+	require.NoError(t, server.Push(id, proto.EncodeVarint(1)))
+	select {
+	case <-event.Done():
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for event")
+	}
+	require.NoError(t, event.Remove())
+}
+
+func TestStream(t *testing.T) {
+	server, client := newServer(t)
+
+	stream, err := Bool(true).Not().Stream(context.Background(), client, krpcgo.Unstarted)
+	require.NoError(t, err)
+	defer stream.Close()
+	server.RequireNotCalled(t, "KRPC", "StartStream")
+	require.NoError(t, stream.Start())
+
+	id, ok := server.StreamID("KRPC", "AddEvent")
+	require.True(t, ok)
+	require.NoError(t, server.Push(id, proto.EncodeVarint(0)))
+	value, err := stream.Get()
+	require.NoError(t, err)
+	require.False(t, value)
+}
+
+func TestEventStartFails(t *testing.T) {
+	server, client := newServer(t)
+	server.HandleError("KRPC", "StartStream", &types.Error{Service: "KRPC", Name: "TestException", Description: "failed"})
+
+	_, err := Bool(true).Event(context.Background(), client)
+	require.Error(t, err)
+	server.RequireCalled(t, "KRPC", "RemoveStream")
+	_, ok := server.StreamID("KRPC", "AddEvent")
+	require.False(t, ok)
+}
@@ -0,0 +1,78 @@
+package krpcgo
+
+import (
+	"context"
+
+	"github.com/ztrue/tracerr"
+)
+
+// Future is the result of an asynchronous call, which becomes available once
+// the call completes.
+type Future[T any] struct {
+	done  chan struct{}
+	value T
+	err   error
+}
+
+func newFuture[T any]() *Future[T] {
+	return &Future[T]{
+		done: make(chan struct{}),
+	}
+}
+
+// failedFuture creates a future that has already failed with err.
+func failedFuture[T any](err error) *Future[T] {
+	f := newFuture[T]()
+	var zero T
+	f.resolve(zero, err)
+	return f
+}
+
+// RunAsync runs f in the background and returns a future of its result.
+func RunAsync[T any](f func() (T, error)) *Future[T] {
+	future := newFuture[T]()
+	go func() {
+		future.resolve(f())
+	}()
+	return future
+}
+
+// resolve completes the future. It must only be called once.
+func (f *Future[T]) resolve(value T, err error) {
+	f.value = value
+	f.err = err
+	close(f.done)
+}
+
+// Done returns a channel that is closed once the result is available.
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+// Wait waits for the result of the call. If ctx ends first, its error is
+// returned; the call itself is not cancelled.
+func (f *Future[T]) Wait(ctx context.Context) (T, error) {
+	select {
+	case <-f.done:
+		return f.value, tracerr.Wrap(f.err)
+	case <-ctx.Done():
+		var zero T
+		return zero, tracerr.Wrap(ctx.Err())
+	}
+}
+
+// MapFuture converts a future to another type. m is only called if src
+// completes without an error.
+func MapFuture[S, T any](src *Future[S], m func(S) (T, error)) *Future[T] {
+	dst := newFuture[T]()
+	go func() {
+		<-src.done
+		if src.err != nil {
+			var zero T
+			dst.resolve(zero, src.err)
+			return
+		}
+		dst.resolve(m(src.value))
+	}()
+	return dst
+}
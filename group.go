@@ -0,0 +1,161 @@
+package krpcgo
+
+import (
+	"sync"
+
+	"github.com/ztrue/tracerr"
+)
+
+// Snapshot is a value built from the latest values of the streams of a
+// StreamGroup, as they were after a stream update from the server.
+type Snapshot[T any] struct {
+	// Seq is the sequence number of the stream update. It increases with
+	// every update the client receives, so snapshots can be told apart and
+	// skipped updates noticed.
+	Seq   uint64
+	Value T
+}
+
+// GroupMember is a stream in a StreamGroup. Create one with Member.
+type GroupMember[T any] struct {
+	manager *streamManager
+	// set sets the stream's latest value in a snapshot.
+	set func(*T)
+	// ready is closed once the stream has a value.
+	ready <-chan struct{}
+	done  <-chan struct{}
+	err   func() error
+	close func() error
+	clone func() GroupMember[T]
+}
+
+// Member creates a group member for a stream, which sets the stream's values
+// in the group's snapshots with set. The stream must come from AddStream or
+// a generated stream function, optionally converted with MapStream.
+func Member[S, T any](stream *Stream[S], set func(*T, S)) GroupMember[T] {
+	return GroupMember[T]{
+		manager: stream.manager,
+		set: func(snapshot *T) {
+			value, _ := stream.latest()
+			set(snapshot, value)
+		},
+		ready: stream.ready,
+		done:  stream.done,
+		err:   stream.Err,
+		close: stream.Close,
+		clone: func() GroupMember[T] {
+			return Member(stream.Clone(), set)
+		},
+	}
+}
+
+// StreamGroup is a stream of snapshots of several streams. The server sends
+// the values of every stream from the same physics frame in a single update,
+// and a snapshot is built after each update to any member, once every member
+// has a value, so its values are consistent. Members that weren't in the
+// update keep their last value; the server leaves out streams whose value
+// hasn't changed. The members don't need to be received from. Closing the
+// group closes its members, and the group ends as soon as any member ends.
+type StreamGroup[T any] struct {
+	*Stream[Snapshot[T]]
+
+	mu      sync.Mutex
+	stopped bool
+	// stopObserving stops observing the stream updates.
+	stopObserving func()
+}
+
+// NewStreamGroup creates a stream group. Options set how the snapshots are
+// buffered until they're received. All of the members must be streams of the
+// same client.
+func NewStreamGroup[T any](members []GroupMember[T], opts ...StreamOption) (*StreamGroup[T], error) {
+	if len(members) == 0 {
+		return nil, tracerr.Errorf("A stream group needs at least one member")
+	}
+	var client *StreamClient
+	for _, member := range members {
+		if member.manager == nil || member.manager.client == nil {
+			return nil, tracerr.Errorf("Only streams added to a client can be grouped")
+		}
+		if client != nil && member.manager.client != client {
+			return nil, tracerr.Errorf("Streams from different clients can't be grouped")
+		}
+		client = member.manager.client
+	}
+
+	cfg := newStreamConfig(opts)
+	g := &StreamGroup[T]{}
+	g.Stream = newStream(0, make(chan Snapshot[T], cfg.BufferSize), func() *Stream[Snapshot[T]] {
+		clones := make([]GroupMember[T], len(members))
+		for i, member := range members {
+			clones[i] = member.clone()
+		}
+		// The clones are streams of the same client, so this can't fail.
+		clone, _ := NewStreamGroup(clones, opts...)
+		return clone.Stream
+	})
+	g.overflow = cfg.Overflow
+	latest := newLatestValue[Snapshot[T]]()
+	g.latest, g.ready, g.updated = latest.get, latest.ready, latest.updated
+	g.AddCloser(func() error {
+		g.stop(nil)
+		var err error
+		for _, member := range members {
+			if closeErr := member.close(); closeErr != nil && err == nil {
+				err = closeErr
+			}
+		}
+		return tracerr.Wrap(err)
+	})
+
+	g.stopObserving = client.observe(func(seq uint64, updated map[*streamManager]bool) {
+		var anyUpdated bool
+		for _, member := range members {
+			select {
+			case <-member.ready:
+			default:
+				return
+			}
+			anyUpdated = anyUpdated || updated[member.manager]
+		}
+		if !anyUpdated {
+			return
+		}
+		var value T
+		for _, member := range members {
+			member.set(&value)
+		}
+		snapshot := Snapshot[T]{Seq: seq, Value: value}
+
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		if !g.stopped {
+			latest.set(snapshot)
+			g.send(snapshot)
+		}
+	})
+	for _, member := range members {
+		member := member
+		go func() {
+			select {
+			case <-member.done:
+				g.stop(member.err())
+			case <-g.done:
+			}
+		}()
+	}
+	return g, nil
+}
+
+// stop ends the group with err and stops building snapshots.
+func (g *StreamGroup[T]) stop(err error) {
+	// Ending the stream first stops any blocked send.
+	g.end(err)
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if !g.stopped {
+		g.stopped = true
+		g.stopObserving()
+		close(g.C)
+	}
+}
@@ -0,0 +1,93 @@
+package krpcgo
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/atburke/krpc-go/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+)
+
+// sendStreamUpdate sends a stream update with the given values for each
+// stream ID.
+func sendStreamUpdate(t *testing.T, conn net.Conn, values map[uint64]string) {
+	t.Helper()
+	var update types.StreamUpdate
+	for id, value := range values {
+		update.Results = append(update.Results, &types.StreamResult{
+			Id:     id,
+			Result: &types.ProcedureResult{Value: []byte(value)},
+		})
+	}
+	data, err := proto.Marshal(&update)
+	require.NoError(t, err)
+	require.NoError(t, send(conn, data))
+}
+
+func TestStreamGroup(t *testing.T) {
+	clientConn, serverConn := net.Pipe()
+	defer serverConn.Close()
+	streamClient := NewStreamClient(clientConn)
+	go streamClient.Run(context.Background())
+
+	type telemetry struct {
+		Altitude, Speed string
+	}
+	altitude := MapStream(streamClient.GetStream(1), func(b []byte) string { return string(b) })
+	speed := streamClient.GetStream(2)
+	group, err := NewStreamGroup([]GroupMember[telemetry]{
+		Member(altitude, func(t *telemetry, v string) { t.Altitude = v }),
+		Member(speed, func(t *telemetry, v []byte) { t.Speed = string(v) }),
+	}, WithBuffer(10))
+	require.NoError(t, err)
+
+	// Snapshots are built once every member has a value, after any update to
+	// a member, keeping the last value of members that weren't updated.
+	sendStreamUpdate(t, serverConn, map[uint64]string{1: "100"})
+	sendStreamUpdate(t, serverConn, map[uint64]string{1: "200", 2: "10"})
+	sendStreamUpdate(t, serverConn, map[uint64]string{2: "20"})
+	sendStreamUpdate(t, serverConn, map[uint64]string{3: "other"})
+	sendStreamUpdate(t, serverConn, map[uint64]string{1: "300", 2: "30", 3: "other"})
+	require.Equal(t, Snapshot[telemetry]{Seq: 2, Value: telemetry{"200", "10"}}, receiveValue(t, group.Stream))
+	require.Equal(t, Snapshot[telemetry]{Seq: 3, Value: telemetry{"200", "20"}}, receiveValue(t, group.Stream))
+	require.Equal(t, Snapshot[telemetry]{Seq: 5, Value: telemetry{"300", "30"}}, receiveValue(t, group.Stream))
+
+	// The group ends when its members do.
+	serverConn.Close()
+	requireEnded(t, group.Stream, io.EOF)
+
+	_, err = NewStreamGroup([]GroupMember[int]{
+		Member(NewStream(1, make(chan int)), func(t *int, v int) { *t = v }),
+	})
+	require.Error(t, err)
+}
+
+func TestStreamGroupClose(t *testing.T) {
+	clientConn, serverConn := net.Pipe()
+	defer serverConn.Close()
+	streamClient := NewStreamClient(clientConn)
+	go streamClient.Run(context.Background())
+
+	a := streamClient.GetStream(1)
+	b := streamClient.GetStream(2)
+	set := func(t *int, v []byte) { *t += len(v) }
+	group, err := NewStreamGroup([]GroupMember[int]{Member(a, set), Member(b, set)})
+	require.NoError(t, err)
+	clone := group.Clone()
+
+	require.NoError(t, group.Close())
+	requireEnded(t, group.Stream, nil)
+	requireEnded(t, a, nil)
+	requireEnded(t, b, nil)
+
+	// Clones have their own members.
+	sendStreamUpdate(t, serverConn, map[uint64]string{1: "a", 2: "bb"})
+	snapshot, err := clone.Get()
+	require.NoError(t, err)
+	require.Equal(t, 3, snapshot.Value)
+	require.NoError(t, clone.Close())
+	require.Empty(t, streamClient.getObservers())
+}
@@ -0,0 +1,215 @@
+package krpcgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/atburke/krpc-go/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/ztrue/tracerr"
+)
+
+const (
+	// MinServerVersion is the oldest supported kRPC server version.
+	MinServerVersion = "0.4.0"
+	// MaxServerVersion is the first kRPC server version that is no longer
+	// supported.
+	MaxServerVersion = "0.6.0"
+)
+
+var (
+	// ErrMalformedMessage is returned when the server couldn't decode the
+	// connection request.
+	ErrMalformedMessage = errors.New("malformed connection request")
+	// ErrHandshakeTimeout is returned when the server timed out waiting for
+	// the connection request.
+	ErrHandshakeTimeout = errors.New("connection request timed out")
+	// ErrWrongConnectionType is returned when a connection request was sent to
+	// the wrong server, such as an RPC request to the stream server.
+	ErrWrongConnectionType = errors.New("wrong connection type")
+)
+
+// DialError is returned when the client can't connect to a server.
+type DialError struct {
+	// Addr is the address of the server.
+	Addr string
+	// Err is the underlying error.
+	Err error
+}
+
+// Error returns a human-readable error.
+func (e *DialError) Error() string {
+	return fmt.Sprintf("Failed to connect to %v: %v", e.Addr, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *DialError) Unwrap() error {
+	return e.Err
+}
+
+// HandshakeError is returned when a server rejects a connection request. It
+// matches ErrMalformedMessage, ErrHandshakeTimeout or ErrWrongConnectionType
+// with errors.Is, depending on its status.
+type HandshakeError struct {
+	// Type is the type of the rejected connection.
+	Type types.ConnectionRequest_Type
+	// Status is the status sent by the server.
+	Status types.ConnectionResponse_Status
+	// Message is the message sent by the server.
+	Message string
+}
+
+// Error returns a human-readable error.
+func (e *HandshakeError) Error() string {
+	return fmt.Sprintf("%v connection rejected with status %v: %v", e.Type, e.Status, e.Message)
+}
+
+// Is checks if the error matches the sentinel error for its status.
+func (e *HandshakeError) Is(target error) bool {
+	switch e.Status {
+	case types.ConnectionResponse_MALFORMED_MESSAGE:
+		return target == ErrMalformedMessage
+	case types.ConnectionResponse_TIMEOUT:
+		return target == ErrHandshakeTimeout
+	case types.ConnectionResponse_WRONG_TYPE:
+		return target == ErrWrongConnectionType
+	}
+	return false
+}
+
+// VersionError is returned when the server's version isn't supported.
+type VersionError struct {
+	// Version is the server's version.
+	Version string
+}
+
+// Error returns a human-readable error.
+func (e *VersionError) Error() string {
+	return fmt.Sprintf(
+		"Server version %q is not supported (supported versions are %v up to but not including %v)",
+		e.Version, MinServerVersion, MaxServerVersion,
+	)
+}
+
+// handshake sends a connection request to a server and checks the response.
+func handshake(conn messageConn, request *types.ConnectionRequest) (*types.ConnectionResponse, error) {
+	out, err := proto.Marshal(request)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	if err := conn.WriteMessage(out); err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	in, err := conn.ReadMessage()
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	resp, err := connectionResponse(request.Type, in)
+	return resp, tracerr.Wrap(err)
+}
+
+// connectionResponse decodes the server's response to a connection request of
+// the given type and checks that the connection was accepted.
+func connectionResponse(typ types.ConnectionRequest_Type, in []byte) (*types.ConnectionResponse, error) {
+	var resp types.ConnectionResponse
+	if err := proto.Unmarshal(in, &resp); err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	if resp.Status != types.ConnectionResponse_OK {
+		return nil, tracerr.Wrap(&HandshakeError{
+			Type:    typ,
+			Status:  resp.Status,
+			Message: resp.Message,
+		})
+	}
+	return &resp, nil
+}
+
+// checkVersion checks that the server's version is supported. It must be
+// called before the RPC connection is pipelined.
+func (c *KRPCClient) checkVersion(ctx context.Context) error {
+	result, err := c.callDirect(ctx, "GetStatus")
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	var status types.Status
+	if err := proto.Unmarshal(result.Value, &status); err != nil {
+		return tracerr.Wrap(err)
+	}
+
+	version, err := parseVersion(status.Version)
+	if err != nil ||
+		compareVersions(version, mustParseVersion(MinServerVersion)) < 0 ||
+		compareVersions(version, mustParseVersion(MaxServerVersion)) >= 0 {
+		return tracerr.Wrap(&VersionError{Version: status.Version})
+	}
+	return nil
+}
+
+// callDirect calls a KRPC procedure without arguments while connecting,
+// before the RPC connection is pipelined.
+func (c *KRPCClient) callDirect(ctx context.Context, procedure string) (*types.ProcedureResult, error) {
+	out, err := proto.Marshal(&types.Request{Calls: []*types.ProcedureCall{{
+		Service:   "KRPC",
+		Procedure: procedure,
+	}}})
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	in, err := c.roundTrip(ctx, out)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	results, err := decodeResponse(in)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	return firstResult(results)
+}
+
+// parseVersion parses a version of the form major.minor.patch. Pre-release
+// and build suffixes, such as the "-dev" of development builds, are ignored.
+func parseVersion(s string) ([3]int, error) {
+	var version [3]int
+	release := s
+	if i := strings.IndexAny(release, "-+"); i >= 0 {
+		release = release[:i]
+	}
+	parts := strings.Split(release, ".")
+	if len(parts) > len(version) {
+		return version, tracerr.Errorf("Invalid version %q", s)
+	}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return version, tracerr.Errorf("Invalid version %q", s)
+		}
+		version[i] = n
+	}
+	return version, nil
+}
+
+func mustParseVersion(s string) [3]int {
+	version, err := parseVersion(s)
+	if err != nil {
+		panic(err)
+	}
+	return version
+}
+
+// compareVersions returns -1, 0 or 1 if a is older than, the same as or
+// newer than b.
+func compareVersions(a, b [3]int) int {
+	for i := range a {
+		if a[i] < b[i] {
+			return -1
+		}
+		if a[i] > b[i] {
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,68 @@
+package krpcgo
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/atburke/krpc-go/krpctest"
+	"github.com/atburke/krpc-go/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectErrors(t *testing.T) {
+	t.Run("dial", func(t *testing.T) {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		host, port, err := net.SplitHostPort(l.Addr().String())
+		require.NoError(t, err)
+		l.Close()
+
+		client := NewKRPCClient(KRPCClientConfig{Host: host, RPCPort: port})
+		err = client.Connect(context.Background())
+		var dialErr *DialError
+		require.ErrorAs(t, err, &dialErr)
+		require.Equal(t, net.JoinHostPort(host, port), dialErr.Addr)
+	})
+
+	t.Run("stream handshake", func(t *testing.T) {
+		server := krpctest.NewServer(t)
+		server.SetHandshakeStatus(types.ConnectionRequest_STREAM, types.ConnectionResponse_WRONG_TYPE)
+		client := NewKRPCClient(serverConfig(server, KRPCClientConfig{}))
+
+		err := client.Connect(context.Background())
+		require.ErrorIs(t, err, ErrWrongConnectionType)
+		var handshakeErr *HandshakeError
+		require.ErrorAs(t, err, &handshakeErr)
+		require.Equal(t, types.ConnectionRequest_STREAM, handshakeErr.Type)
+		// The RPC connection is closed again.
+		err = client.conn.WriteMessage([]byte{0})
+		require.ErrorIs(t, err, net.ErrClosed)
+	})
+
+	for _, version := range []string{"0.3.11", "0.6.0", "0.6.0-dev", "latest", "-dev"} {
+		version := version
+		t.Run("version "+version, func(t *testing.T) {
+			server := krpctest.NewServer(t)
+			server.SetVersion(version)
+			client := NewKRPCClient(serverConfig(server, KRPCClientConfig{RPCOnly: true}))
+
+			err := client.Connect(context.Background())
+			var versionErr *VersionError
+			require.ErrorAs(t, err, &versionErr)
+			require.Equal(t, version, versionErr.Version)
+		})
+	}
+
+	t.Run("pre-release version", func(t *testing.T) {
+		server := krpctest.NewServer(t)
+		server.SetVersion("0.5.4-dev+abc123")
+		connect(t, server, KRPCClientConfig{RPCOnly: true})
+	})
+
+	t.Run("skip version check", func(t *testing.T) {
+		server := krpctest.NewServer(t)
+		server.SetVersion("0.6.0")
+		connect(t, server, KRPCClientConfig{RPCOnly: true, SkipVersionCheck: true})
+	})
+}
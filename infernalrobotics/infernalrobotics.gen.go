@@ -7,8 +7,8 @@
 package infernalrobotics
 
 import (
+	"context"
 	krpcgo "github.com/atburke/krpc-go"
-	krpc "github.com/atburke/krpc-go/krpc"
 	encode "github.com/atburke/krpc-go/lib/encode"
 	service "github.com/atburke/krpc-go/lib/service"
 	spacecenter "github.com/atburke/krpc-go/spacecenter"
@@ -27,7 +27,7 @@ type Servo struct {
 }
 
 // NewServo creates a new Servo.
-func NewServo(id uint64, client *krpcgo.KRPCClient) *Servo {
+func NewServo(id uint64, client krpcgo.Caller) *Servo {
 	c := &Servo{BaseClass: service.BaseClass{Client: client}}
 	c.SetID(id)
 	return c
@@ -42,7 +42,7 @@ type ServoGroup struct {
 }
 
 // NewServoGroup creates a new ServoGroup.
-func NewServoGroup(id uint64, client *krpcgo.KRPCClient) *ServoGroup {
+func NewServoGroup(id uint64, client krpcgo.Caller) *ServoGroup {
 	c := &ServoGroup{BaseClass: service.BaseClass{Client: client}}
 	c.SetID(id)
 	return c
@@ -52,11 +52,11 @@ func NewServoGroup(id uint64, client *krpcgo.KRPCClient) *ServoGroup {
 // href="https://forum.kerbalspaceprogram.com/index.php?/topic/104535-112-magic-smoke-industries-infernal-robotics-202/">Infernal
 // Robotics</a>.
 type InfernalRobotics struct {
-	Client *krpcgo.KRPCClient
+	Client krpcgo.Caller
 }
 
 // New creates a new InfernalRobotics.
-func New(client *krpcgo.KRPCClient) *InfernalRobotics {
+func New(client krpcgo.Caller) *InfernalRobotics {
 	return &InfernalRobotics{Client: client}
 }
 
@@ -65,6 +65,14 @@ func New(client *krpcgo.KRPCClient) *InfernalRobotics {
 //
 // Allowed game scenes: any.
 func (s *InfernalRobotics) ServoGroups(vessel *spacecenter.Vessel) ([]*ServoGroup, error) {
+	return s.ServoGroupsContext(context.Background(), vessel)
+}
+
+// ServoGroupsContext - a list of all the servo groups in the given <paramref
+// name="vessel" />.
+//
+// Allowed game scenes: any.
+func (s *InfernalRobotics) ServoGroupsContext(ctx context.Context, vessel *spacecenter.Vessel) ([]*ServoGroup, error) {
 	var err error
 	var argBytes []byte
 	var vv []*ServoGroup
@@ -80,7 +88,7 @@ func (s *InfernalRobotics) ServoGroups(vessel *spacecenter.Vessel) ([]*ServoGrou
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -91,11 +99,41 @@ func (s *InfernalRobotics) ServoGroups(vessel *spacecenter.Vessel) ([]*ServoGrou
 	return vv, nil
 }
 
+// ServoGroupsAsync - a list of all the servo groups in the given <paramref
+// name="vessel" />.
+//
+// Allowed game scenes: any.
+func (s *InfernalRobotics) ServoGroupsAsync(vessel *spacecenter.Vessel) (*krpcgo.Future[[]*ServoGroup], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "ServoGroups",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(vessel)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) ([]*ServoGroup, error) {
+		var vv []*ServoGroup
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
 // ServoGroupsStream - a list of all the servo groups in the given <paramref
 // name="vessel" />.
 //
 // Allowed game scenes: any.
-func (s *InfernalRobotics) ServoGroupsStream(vessel *spacecenter.Vessel) (*krpcgo.Stream[[]*ServoGroup], error) {
+func (s *InfernalRobotics) ServoGroupsStream(vessel *spacecenter.Vessel, opts ...krpcgo.StreamOption) (*krpcgo.Stream[[]*ServoGroup], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
@@ -110,23 +148,52 @@ func (s *InfernalRobotics) ServoGroupsStream(vessel *spacecenter.Vessel) (*krpcg
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) []*ServoGroup {
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) ([]*ServoGroup, error) {
 		var value []*ServoGroup
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
 	return stream, nil
 }
 
+// ServoGroupsCall builds a call to ServoGroups without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *InfernalRobotics) ServoGroupsCall(vessel *spacecenter.Vessel) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "ServoGroups",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(vessel)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeServoGroups decodes the result of a call built with ServoGroupsCall.
+func (s *InfernalRobotics) DecodeServoGroups(result *types.ProcedureResult) ([]*ServoGroup, error) {
+	var vv []*ServoGroup
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
 // ServoGroupWithName - returns the servo group in the given <paramref
 // name="vessel" /> with the given <paramref name="name" />, or nil if none
 // exists. If multiple servo groups have the same name, only one of them is
@@ -134,6 +201,16 @@ func (s *InfernalRobotics) ServoGroupsStream(vessel *spacecenter.Vessel) (*krpcg
 //
 // Allowed game scenes: any.
 func (s *InfernalRobotics) ServoGroupWithName(vessel *spacecenter.Vessel, name string) (*ServoGroup, error) {
+	return s.ServoGroupWithNameContext(context.Background(), vessel, name)
+}
+
+// ServoGroupWithNameContext - returns the servo group in the given <paramref
+// name="vessel" /> with the given <paramref name="name" />, or nil if none
+// exists. If multiple servo groups have the same name, only one of them is
+// returned.
+//
+// Allowed game scenes: any.
+func (s *InfernalRobotics) ServoGroupWithNameContext(ctx context.Context, vessel *spacecenter.Vessel, name string) (*ServoGroup, error) {
 	var err error
 	var argBytes []byte
 	var vv ServoGroup
@@ -157,7 +234,7 @@ func (s *InfernalRobotics) ServoGroupWithName(vessel *spacecenter.Vessel, name s
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -169,12 +246,106 @@ func (s *InfernalRobotics) ServoGroupWithName(vessel *spacecenter.Vessel, name s
 	return &vv, nil
 }
 
+// ServoGroupWithNameAsync - returns the servo group in the given <paramref
+// name="vessel" /> with the given <paramref name="name" />, or nil if none
+// exists. If multiple servo groups have the same name, only one of them is
+// returned.
+//
+// Allowed game scenes: any.
+func (s *InfernalRobotics) ServoGroupWithNameAsync(vessel *spacecenter.Vessel, name string) (*krpcgo.Future[*ServoGroup], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "ServoGroupWithName",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(vessel)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(name)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*ServoGroup, error) {
+		var vv ServoGroup
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// ServoGroupWithNameCall builds a call to ServoGroupWithName without making it,
+// such as to make several calls with CallMultiple, add a stream with AddStream
+// or use it in an expression.
+func (s *InfernalRobotics) ServoGroupWithNameCall(vessel *spacecenter.Vessel, name string) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "ServoGroupWithName",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(vessel)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(name)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeServoGroupWithName decodes the result of a call built with
+// ServoGroupWithNameCall.
+func (s *InfernalRobotics) DecodeServoGroupWithName(result *types.ProcedureResult) (*ServoGroup, error) {
+	var vv ServoGroup
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
 // ServoWithName - returns the servo in the given <paramref name="vessel" />
 // with the given <paramref name="name" /> or nil if none exists. If multiple
 // servos have the same name, only one of them is returned.
 //
 // Allowed game scenes: any.
 func (s *InfernalRobotics) ServoWithName(vessel *spacecenter.Vessel, name string) (*Servo, error) {
+	return s.ServoWithNameContext(context.Background(), vessel, name)
+}
+
+// ServoWithNameContext - returns the servo in the given <paramref name="vessel"
+// /> with the given <paramref name="name" /> or nil if none exists. If multiple
+// servos have the same name, only one of them is returned.
+//
+// Allowed game scenes: any.
+func (s *InfernalRobotics) ServoWithNameContext(ctx context.Context, vessel *spacecenter.Vessel, name string) (*Servo, error) {
 	var err error
 	var argBytes []byte
 	var vv Servo
@@ -198,7 +369,7 @@ func (s *InfernalRobotics) ServoWithName(vessel *spacecenter.Vessel, name string
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -210,17 +381,108 @@ func (s *InfernalRobotics) ServoWithName(vessel *spacecenter.Vessel, name string
 	return &vv, nil
 }
 
+// ServoWithNameAsync - returns the servo in the given <paramref name="vessel"
+// /> with the given <paramref name="name" /> or nil if none exists. If multiple
+// servos have the same name, only one of them is returned.
+//
+// Allowed game scenes: any.
+func (s *InfernalRobotics) ServoWithNameAsync(vessel *spacecenter.Vessel, name string) (*krpcgo.Future[*Servo], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "ServoWithName",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(vessel)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(name)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Servo, error) {
+		var vv Servo
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// ServoWithNameCall builds a call to ServoWithName without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *InfernalRobotics) ServoWithNameCall(vessel *spacecenter.Vessel, name string) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "ServoWithName",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(vessel)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(name)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeServoWithName decodes the result of a call built with
+// ServoWithNameCall.
+func (s *InfernalRobotics) DecodeServoWithName(result *types.ProcedureResult) (*Servo, error) {
+	var vv Servo
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
 // Available - whether Infernal Robotics is installed.
 //
 // Allowed game scenes: any.
 func (s *InfernalRobotics) Available() (bool, error) {
+	return s.AvailableContext(context.Background())
+}
+
+// AvailableContext - whether Infernal Robotics is installed.
+//
+// Allowed game scenes: any.
+func (s *InfernalRobotics) AvailableContext(ctx context.Context) (bool, error) {
 	var err error
 	var vv bool
 	request := &types.ProcedureCall{
 		Procedure: "get_Available",
 		Service:   "InfernalRobotics",
 	}
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -231,43 +493,88 @@ func (s *InfernalRobotics) Available() (bool, error) {
 	return vv, nil
 }
 
+// AvailableAsync - whether Infernal Robotics is installed.
+//
+// Allowed game scenes: any.
+func (s *InfernalRobotics) AvailableAsync() (*krpcgo.Future[bool], error) {
+	request := &types.ProcedureCall{
+		Procedure: "get_Available",
+		Service:   "InfernalRobotics",
+	}
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (bool, error) {
+		var vv bool
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
 // AvailableStream - whether Infernal Robotics is installed.
 //
 // Allowed game scenes: any.
-func (s *InfernalRobotics) AvailableStream() (*krpcgo.Stream[bool], error) {
+func (s *InfernalRobotics) AvailableStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[bool], error) {
 	var err error
 	request := &types.ProcedureCall{
 		Procedure: "get_Available",
 		Service:   "InfernalRobotics",
 	}
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) bool {
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (bool, error) {
 		var value bool
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
 	return stream, nil
 }
 
+// AvailableCall builds a call to Available without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *InfernalRobotics) AvailableCall() (*types.ProcedureCall, error) {
+	request := &types.ProcedureCall{
+		Procedure: "get_Available",
+		Service:   "InfernalRobotics",
+	}
+	return request, nil
+}
+
+// DecodeAvailable decodes the result of a call built with AvailableCall.
+func (s *InfernalRobotics) DecodeAvailable(result *types.ProcedureResult) (bool, error) {
+	var vv bool
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
 // Ready - whether Infernal Robotics API is ready.
 //
 // Allowed game scenes: any.
 func (s *InfernalRobotics) Ready() (bool, error) {
+	return s.ReadyContext(context.Background())
+}
+
+// ReadyContext - whether Infernal Robotics API is ready.
+//
+// Allowed game scenes: any.
+func (s *InfernalRobotics) ReadyContext(ctx context.Context) (bool, error) {
 	var err error
 	var vv bool
 	request := &types.ProcedureCall{
 		Procedure: "get_Ready",
 		Service:   "InfernalRobotics",
 	}
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -278,36 +585,81 @@ func (s *InfernalRobotics) Ready() (bool, error) {
 	return vv, nil
 }
 
+// ReadyAsync - whether Infernal Robotics API is ready.
+//
+// Allowed game scenes: any.
+func (s *InfernalRobotics) ReadyAsync() (*krpcgo.Future[bool], error) {
+	request := &types.ProcedureCall{
+		Procedure: "get_Ready",
+		Service:   "InfernalRobotics",
+	}
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (bool, error) {
+		var vv bool
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
 // ReadyStream - whether Infernal Robotics API is ready.
 //
 // Allowed game scenes: any.
-func (s *InfernalRobotics) ReadyStream() (*krpcgo.Stream[bool], error) {
+func (s *InfernalRobotics) ReadyStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[bool], error) {
 	var err error
 	request := &types.ProcedureCall{
 		Procedure: "get_Ready",
 		Service:   "InfernalRobotics",
 	}
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) bool {
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (bool, error) {
 		var value bool
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
 	return stream, nil
 }
 
+// ReadyCall builds a call to Ready without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *InfernalRobotics) ReadyCall() (*types.ProcedureCall, error) {
+	request := &types.ProcedureCall{
+		Procedure: "get_Ready",
+		Service:   "InfernalRobotics",
+	}
+	return request, nil
+}
+
+// DecodeReady decodes the result of a call built with ReadyCall.
+func (s *InfernalRobotics) DecodeReady(result *types.ProcedureResult) (bool, error) {
+	var vv bool
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
 // MoveRight - moves the servo to the right.
 //
 // Allowed game scenes: any.
 func (s *Servo) MoveRight() error {
+	return s.MoveRightContext(context.Background())
+}
+
+// MoveRightContext - moves the servo to the right.
+//
+// Allowed game scenes: any.
+func (s *Servo) MoveRightContext(ctx context.Context) error {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
@@ -322,42 +674,146 @@ func (s *Servo) MoveRight() error {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
 		return tracerr.Wrap(err)
 	}
 	return nil
 }
 
-// MoveLeft - moves the servo to the left.
+// MoveRightAsync - moves the servo to the right.
 //
 // Allowed game scenes: any.
-func (s *Servo) MoveLeft() error {
+func (s *Servo) MoveRightAsync() (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Servo_MoveLeft",
+		Procedure: "Servo_MoveRight",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// MoveRightCall builds a call to MoveRight without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Servo) MoveRightCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_MoveRight",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// MoveLeft - moves the servo to the left.
+//
+// Allowed game scenes: any.
+func (s *Servo) MoveLeft() error {
+	return s.MoveLeftContext(context.Background())
+}
+
+// MoveLeftContext - moves the servo to the left.
+//
+// Allowed game scenes: any.
+func (s *Servo) MoveLeftContext(ctx context.Context) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_MoveLeft",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
 		return tracerr.Wrap(err)
 	}
 	return nil
 }
 
+// MoveLeftAsync - moves the servo to the left.
+//
+// Allowed game scenes: any.
+func (s *Servo) MoveLeftAsync() (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_MoveLeft",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// MoveLeftCall builds a call to MoveLeft without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Servo) MoveLeftCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_MoveLeft",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
 // MoveCenter - moves the servo to the center.
 //
 // Allowed game scenes: any.
 func (s *Servo) MoveCenter() error {
+	return s.MoveCenterContext(context.Background())
+}
+
+// MoveCenterContext - moves the servo to the center.
+//
+// Allowed game scenes: any.
+func (s *Servo) MoveCenterContext(ctx context.Context) error {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
@@ -372,17 +828,69 @@ func (s *Servo) MoveCenter() error {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
 		return tracerr.Wrap(err)
 	}
 	return nil
 }
 
+// MoveCenterAsync - moves the servo to the center.
+//
+// Allowed game scenes: any.
+func (s *Servo) MoveCenterAsync() (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_MoveCenter",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// MoveCenterCall builds a call to MoveCenter without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Servo) MoveCenterCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_MoveCenter",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
 // MoveNextPreset - moves the servo to the next preset.
 //
 // Allowed game scenes: any.
 func (s *Servo) MoveNextPreset() error {
+	return s.MoveNextPresetContext(context.Background())
+}
+
+// MoveNextPresetContext - moves the servo to the next preset.
+//
+// Allowed game scenes: any.
+func (s *Servo) MoveNextPresetContext(ctx context.Context) error {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
@@ -397,17 +905,69 @@ func (s *Servo) MoveNextPreset() error {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
 		return tracerr.Wrap(err)
 	}
 	return nil
 }
 
+// MoveNextPresetAsync - moves the servo to the next preset.
+//
+// Allowed game scenes: any.
+func (s *Servo) MoveNextPresetAsync() (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_MoveNextPreset",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// MoveNextPresetCall builds a call to MoveNextPreset without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *Servo) MoveNextPresetCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_MoveNextPreset",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
 // MovePrevPreset - moves the servo to the previous preset.
 //
 // Allowed game scenes: any.
 func (s *Servo) MovePrevPreset() error {
+	return s.MovePrevPresetContext(context.Background())
+}
+
+// MovePrevPresetContext - moves the servo to the previous preset.
+//
+// Allowed game scenes: any.
+func (s *Servo) MovePrevPresetContext(ctx context.Context) error {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
@@ -422,18 +982,71 @@ func (s *Servo) MovePrevPreset() error {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
 		return tracerr.Wrap(err)
 	}
 	return nil
 }
 
+// MovePrevPresetAsync - moves the servo to the previous preset.
+//
+// Allowed game scenes: any.
+func (s *Servo) MovePrevPresetAsync() (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_MovePrevPreset",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// MovePrevPresetCall builds a call to MovePrevPreset without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *Servo) MovePrevPresetCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_MovePrevPreset",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
 // MoveTo - moves the servo to <paramref name="position" /> and sets the speed
 // multiplier to <paramref name="speed" />.
 //
 // Allowed game scenes: any.
 func (s *Servo) MoveTo(position float32, speed float32) error {
+	return s.MoveToContext(context.Background(), position, speed)
+}
+
+// MoveToContext - moves the servo to <paramref name="position" /> and sets the
+// speed multiplier to <paramref name="speed" />.
+//
+// Allowed game scenes: any.
+func (s *Servo) MoveToContext(ctx context.Context, position float32, speed float32) error {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
@@ -464,160 +1077,3160 @@ func (s *Servo) MoveTo(position float32, speed float32) error {
 		Position: uint32(0x2),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
 		return tracerr.Wrap(err)
 	}
 	return nil
 }
 
-// Stop - stops the servo.
+// MoveToAsync - moves the servo to <paramref name="position" /> and sets the
+// speed multiplier to <paramref name="speed" />.
 //
 // Allowed game scenes: any.
-func (s *Servo) Stop() error {
+func (s *Servo) MoveToAsync(position float32, speed float32) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Servo_Stop",
+		Procedure: "Servo_MoveTo",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	argBytes, err = encode.Marshal(position)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	return nil
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(speed)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x2),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
 }
 
-// Name - the name of the servo.
-//
-// Allowed game scenes: any.
-func (s *Servo) Name() (string, error) {
+// MoveToCall builds a call to MoveTo without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Servo) MoveToCall(position float32, speed float32) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv string
 	request := &types.ProcedureCall{
-		Procedure: "Servo_get_Name",
+		Procedure: "Servo_MoveTo",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	argBytes, err = encode.Marshal(position)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(speed)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	return vv, nil
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x2),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// Stop - stops the servo.
+//
+// Allowed game scenes: any.
+func (s *Servo) Stop() error {
+	return s.StopContext(context.Background())
+}
+
+// StopContext - stops the servo.
+//
+// Allowed game scenes: any.
+func (s *Servo) StopContext(ctx context.Context) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_Stop",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// StopAsync - stops the servo.
+//
+// Allowed game scenes: any.
+func (s *Servo) StopAsync() (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_Stop",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// StopCall builds a call to Stop without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Servo) StopCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_Stop",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// Name - the name of the servo.
+//
+// Allowed game scenes: any.
+func (s *Servo) Name() (string, error) {
+	return s.NameContext(context.Background())
+}
+
+// NameContext - the name of the servo.
+//
+// Allowed game scenes: any.
+func (s *Servo) NameContext(ctx context.Context) (string, error) {
+	var err error
+	var argBytes []byte
+	var vv string
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_Name",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// NameAsync - the name of the servo.
+//
+// Allowed game scenes: any.
+func (s *Servo) NameAsync() (*krpcgo.Future[string], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_Name",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (string, error) {
+		var vv string
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// NameStream - the name of the servo.
+//
+// Allowed game scenes: any.
+func (s *Servo) NameStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[string], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_Name",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (string, error) {
+		var value string
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// NameCall builds a call to Name without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Servo) NameCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_Name",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeName decodes the result of a call built with NameCall.
+func (s *Servo) DecodeName(result *types.ProcedureResult) (string, error) {
+	var vv string
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetName - the name of the servo.
+//
+// Allowed game scenes: any.
+func (s *Servo) SetName(value string) error {
+	return s.SetNameContext(context.Background(), value)
+}
+
+// SetNameContext - the name of the servo.
+//
+// Allowed game scenes: any.
+func (s *Servo) SetNameContext(ctx context.Context, value string) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_set_Name",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetNameAsync - the name of the servo.
+//
+// Allowed game scenes: any.
+func (s *Servo) SetNameAsync(value string) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_set_Name",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetNameCall builds a call to SetName without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Servo) SetNameCall(value string) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_set_Name",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// Part - the part containing the servo.
+//
+// Allowed game scenes: any.
+func (s *Servo) Part() (*spacecenter.Part, error) {
+	return s.PartContext(context.Background())
+}
+
+// PartContext - the part containing the servo.
+//
+// Allowed game scenes: any.
+func (s *Servo) PartContext(ctx context.Context) (*spacecenter.Part, error) {
+	var err error
+	var argBytes []byte
+	var vv spacecenter.Part
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_Part",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// PartAsync - the part containing the servo.
+//
+// Allowed game scenes: any.
+func (s *Servo) PartAsync() (*krpcgo.Future[*spacecenter.Part], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_Part",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*spacecenter.Part, error) {
+		var vv spacecenter.Part
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// PartCall builds a call to Part without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Servo) PartCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_Part",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodePart decodes the result of a call built with PartCall.
+func (s *Servo) DecodePart(result *types.ProcedureResult) (*spacecenter.Part, error) {
+	var vv spacecenter.Part
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// SetHighlight - whether the servo should be highlighted in-game.
+//
+// Allowed game scenes: any.
+func (s *Servo) SetHighlight(value bool) error {
+	return s.SetHighlightContext(context.Background(), value)
+}
+
+// SetHighlightContext - whether the servo should be highlighted in-game.
+//
+// Allowed game scenes: any.
+func (s *Servo) SetHighlightContext(ctx context.Context, value bool) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_set_Highlight",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetHighlightAsync - whether the servo should be highlighted in-game.
+//
+// Allowed game scenes: any.
+func (s *Servo) SetHighlightAsync(value bool) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_set_Highlight",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetHighlightCall builds a call to SetHighlight without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *Servo) SetHighlightCall(value bool) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_set_Highlight",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// Position - the position of the servo.
+//
+// Allowed game scenes: any.
+func (s *Servo) Position() (float32, error) {
+	return s.PositionContext(context.Background())
+}
+
+// PositionContext - the position of the servo.
+//
+// Allowed game scenes: any.
+func (s *Servo) PositionContext(ctx context.Context) (float32, error) {
+	var err error
+	var argBytes []byte
+	var vv float32
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_Position",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// PositionAsync - the position of the servo.
+//
+// Allowed game scenes: any.
+func (s *Servo) PositionAsync() (*krpcgo.Future[float32], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_Position",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (float32, error) {
+		var vv float32
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// PositionStream - the position of the servo.
+//
+// Allowed game scenes: any.
+func (s *Servo) PositionStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[float32], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_Position",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (float32, error) {
+		var value float32
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// PositionCall builds a call to Position without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Servo) PositionCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_Position",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodePosition decodes the result of a call built with PositionCall.
+func (s *Servo) DecodePosition(result *types.ProcedureResult) (float32, error) {
+	var vv float32
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// MinConfigPosition - the minimum position of the servo, specified by the part
+// configuration.
+//
+// Allowed game scenes: any.
+func (s *Servo) MinConfigPosition() (float32, error) {
+	return s.MinConfigPositionContext(context.Background())
+}
+
+// MinConfigPositionContext - the minimum position of the servo, specified by
+// the part configuration.
+//
+// Allowed game scenes: any.
+func (s *Servo) MinConfigPositionContext(ctx context.Context) (float32, error) {
+	var err error
+	var argBytes []byte
+	var vv float32
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_MinConfigPosition",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// MinConfigPositionAsync - the minimum position of the servo, specified by the
+// part configuration.
+//
+// Allowed game scenes: any.
+func (s *Servo) MinConfigPositionAsync() (*krpcgo.Future[float32], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_MinConfigPosition",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (float32, error) {
+		var vv float32
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// MinConfigPositionStream - the minimum position of the servo, specified by the
+// part configuration.
+//
+// Allowed game scenes: any.
+func (s *Servo) MinConfigPositionStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[float32], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_MinConfigPosition",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (float32, error) {
+		var value float32
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// MinConfigPositionCall builds a call to MinConfigPosition without making it,
+// such as to make several calls with CallMultiple, add a stream with AddStream
+// or use it in an expression.
+func (s *Servo) MinConfigPositionCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_MinConfigPosition",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeMinConfigPosition decodes the result of a call built with
+// MinConfigPositionCall.
+func (s *Servo) DecodeMinConfigPosition(result *types.ProcedureResult) (float32, error) {
+	var vv float32
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// MaxConfigPosition - the maximum position of the servo, specified by the part
+// configuration.
+//
+// Allowed game scenes: any.
+func (s *Servo) MaxConfigPosition() (float32, error) {
+	return s.MaxConfigPositionContext(context.Background())
+}
+
+// MaxConfigPositionContext - the maximum position of the servo, specified by
+// the part configuration.
+//
+// Allowed game scenes: any.
+func (s *Servo) MaxConfigPositionContext(ctx context.Context) (float32, error) {
+	var err error
+	var argBytes []byte
+	var vv float32
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_MaxConfigPosition",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// MaxConfigPositionAsync - the maximum position of the servo, specified by the
+// part configuration.
+//
+// Allowed game scenes: any.
+func (s *Servo) MaxConfigPositionAsync() (*krpcgo.Future[float32], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_MaxConfigPosition",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (float32, error) {
+		var vv float32
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// MaxConfigPositionStream - the maximum position of the servo, specified by the
+// part configuration.
+//
+// Allowed game scenes: any.
+func (s *Servo) MaxConfigPositionStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[float32], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_MaxConfigPosition",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (float32, error) {
+		var value float32
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// MaxConfigPositionCall builds a call to MaxConfigPosition without making it,
+// such as to make several calls with CallMultiple, add a stream with AddStream
+// or use it in an expression.
+func (s *Servo) MaxConfigPositionCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_MaxConfigPosition",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeMaxConfigPosition decodes the result of a call built with
+// MaxConfigPositionCall.
+func (s *Servo) DecodeMaxConfigPosition(result *types.ProcedureResult) (float32, error) {
+	var vv float32
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// MinPosition - the minimum position of the servo, specified by the in-game
+// tweak menu.
+//
+// Allowed game scenes: any.
+func (s *Servo) MinPosition() (float32, error) {
+	return s.MinPositionContext(context.Background())
+}
+
+// MinPositionContext - the minimum position of the servo, specified by the
+// in-game tweak menu.
+//
+// Allowed game scenes: any.
+func (s *Servo) MinPositionContext(ctx context.Context) (float32, error) {
+	var err error
+	var argBytes []byte
+	var vv float32
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_MinPosition",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// MinPositionAsync - the minimum position of the servo, specified by the
+// in-game tweak menu.
+//
+// Allowed game scenes: any.
+func (s *Servo) MinPositionAsync() (*krpcgo.Future[float32], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_MinPosition",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (float32, error) {
+		var vv float32
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// MinPositionStream - the minimum position of the servo, specified by the
+// in-game tweak menu.
+//
+// Allowed game scenes: any.
+func (s *Servo) MinPositionStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[float32], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_MinPosition",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (float32, error) {
+		var value float32
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// MinPositionCall builds a call to MinPosition without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *Servo) MinPositionCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_MinPosition",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeMinPosition decodes the result of a call built with MinPositionCall.
+func (s *Servo) DecodeMinPosition(result *types.ProcedureResult) (float32, error) {
+	var vv float32
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetMinPosition - the minimum position of the servo, specified by the in-game
+// tweak menu.
+//
+// Allowed game scenes: any.
+func (s *Servo) SetMinPosition(value float32) error {
+	return s.SetMinPositionContext(context.Background(), value)
+}
+
+// SetMinPositionContext - the minimum position of the servo, specified by the
+// in-game tweak menu.
+//
+// Allowed game scenes: any.
+func (s *Servo) SetMinPositionContext(ctx context.Context, value float32) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_set_MinPosition",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetMinPositionAsync - the minimum position of the servo, specified by the
+// in-game tweak menu.
+//
+// Allowed game scenes: any.
+func (s *Servo) SetMinPositionAsync(value float32) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_set_MinPosition",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetMinPositionCall builds a call to SetMinPosition without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *Servo) SetMinPositionCall(value float32) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_set_MinPosition",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// MaxPosition - the maximum position of the servo, specified by the in-game
+// tweak menu.
+//
+// Allowed game scenes: any.
+func (s *Servo) MaxPosition() (float32, error) {
+	return s.MaxPositionContext(context.Background())
+}
+
+// MaxPositionContext - the maximum position of the servo, specified by the
+// in-game tweak menu.
+//
+// Allowed game scenes: any.
+func (s *Servo) MaxPositionContext(ctx context.Context) (float32, error) {
+	var err error
+	var argBytes []byte
+	var vv float32
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_MaxPosition",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// MaxPositionAsync - the maximum position of the servo, specified by the
+// in-game tweak menu.
+//
+// Allowed game scenes: any.
+func (s *Servo) MaxPositionAsync() (*krpcgo.Future[float32], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_MaxPosition",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (float32, error) {
+		var vv float32
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// MaxPositionStream - the maximum position of the servo, specified by the
+// in-game tweak menu.
+//
+// Allowed game scenes: any.
+func (s *Servo) MaxPositionStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[float32], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_MaxPosition",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (float32, error) {
+		var value float32
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// MaxPositionCall builds a call to MaxPosition without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *Servo) MaxPositionCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_MaxPosition",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeMaxPosition decodes the result of a call built with MaxPositionCall.
+func (s *Servo) DecodeMaxPosition(result *types.ProcedureResult) (float32, error) {
+	var vv float32
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetMaxPosition - the maximum position of the servo, specified by the in-game
+// tweak menu.
+//
+// Allowed game scenes: any.
+func (s *Servo) SetMaxPosition(value float32) error {
+	return s.SetMaxPositionContext(context.Background(), value)
+}
+
+// SetMaxPositionContext - the maximum position of the servo, specified by the
+// in-game tweak menu.
+//
+// Allowed game scenes: any.
+func (s *Servo) SetMaxPositionContext(ctx context.Context, value float32) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_set_MaxPosition",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetMaxPositionAsync - the maximum position of the servo, specified by the
+// in-game tweak menu.
+//
+// Allowed game scenes: any.
+func (s *Servo) SetMaxPositionAsync(value float32) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_set_MaxPosition",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetMaxPositionCall builds a call to SetMaxPosition without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *Servo) SetMaxPositionCall(value float32) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_set_MaxPosition",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// ConfigSpeed - the speed multiplier of the servo, specified by the part
+// configuration.
+//
+// Allowed game scenes: any.
+func (s *Servo) ConfigSpeed() (float32, error) {
+	return s.ConfigSpeedContext(context.Background())
+}
+
+// ConfigSpeedContext - the speed multiplier of the servo, specified by the part
+// configuration.
+//
+// Allowed game scenes: any.
+func (s *Servo) ConfigSpeedContext(ctx context.Context) (float32, error) {
+	var err error
+	var argBytes []byte
+	var vv float32
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_ConfigSpeed",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// ConfigSpeedAsync - the speed multiplier of the servo, specified by the part
+// configuration.
+//
+// Allowed game scenes: any.
+func (s *Servo) ConfigSpeedAsync() (*krpcgo.Future[float32], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_ConfigSpeed",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (float32, error) {
+		var vv float32
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// ConfigSpeedStream - the speed multiplier of the servo, specified by the part
+// configuration.
+//
+// Allowed game scenes: any.
+func (s *Servo) ConfigSpeedStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[float32], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_ConfigSpeed",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (float32, error) {
+		var value float32
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// ConfigSpeedCall builds a call to ConfigSpeed without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *Servo) ConfigSpeedCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_ConfigSpeed",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeConfigSpeed decodes the result of a call built with ConfigSpeedCall.
+func (s *Servo) DecodeConfigSpeed(result *types.ProcedureResult) (float32, error) {
+	var vv float32
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// Speed - the speed multiplier of the servo, specified by the in-game tweak
+// menu.
+//
+// Allowed game scenes: any.
+func (s *Servo) Speed() (float32, error) {
+	return s.SpeedContext(context.Background())
+}
+
+// SpeedContext - the speed multiplier of the servo, specified by the in-game
+// tweak menu.
+//
+// Allowed game scenes: any.
+func (s *Servo) SpeedContext(ctx context.Context) (float32, error) {
+	var err error
+	var argBytes []byte
+	var vv float32
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_Speed",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SpeedAsync - the speed multiplier of the servo, specified by the in-game
+// tweak menu.
+//
+// Allowed game scenes: any.
+func (s *Servo) SpeedAsync() (*krpcgo.Future[float32], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_Speed",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (float32, error) {
+		var vv float32
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// SpeedStream - the speed multiplier of the servo, specified by the in-game
+// tweak menu.
+//
+// Allowed game scenes: any.
+func (s *Servo) SpeedStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[float32], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_Speed",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (float32, error) {
+		var value float32
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// SpeedCall builds a call to Speed without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Servo) SpeedCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_Speed",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeSpeed decodes the result of a call built with SpeedCall.
+func (s *Servo) DecodeSpeed(result *types.ProcedureResult) (float32, error) {
+	var vv float32
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetSpeed - the speed multiplier of the servo, specified by the in-game tweak
+// menu.
+//
+// Allowed game scenes: any.
+func (s *Servo) SetSpeed(value float32) error {
+	return s.SetSpeedContext(context.Background(), value)
+}
+
+// SetSpeedContext - the speed multiplier of the servo, specified by the in-game
+// tweak menu.
+//
+// Allowed game scenes: any.
+func (s *Servo) SetSpeedContext(ctx context.Context, value float32) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_set_Speed",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetSpeedAsync - the speed multiplier of the servo, specified by the in-game
+// tweak menu.
+//
+// Allowed game scenes: any.
+func (s *Servo) SetSpeedAsync(value float32) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_set_Speed",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetSpeedCall builds a call to SetSpeed without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Servo) SetSpeedCall(value float32) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_set_Speed",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// CurrentSpeed - the current speed at which the servo is moving.
+//
+// Allowed game scenes: any.
+func (s *Servo) CurrentSpeed() (float32, error) {
+	return s.CurrentSpeedContext(context.Background())
+}
+
+// CurrentSpeedContext - the current speed at which the servo is moving.
+//
+// Allowed game scenes: any.
+func (s *Servo) CurrentSpeedContext(ctx context.Context) (float32, error) {
+	var err error
+	var argBytes []byte
+	var vv float32
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_CurrentSpeed",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// CurrentSpeedAsync - the current speed at which the servo is moving.
+//
+// Allowed game scenes: any.
+func (s *Servo) CurrentSpeedAsync() (*krpcgo.Future[float32], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_CurrentSpeed",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (float32, error) {
+		var vv float32
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// CurrentSpeedStream - the current speed at which the servo is moving.
+//
+// Allowed game scenes: any.
+func (s *Servo) CurrentSpeedStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[float32], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_CurrentSpeed",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (float32, error) {
+		var value float32
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// CurrentSpeedCall builds a call to CurrentSpeed without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *Servo) CurrentSpeedCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_CurrentSpeed",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeCurrentSpeed decodes the result of a call built with CurrentSpeedCall.
+func (s *Servo) DecodeCurrentSpeed(result *types.ProcedureResult) (float32, error) {
+	var vv float32
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetCurrentSpeed - the current speed at which the servo is moving.
+//
+// Allowed game scenes: any.
+func (s *Servo) SetCurrentSpeed(value float32) error {
+	return s.SetCurrentSpeedContext(context.Background(), value)
+}
+
+// SetCurrentSpeedContext - the current speed at which the servo is moving.
+//
+// Allowed game scenes: any.
+func (s *Servo) SetCurrentSpeedContext(ctx context.Context, value float32) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_set_CurrentSpeed",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetCurrentSpeedAsync - the current speed at which the servo is moving.
+//
+// Allowed game scenes: any.
+func (s *Servo) SetCurrentSpeedAsync(value float32) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_set_CurrentSpeed",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetCurrentSpeedCall builds a call to SetCurrentSpeed without making it, such
+// as to make several calls with CallMultiple, add a stream with AddStream or
+// use it in an expression.
+func (s *Servo) SetCurrentSpeedCall(value float32) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_set_CurrentSpeed",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// Acceleration - the current speed multiplier set in the UI.
+//
+// Allowed game scenes: any.
+func (s *Servo) Acceleration() (float32, error) {
+	return s.AccelerationContext(context.Background())
+}
+
+// AccelerationContext - the current speed multiplier set in the UI.
+//
+// Allowed game scenes: any.
+func (s *Servo) AccelerationContext(ctx context.Context) (float32, error) {
+	var err error
+	var argBytes []byte
+	var vv float32
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_Acceleration",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// AccelerationAsync - the current speed multiplier set in the UI.
+//
+// Allowed game scenes: any.
+func (s *Servo) AccelerationAsync() (*krpcgo.Future[float32], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_Acceleration",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (float32, error) {
+		var vv float32
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// AccelerationStream - the current speed multiplier set in the UI.
+//
+// Allowed game scenes: any.
+func (s *Servo) AccelerationStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[float32], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_Acceleration",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (float32, error) {
+		var value float32
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// AccelerationCall builds a call to Acceleration without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *Servo) AccelerationCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_Acceleration",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeAcceleration decodes the result of a call built with AccelerationCall.
+func (s *Servo) DecodeAcceleration(result *types.ProcedureResult) (float32, error) {
+	var vv float32
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetAcceleration - the current speed multiplier set in the UI.
+//
+// Allowed game scenes: any.
+func (s *Servo) SetAcceleration(value float32) error {
+	return s.SetAccelerationContext(context.Background(), value)
+}
+
+// SetAccelerationContext - the current speed multiplier set in the UI.
+//
+// Allowed game scenes: any.
+func (s *Servo) SetAccelerationContext(ctx context.Context, value float32) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_set_Acceleration",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetAccelerationAsync - the current speed multiplier set in the UI.
+//
+// Allowed game scenes: any.
+func (s *Servo) SetAccelerationAsync(value float32) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_set_Acceleration",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetAccelerationCall builds a call to SetAcceleration without making it, such
+// as to make several calls with CallMultiple, add a stream with AddStream or
+// use it in an expression.
+func (s *Servo) SetAccelerationCall(value float32) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_set_Acceleration",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// IsMoving - whether the servo is moving.
+//
+// Allowed game scenes: any.
+func (s *Servo) IsMoving() (bool, error) {
+	return s.IsMovingContext(context.Background())
+}
+
+// IsMovingContext - whether the servo is moving.
+//
+// Allowed game scenes: any.
+func (s *Servo) IsMovingContext(ctx context.Context) (bool, error) {
+	var err error
+	var argBytes []byte
+	var vv bool
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_IsMoving",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// IsMovingAsync - whether the servo is moving.
+//
+// Allowed game scenes: any.
+func (s *Servo) IsMovingAsync() (*krpcgo.Future[bool], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_IsMoving",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (bool, error) {
+		var vv bool
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// IsMovingStream - whether the servo is moving.
+//
+// Allowed game scenes: any.
+func (s *Servo) IsMovingStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[bool], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_IsMoving",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (bool, error) {
+		var value bool
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// IsMovingCall builds a call to IsMoving without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Servo) IsMovingCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_IsMoving",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeIsMoving decodes the result of a call built with IsMovingCall.
+func (s *Servo) DecodeIsMoving(result *types.ProcedureResult) (bool, error) {
+	var vv bool
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// IsFreeMoving - whether the servo is freely moving.
+//
+// Allowed game scenes: any.
+func (s *Servo) IsFreeMoving() (bool, error) {
+	return s.IsFreeMovingContext(context.Background())
+}
+
+// IsFreeMovingContext - whether the servo is freely moving.
+//
+// Allowed game scenes: any.
+func (s *Servo) IsFreeMovingContext(ctx context.Context) (bool, error) {
+	var err error
+	var argBytes []byte
+	var vv bool
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_IsFreeMoving",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// IsFreeMovingAsync - whether the servo is freely moving.
+//
+// Allowed game scenes: any.
+func (s *Servo) IsFreeMovingAsync() (*krpcgo.Future[bool], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_IsFreeMoving",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (bool, error) {
+		var vv bool
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// IsFreeMovingStream - whether the servo is freely moving.
+//
+// Allowed game scenes: any.
+func (s *Servo) IsFreeMovingStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[bool], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_IsFreeMoving",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (bool, error) {
+		var value bool
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// IsFreeMovingCall builds a call to IsFreeMoving without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *Servo) IsFreeMovingCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_IsFreeMoving",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeIsFreeMoving decodes the result of a call built with IsFreeMovingCall.
+func (s *Servo) DecodeIsFreeMoving(result *types.ProcedureResult) (bool, error) {
+	var vv bool
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// IsLocked - whether the servo is locked.
+//
+// Allowed game scenes: any.
+func (s *Servo) IsLocked() (bool, error) {
+	return s.IsLockedContext(context.Background())
+}
+
+// IsLockedContext - whether the servo is locked.
+//
+// Allowed game scenes: any.
+func (s *Servo) IsLockedContext(ctx context.Context) (bool, error) {
+	var err error
+	var argBytes []byte
+	var vv bool
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_IsLocked",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// IsLockedAsync - whether the servo is locked.
+//
+// Allowed game scenes: any.
+func (s *Servo) IsLockedAsync() (*krpcgo.Future[bool], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_IsLocked",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (bool, error) {
+		var vv bool
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// IsLockedStream - whether the servo is locked.
+//
+// Allowed game scenes: any.
+func (s *Servo) IsLockedStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[bool], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_IsLocked",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (bool, error) {
+		var value bool
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// IsLockedCall builds a call to IsLocked without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Servo) IsLockedCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_IsLocked",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeIsLocked decodes the result of a call built with IsLockedCall.
+func (s *Servo) DecodeIsLocked(result *types.ProcedureResult) (bool, error) {
+	var vv bool
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetIsLocked - whether the servo is locked.
+//
+// Allowed game scenes: any.
+func (s *Servo) SetIsLocked(value bool) error {
+	return s.SetIsLockedContext(context.Background(), value)
+}
+
+// SetIsLockedContext - whether the servo is locked.
+//
+// Allowed game scenes: any.
+func (s *Servo) SetIsLockedContext(ctx context.Context, value bool) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_set_IsLocked",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetIsLockedAsync - whether the servo is locked.
+//
+// Allowed game scenes: any.
+func (s *Servo) SetIsLockedAsync(value bool) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_set_IsLocked",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetIsLockedCall builds a call to SetIsLocked without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *Servo) SetIsLockedCall(value bool) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_set_IsLocked",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// IsAxisInverted - whether the servos axis is inverted.
+//
+// Allowed game scenes: any.
+func (s *Servo) IsAxisInverted() (bool, error) {
+	return s.IsAxisInvertedContext(context.Background())
+}
+
+// IsAxisInvertedContext - whether the servos axis is inverted.
+//
+// Allowed game scenes: any.
+func (s *Servo) IsAxisInvertedContext(ctx context.Context) (bool, error) {
+	var err error
+	var argBytes []byte
+	var vv bool
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_IsAxisInverted",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// IsAxisInvertedAsync - whether the servos axis is inverted.
+//
+// Allowed game scenes: any.
+func (s *Servo) IsAxisInvertedAsync() (*krpcgo.Future[bool], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_IsAxisInverted",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (bool, error) {
+		var vv bool
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// IsAxisInvertedStream - whether the servos axis is inverted.
+//
+// Allowed game scenes: any.
+func (s *Servo) IsAxisInvertedStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[bool], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_IsAxisInverted",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (bool, error) {
+		var value bool
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// IsAxisInvertedCall builds a call to IsAxisInverted without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *Servo) IsAxisInvertedCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_get_IsAxisInverted",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeIsAxisInverted decodes the result of a call built with
+// IsAxisInvertedCall.
+func (s *Servo) DecodeIsAxisInverted(result *types.ProcedureResult) (bool, error) {
+	var vv bool
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetIsAxisInverted - whether the servos axis is inverted.
+//
+// Allowed game scenes: any.
+func (s *Servo) SetIsAxisInverted(value bool) error {
+	return s.SetIsAxisInvertedContext(context.Background(), value)
+}
+
+// SetIsAxisInvertedContext - whether the servos axis is inverted.
+//
+// Allowed game scenes: any.
+func (s *Servo) SetIsAxisInvertedContext(ctx context.Context, value bool) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_set_IsAxisInverted",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetIsAxisInvertedAsync - whether the servos axis is inverted.
+//
+// Allowed game scenes: any.
+func (s *Servo) SetIsAxisInvertedAsync(value bool) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_set_IsAxisInverted",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetIsAxisInvertedCall builds a call to SetIsAxisInverted without making it,
+// such as to make several calls with CallMultiple, add a stream with AddStream
+// or use it in an expression.
+func (s *Servo) SetIsAxisInvertedCall(value bool) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Servo_set_IsAxisInverted",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// ServoWithName - returns the servo with the given <paramref name="name" />
+// from this group, or nil if none exists.
+//
+// Allowed game scenes: any.
+func (s *ServoGroup) ServoWithName(name string) (*Servo, error) {
+	return s.ServoWithNameContext(context.Background(), name)
 }
 
-// NameStream - the name of the servo.
+// ServoWithNameContext - returns the servo with the given <paramref name="name"
+// /> from this group, or nil if none exists.
 //
 // Allowed game scenes: any.
-func (s *Servo) NameStream() (*krpcgo.Stream[string], error) {
+func (s *ServoGroup) ServoWithNameContext(ctx context.Context, name string) (*Servo, error) {
 	var err error
 	var argBytes []byte
+	var vv Servo
 	request := &types.ProcedureCall{
-		Procedure: "Servo_get_Name",
+		Procedure: "ServoGroup_ServoWithName",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return nil, tracerr.Wrap(err)
+		return &vv, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	argBytes, err = encode.Marshal(name)
 	if err != nil {
-		return nil, tracerr.Wrap(err)
+		return &vv, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) string {
-		var value string
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
 	})
-	return stream, nil
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
 }
 
-// SetName - the name of the servo.
+// ServoWithNameAsync - returns the servo with the given <paramref name="name"
+// /> from this group, or nil if none exists.
 //
 // Allowed game scenes: any.
-func (s *Servo) SetName(value string) error {
+func (s *ServoGroup) ServoWithNameAsync(name string) (*krpcgo.Future[*Servo], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Servo_set_Name",
+		Procedure: "ServoGroup_ServoWithName",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
+	argBytes, err = encode.Marshal(name)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Servo, error) {
+		var vv Servo
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
 }
 
-// Part - the part containing the servo.
-//
-// Allowed game scenes: any.
-func (s *Servo) Part() (*spacecenter.Part, error) {
+// ServoWithNameCall builds a call to ServoWithName without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *ServoGroup) ServoWithNameCall(name string) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv spacecenter.Part
 	request := &types.ProcedureCall{
-		Procedure: "Servo_get_Part",
+		Procedure: "ServoGroup_ServoWithName",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	argBytes, err = encode.Marshal(name)
 	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeServoWithName decodes the result of a call built with
+// ServoWithNameCall.
+func (s *ServoGroup) DecodeServoWithName(result *types.ProcedureResult) (*Servo, error) {
+	var vv Servo
+	if err := krpcgo.ResultError(result); err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -625,14 +4238,21 @@ func (s *Servo) Part() (*spacecenter.Part, error) {
 	return &vv, nil
 }
 
-// SetHighlight - whether the servo should be highlighted in-game.
+// MoveRight - moves all of the servos in the group to the right.
 //
 // Allowed game scenes: any.
-func (s *Servo) SetHighlight(value bool) error {
+func (s *ServoGroup) MoveRight() error {
+	return s.MoveRightContext(context.Background())
+}
+
+// MoveRightContext - moves all of the servos in the group to the right.
+//
+// Allowed game scenes: any.
+func (s *ServoGroup) MoveRightContext(ctx context.Context) error {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Servo_set_Highlight",
+		Procedure: "ServoGroup_MoveRight",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -643,59 +4263,45 @@ func (s *Servo) SetHighlight(value bool) error {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
-	})
-	_, err = s.Client.Call(request)
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
 		return tracerr.Wrap(err)
 	}
 	return nil
 }
 
-// Position - the position of the servo.
+// MoveRightAsync - moves all of the servos in the group to the right.
 //
 // Allowed game scenes: any.
-func (s *Servo) Position() (float32, error) {
+func (s *ServoGroup) MoveRightAsync() (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
-	var vv float32
 	request := &types.ProcedureCall{
-		Procedure: "Servo_get_Position",
+		Procedure: "ServoGroup_MoveRight",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	return vv, nil
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
 }
 
-// PositionStream - the position of the servo.
-//
-// Allowed game scenes: any.
-func (s *Servo) PositionStream() (*krpcgo.Stream[float32], error) {
+// MoveRightCall builds a call to MoveRight without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *ServoGroup) MoveRightCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Servo_get_Position",
+		Procedure: "ServoGroup_MoveRight",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -706,63 +4312,49 @@ func (s *Servo) PositionStream() (*krpcgo.Stream[float32], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) float32 {
-		var value float32
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
-	})
-	return stream, nil
+	return request, nil
 }
 
-// MinConfigPosition - the minimum position of the servo, specified by the part
-// configuration.
+// MoveLeft - moves all of the servos in the group to the left.
 //
 // Allowed game scenes: any.
-func (s *Servo) MinConfigPosition() (float32, error) {
+func (s *ServoGroup) MoveLeft() error {
+	return s.MoveLeftContext(context.Background())
+}
+
+// MoveLeftContext - moves all of the servos in the group to the left.
+//
+// Allowed game scenes: any.
+func (s *ServoGroup) MoveLeftContext(ctx context.Context) error {
 	var err error
 	var argBytes []byte
-	var vv float32
 	request := &types.ProcedureCall{
-		Procedure: "Servo_get_MinConfigPosition",
+		Procedure: "ServoGroup_MoveLeft",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	return vv, nil
+	return nil
 }
 
-// MinConfigPositionStream - the minimum position of the servo, specified by the
-// part configuration.
+// MoveLeftAsync - moves all of the servos in the group to the left.
 //
 // Allowed game scenes: any.
-func (s *Servo) MinConfigPositionStream() (*krpcgo.Stream[float32], error) {
+func (s *ServoGroup) MoveLeftAsync() (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Servo_get_MinConfigPosition",
+		Procedure: "ServoGroup_MoveLeft",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -773,130 +4365,97 @@ func (s *Servo) MinConfigPositionStream() (*krpcgo.Stream[float32], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) float32 {
-		var value float32
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// MaxConfigPosition - the maximum position of the servo, specified by the part
-// configuration.
-//
-// Allowed game scenes: any.
-func (s *Servo) MaxConfigPosition() (float32, error) {
+// MoveLeftCall builds a call to MoveLeft without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *ServoGroup) MoveLeftCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv float32
 	request := &types.ProcedureCall{
-		Procedure: "Servo_get_MaxConfigPosition",
+		Procedure: "ServoGroup_MoveLeft",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	return vv, nil
+	return request, nil
 }
 
-// MaxConfigPositionStream - the maximum position of the servo, specified by the
-// part configuration.
+// MoveCenter - moves all of the servos in the group to the center.
+//
+// Allowed game scenes: any.
+func (s *ServoGroup) MoveCenter() error {
+	return s.MoveCenterContext(context.Background())
+}
+
+// MoveCenterContext - moves all of the servos in the group to the center.
 //
 // Allowed game scenes: any.
-func (s *Servo) MaxConfigPositionStream() (*krpcgo.Stream[float32], error) {
+func (s *ServoGroup) MoveCenterContext(ctx context.Context) error {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Servo_get_MaxConfigPosition",
+		Procedure: "ServoGroup_MoveCenter",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return nil, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
-		return nil, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) float32 {
-		var value float32
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
-	})
-	return stream, nil
+	return nil
 }
 
-// MinPosition - the minimum position of the servo, specified by the in-game
-// tweak menu.
+// MoveCenterAsync - moves all of the servos in the group to the center.
 //
 // Allowed game scenes: any.
-func (s *Servo) MinPosition() (float32, error) {
+func (s *ServoGroup) MoveCenterAsync() (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
-	var vv float32
 	request := &types.ProcedureCall{
-		Procedure: "Servo_get_MinPosition",
+		Procedure: "ServoGroup_MoveCenter",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	return vv, nil
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
 }
 
-// MinPositionStream - the minimum position of the servo, specified by the
-// in-game tweak menu.
-//
-// Allowed game scenes: any.
-func (s *Servo) MinPositionStream() (*krpcgo.Stream[float32], error) {
+// MoveCenterCall builds a call to MoveCenter without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *ServoGroup) MoveCenterCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Servo_get_MinPosition",
+		Procedure: "ServoGroup_MoveCenter",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -907,32 +4466,25 @@ func (s *Servo) MinPositionStream() (*krpcgo.Stream[float32], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) float32 {
-		var value float32
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
-	})
-	return stream, nil
+	return request, nil
 }
 
-// SetMinPosition - the minimum position of the servo, specified by the in-game
-// tweak menu.
+// MoveNextPreset - moves all of the servos in the group to the next preset.
 //
 // Allowed game scenes: any.
-func (s *Servo) SetMinPosition(value float32) error {
+func (s *ServoGroup) MoveNextPreset() error {
+	return s.MoveNextPresetContext(context.Background())
+}
+
+// MoveNextPresetContext - moves all of the servos in the group to the next
+// preset.
+//
+// Allowed game scenes: any.
+func (s *ServoGroup) MoveNextPresetContext(ctx context.Context) error {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Servo_set_MinPosition",
+		Procedure: "ServoGroup_MoveNextPreset",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -943,61 +4495,46 @@ func (s *Servo) SetMinPosition(value float32) error {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
-	})
-	_, err = s.Client.Call(request)
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
 		return tracerr.Wrap(err)
 	}
 	return nil
 }
 
-// MaxPosition - the maximum position of the servo, specified by the in-game
-// tweak menu.
+// MoveNextPresetAsync - moves all of the servos in the group to the next
+// preset.
 //
 // Allowed game scenes: any.
-func (s *Servo) MaxPosition() (float32, error) {
+func (s *ServoGroup) MoveNextPresetAsync() (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
-	var vv float32
 	request := &types.ProcedureCall{
-		Procedure: "Servo_get_MaxPosition",
+		Procedure: "ServoGroup_MoveNextPreset",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	return vv, nil
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
 }
 
-// MaxPositionStream - the maximum position of the servo, specified by the
-// in-game tweak menu.
-//
-// Allowed game scenes: any.
-func (s *Servo) MaxPositionStream() (*krpcgo.Stream[float32], error) {
+// MoveNextPresetCall builds a call to MoveNextPreset without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *ServoGroup) MoveNextPresetCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Servo_get_MaxPosition",
+		Procedure: "ServoGroup_MoveNextPreset",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1008,32 +4545,25 @@ func (s *Servo) MaxPositionStream() (*krpcgo.Stream[float32], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) float32 {
-		var value float32
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
-	})
-	return stream, nil
+	return request, nil
+}
+
+// MovePrevPreset - moves all of the servos in the group to the previous preset.
+//
+// Allowed game scenes: any.
+func (s *ServoGroup) MovePrevPreset() error {
+	return s.MovePrevPresetContext(context.Background())
 }
 
-// SetMaxPosition - the maximum position of the servo, specified by the in-game
-// tweak menu.
+// MovePrevPresetContext - moves all of the servos in the group to the previous
+// preset.
 //
 // Allowed game scenes: any.
-func (s *Servo) SetMaxPosition(value float32) error {
+func (s *ServoGroup) MovePrevPresetContext(ctx context.Context) error {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Servo_set_MaxPosition",
+		Procedure: "ServoGroup_MovePrevPreset",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1044,61 +4574,46 @@ func (s *Servo) SetMaxPosition(value float32) error {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
-	})
-	_, err = s.Client.Call(request)
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
 		return tracerr.Wrap(err)
 	}
 	return nil
 }
 
-// ConfigSpeed - the speed multiplier of the servo, specified by the part
-// configuration.
+// MovePrevPresetAsync - moves all of the servos in the group to the previous
+// preset.
 //
 // Allowed game scenes: any.
-func (s *Servo) ConfigSpeed() (float32, error) {
+func (s *ServoGroup) MovePrevPresetAsync() (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
-	var vv float32
 	request := &types.ProcedureCall{
-		Procedure: "Servo_get_ConfigSpeed",
+		Procedure: "ServoGroup_MovePrevPreset",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	return vv, nil
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
 }
 
-// ConfigSpeedStream - the speed multiplier of the servo, specified by the part
-// configuration.
-//
-// Allowed game scenes: any.
-func (s *Servo) ConfigSpeedStream() (*krpcgo.Stream[float32], error) {
+// MovePrevPresetCall builds a call to MovePrevPreset without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *ServoGroup) MovePrevPresetCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Servo_get_ConfigSpeed",
+		Procedure: "ServoGroup_MovePrevPreset",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1109,63 +4624,49 @@ func (s *Servo) ConfigSpeedStream() (*krpcgo.Stream[float32], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) float32 {
-		var value float32
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
-	})
-	return stream, nil
+	return request, nil
 }
 
-// Speed - the speed multiplier of the servo, specified by the in-game tweak
-// menu.
+// Stop - stops the servos in the group.
 //
 // Allowed game scenes: any.
-func (s *Servo) Speed() (float32, error) {
+func (s *ServoGroup) Stop() error {
+	return s.StopContext(context.Background())
+}
+
+// StopContext - stops the servos in the group.
+//
+// Allowed game scenes: any.
+func (s *ServoGroup) StopContext(ctx context.Context) error {
 	var err error
 	var argBytes []byte
-	var vv float32
 	request := &types.ProcedureCall{
-		Procedure: "Servo_get_Speed",
+		Procedure: "ServoGroup_Stop",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	return vv, nil
+	return nil
 }
 
-// SpeedStream - the speed multiplier of the servo, specified by the in-game
-// tweak menu.
+// StopAsync - stops the servos in the group.
 //
 // Allowed game scenes: any.
-func (s *Servo) SpeedStream() (*krpcgo.Stream[float32], error) {
+func (s *ServoGroup) StopAsync() (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Servo_get_Speed",
+		Procedure: "ServoGroup_Stop",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1176,66 +4677,49 @@ func (s *Servo) SpeedStream() (*krpcgo.Stream[float32], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) float32 {
-		var value float32
-		encode.Unmarshal(b, &value)
-		return value
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
 	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
-	})
-	return stream, nil
+	return future, nil
 }
 
-// SetSpeed - the speed multiplier of the servo, specified by the in-game tweak
-// menu.
-//
-// Allowed game scenes: any.
-func (s *Servo) SetSpeed(value float32) error {
+// StopCall builds a call to Stop without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *ServoGroup) StopCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Servo_set_Speed",
+		Procedure: "ServoGroup_Stop",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
-	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return request, nil
 }
 
-// CurrentSpeed - the current speed at which the servo is moving.
+// Name - the name of the group.
 //
 // Allowed game scenes: any.
-func (s *Servo) CurrentSpeed() (float32, error) {
+func (s *ServoGroup) Name() (string, error) {
+	return s.NameContext(context.Background())
+}
+
+// NameContext - the name of the group.
+//
+// Allowed game scenes: any.
+func (s *ServoGroup) NameContext(ctx context.Context) (string, error) {
 	var err error
 	var argBytes []byte
-	var vv float32
+	var vv string
 	request := &types.ProcedureCall{
-		Procedure: "Servo_get_CurrentSpeed",
+		Procedure: "ServoGroup_get_Name",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1246,7 +4730,7 @@ func (s *Servo) CurrentSpeed() (float32, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -1257,14 +4741,14 @@ func (s *Servo) CurrentSpeed() (float32, error) {
 	return vv, nil
 }
 
-// CurrentSpeedStream - the current speed at which the servo is moving.
+// NameAsync - the name of the group.
 //
 // Allowed game scenes: any.
-func (s *Servo) CurrentSpeedStream() (*krpcgo.Stream[float32], error) {
+func (s *ServoGroup) NameAsync() (*krpcgo.Future[string], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Servo_get_CurrentSpeed",
+		Procedure: "ServoGroup_get_Name",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1275,129 +4759,96 @@ func (s *Servo) CurrentSpeedStream() (*krpcgo.Stream[float32], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) float32 {
-		var value float32
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (string, error) {
+		var vv string
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetCurrentSpeed - the current speed at which the servo is moving.
+// NameStream - the name of the group.
 //
 // Allowed game scenes: any.
-func (s *Servo) SetCurrentSpeed(value float32) error {
+func (s *ServoGroup) NameStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[string], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Servo_set_CurrentSpeed",
+		Procedure: "ServoGroup_get_Name",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (string, error) {
+		var value string
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return stream, nil
 }
 
-// Acceleration - the current speed multiplier set in the UI.
-//
-// Allowed game scenes: any.
-func (s *Servo) Acceleration() (float32, error) {
+// NameCall builds a call to Name without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *ServoGroup) NameCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv float32
 	request := &types.ProcedureCall{
-		Procedure: "Servo_get_Acceleration",
+		Procedure: "ServoGroup_get_Name",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
+	return request, nil
+}
+
+// DecodeName decodes the result of a call built with NameCall.
+func (s *ServoGroup) DecodeName(result *types.ProcedureResult) (string, error) {
+	var vv string
+	if err := krpcgo.ResultError(result); err != nil {
 		return vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
 	return vv, nil
 }
 
-// AccelerationStream - the current speed multiplier set in the UI.
+// SetName - the name of the group.
 //
 // Allowed game scenes: any.
-func (s *Servo) AccelerationStream() (*krpcgo.Stream[float32], error) {
-	var err error
-	var argBytes []byte
-	request := &types.ProcedureCall{
-		Procedure: "Servo_get_Acceleration",
-		Service:   "InfernalRobotics",
-	}
-	argBytes, err = encode.Marshal(s)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x0),
-		Value:    argBytes,
-	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) float32 {
-		var value float32
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
-	})
-	return stream, nil
+func (s *ServoGroup) SetName(value string) error {
+	return s.SetNameContext(context.Background(), value)
 }
 
-// SetAcceleration - the current speed multiplier set in the UI.
+// SetNameContext - the name of the group.
 //
 // Allowed game scenes: any.
-func (s *Servo) SetAcceleration(value float32) error {
+func (s *ServoGroup) SetNameContext(ctx context.Context, value string) error {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Servo_set_Acceleration",
+		Procedure: "ServoGroup_set_Name",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1416,51 +4867,53 @@ func (s *Servo) SetAcceleration(value float32) error {
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
 		return tracerr.Wrap(err)
 	}
 	return nil
 }
 
-// IsMoving - whether the servo is moving.
+// SetNameAsync - the name of the group.
 //
 // Allowed game scenes: any.
-func (s *Servo) IsMoving() (bool, error) {
+func (s *ServoGroup) SetNameAsync(value string) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
-	var vv bool
 	request := &types.ProcedureCall{
-		Procedure: "Servo_get_IsMoving",
+		Procedure: "ServoGroup_set_Name",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	return vv, nil
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
 }
 
-// IsMovingStream - whether the servo is moving.
-//
-// Allowed game scenes: any.
-func (s *Servo) IsMovingStream() (*krpcgo.Stream[bool], error) {
+// SetNameCall builds a call to SetName without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *ServoGroup) SetNameCall(value string) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Servo_get_IsMoving",
+		Procedure: "ServoGroup_set_Name",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1471,32 +4924,33 @@ func (s *Servo) IsMovingStream() (*krpcgo.Stream[bool], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) bool {
-		var value bool
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
 	})
-	return stream, nil
+	return request, nil
 }
 
-// IsFreeMoving - whether the servo is freely moving.
+// ForwardKey - the key assigned to be the "forward" key for the group.
 //
 // Allowed game scenes: any.
-func (s *Servo) IsFreeMoving() (bool, error) {
+func (s *ServoGroup) ForwardKey() (string, error) {
+	return s.ForwardKeyContext(context.Background())
+}
+
+// ForwardKeyContext - the key assigned to be the "forward" key for the group.
+//
+// Allowed game scenes: any.
+func (s *ServoGroup) ForwardKeyContext(ctx context.Context) (string, error) {
 	var err error
 	var argBytes []byte
-	var vv bool
+	var vv string
 	request := &types.ProcedureCall{
-		Procedure: "Servo_get_IsFreeMoving",
+		Procedure: "ServoGroup_get_ForwardKey",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1507,7 +4961,7 @@ func (s *Servo) IsFreeMoving() (bool, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -1518,14 +4972,14 @@ func (s *Servo) IsFreeMoving() (bool, error) {
 	return vv, nil
 }
 
-// IsFreeMovingStream - whether the servo is freely moving.
+// ForwardKeyAsync - the key assigned to be the "forward" key for the group.
 //
 // Allowed game scenes: any.
-func (s *Servo) IsFreeMovingStream() (*krpcgo.Stream[bool], error) {
+func (s *ServoGroup) ForwardKeyAsync() (*krpcgo.Future[string], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Servo_get_IsFreeMoving",
+		Procedure: "ServoGroup_get_ForwardKey",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1536,61 +4990,55 @@ func (s *Servo) IsFreeMovingStream() (*krpcgo.Stream[bool], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) bool {
-		var value bool
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (string, error) {
+		var vv string
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// IsLocked - whether the servo is locked.
+// ForwardKeyStream - the key assigned to be the "forward" key for the group.
 //
 // Allowed game scenes: any.
-func (s *Servo) IsLocked() (bool, error) {
+func (s *ServoGroup) ForwardKeyStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[string], error) {
 	var err error
 	var argBytes []byte
-	var vv bool
 	request := &types.ProcedureCall{
-		Procedure: "Servo_get_IsLocked",
+		Procedure: "ServoGroup_get_ForwardKey",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	return vv, nil
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (string, error) {
+		var value string
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
 }
 
-// IsLockedStream - whether the servo is locked.
-//
-// Allowed game scenes: any.
-func (s *Servo) IsLockedStream() (*krpcgo.Stream[bool], error) {
+// ForwardKeyCall builds a call to ForwardKey without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *ServoGroup) ForwardKeyCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Servo_get_IsLocked",
+		Procedure: "ServoGroup_get_ForwardKey",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1601,31 +5049,38 @@ func (s *Servo) IsLockedStream() (*krpcgo.Stream[bool], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	return request, nil
+}
+
+// DecodeForwardKey decodes the result of a call built with ForwardKeyCall.
+func (s *ServoGroup) DecodeForwardKey(result *types.ProcedureResult) (string, error) {
+	var vv string
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return nil, tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) bool {
-		var value bool
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
-	})
-	return stream, nil
+	return vv, nil
 }
 
-// SetIsLocked - whether the servo is locked.
+// SetForwardKey - the key assigned to be the "forward" key for the group.
 //
 // Allowed game scenes: any.
-func (s *Servo) SetIsLocked(value bool) error {
+func (s *ServoGroup) SetForwardKey(value string) error {
+	return s.SetForwardKeyContext(context.Background(), value)
+}
+
+// SetForwardKeyContext - the key assigned to be the "forward" key for the
+// group.
+//
+// Allowed game scenes: any.
+func (s *ServoGroup) SetForwardKeyContext(ctx context.Context, value string) error {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Servo_set_IsLocked",
+		Procedure: "ServoGroup_set_ForwardKey",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1644,91 +5099,58 @@ func (s *Servo) SetIsLocked(value bool) error {
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
 		return tracerr.Wrap(err)
 	}
 	return nil
 }
 
-// IsAxisInverted - whether the servos axis is inverted.
+// SetForwardKeyAsync - the key assigned to be the "forward" key for the group.
 //
 // Allowed game scenes: any.
-func (s *Servo) IsAxisInverted() (bool, error) {
+func (s *ServoGroup) SetForwardKeyAsync(value string) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
-	var vv bool
 	request := &types.ProcedureCall{
-		Procedure: "Servo_get_IsAxisInverted",
+		Procedure: "ServoGroup_set_ForwardKey",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	return vv, nil
-}
-
-// IsAxisInvertedStream - whether the servos axis is inverted.
-//
-// Allowed game scenes: any.
-func (s *Servo) IsAxisInvertedStream() (*krpcgo.Stream[bool], error) {
-	var err error
-	var argBytes []byte
-	request := &types.ProcedureCall{
-		Procedure: "Servo_get_IsAxisInverted",
-		Service:   "InfernalRobotics",
-	}
-	argBytes, err = encode.Marshal(s)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x0),
+		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) bool {
-		var value bool
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetIsAxisInverted - whether the servos axis is inverted.
-//
-// Allowed game scenes: any.
-func (s *Servo) SetIsAxisInverted(value bool) error {
+// SetForwardKeyCall builds a call to SetForwardKey without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *ServoGroup) SetForwardKeyCall(value string) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Servo_set_IsAxisInverted",
+		Procedure: "ServoGroup_set_ForwardKey",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
@@ -1736,142 +5158,161 @@ func (s *Servo) SetIsAxisInverted(value bool) error {
 	})
 	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return request, nil
 }
 
-// ServoWithName - returns the servo with the given <paramref name="name" />
-// from this group, or nil if none exists.
+// ReverseKey - the key assigned to be the "reverse" key for the group.
 //
 // Allowed game scenes: any.
-func (s *ServoGroup) ServoWithName(name string) (*Servo, error) {
+func (s *ServoGroup) ReverseKey() (string, error) {
+	return s.ReverseKeyContext(context.Background())
+}
+
+// ReverseKeyContext - the key assigned to be the "reverse" key for the group.
+//
+// Allowed game scenes: any.
+func (s *ServoGroup) ReverseKeyContext(ctx context.Context) (string, error) {
 	var err error
 	var argBytes []byte
-	var vv Servo
+	var vv string
 	request := &types.ProcedureCall{
-		Procedure: "ServoGroup_ServoWithName",
+		Procedure: "ServoGroup_get_ReverseKey",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(name)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
-	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
 	err = encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	vv.Client = s.Client
-	return &vv, nil
+	return vv, nil
 }
 
-// MoveRight - moves all of the servos in the group to the right.
+// ReverseKeyAsync - the key assigned to be the "reverse" key for the group.
 //
 // Allowed game scenes: any.
-func (s *ServoGroup) MoveRight() error {
+func (s *ServoGroup) ReverseKeyAsync() (*krpcgo.Future[string], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "ServoGroup_MoveRight",
+		Procedure: "ServoGroup_get_ReverseKey",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (string, error) {
+		var vv string
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
 }
 
-// MoveLeft - moves all of the servos in the group to the left.
+// ReverseKeyStream - the key assigned to be the "reverse" key for the group.
 //
 // Allowed game scenes: any.
-func (s *ServoGroup) MoveLeft() error {
+func (s *ServoGroup) ReverseKeyStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[string], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "ServoGroup_MoveLeft",
+		Procedure: "ServoGroup_get_ReverseKey",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	return nil
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (string, error) {
+		var value string
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
 }
 
-// MoveCenter - moves all of the servos in the group to the center.
-//
-// Allowed game scenes: any.
-func (s *ServoGroup) MoveCenter() error {
+// ReverseKeyCall builds a call to ReverseKey without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *ServoGroup) ReverseKeyCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "ServoGroup_MoveCenter",
+		Procedure: "ServoGroup_get_ReverseKey",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	return request, nil
+}
+
+// DecodeReverseKey decodes the result of a call built with ReverseKeyCall.
+func (s *ServoGroup) DecodeReverseKey(result *types.ProcedureResult) (string, error) {
+	var vv string
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	return nil
+	return vv, nil
 }
 
-// MoveNextPreset - moves all of the servos in the group to the next preset.
+// SetReverseKey - the key assigned to be the "reverse" key for the group.
 //
 // Allowed game scenes: any.
-func (s *ServoGroup) MoveNextPreset() error {
+func (s *ServoGroup) SetReverseKey(value string) error {
+	return s.SetReverseKeyContext(context.Background(), value)
+}
+
+// SetReverseKeyContext - the key assigned to be the "reverse" key for the
+// group.
+//
+// Allowed game scenes: any.
+func (s *ServoGroup) SetReverseKeyContext(ctx context.Context, value string) error {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "ServoGroup_MoveNextPreset",
+		Procedure: "ServoGroup_set_ReverseKey",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1882,72 +5323,98 @@ func (s *ServoGroup) MoveNextPreset() error {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
 		return tracerr.Wrap(err)
 	}
 	return nil
 }
 
-// MovePrevPreset - moves all of the servos in the group to the previous preset.
+// SetReverseKeyAsync - the key assigned to be the "reverse" key for the group.
 //
 // Allowed game scenes: any.
-func (s *ServoGroup) MovePrevPreset() error {
+func (s *ServoGroup) SetReverseKeyAsync(value string) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "ServoGroup_MovePrevPreset",
+		Procedure: "ServoGroup_set_ReverseKey",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	return nil
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
 }
 
-// Stop - stops the servos in the group.
-//
-// Allowed game scenes: any.
-func (s *ServoGroup) Stop() error {
+// SetReverseKeyCall builds a call to SetReverseKey without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *ServoGroup) SetReverseKeyCall(value string) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "ServoGroup_Stop",
+		Procedure: "ServoGroup_set_ReverseKey",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	return nil
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
 }
 
-// Name - the name of the group.
+// Speed - the speed multiplier for the group.
 //
 // Allowed game scenes: any.
-func (s *ServoGroup) Name() (string, error) {
+func (s *ServoGroup) Speed() (float32, error) {
+	return s.SpeedContext(context.Background())
+}
+
+// SpeedContext - the speed multiplier for the group.
+//
+// Allowed game scenes: any.
+func (s *ServoGroup) SpeedContext(ctx context.Context) (float32, error) {
 	var err error
 	var argBytes []byte
-	var vv string
+	var vv float32
 	request := &types.ProcedureCall{
-		Procedure: "ServoGroup_get_Name",
+		Procedure: "ServoGroup_get_Speed",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1958,7 +5425,7 @@ func (s *ServoGroup) Name() (string, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -1969,14 +5436,14 @@ func (s *ServoGroup) Name() (string, error) {
 	return vv, nil
 }
 
-// NameStream - the name of the group.
+// SpeedAsync - the speed multiplier for the group.
 //
 // Allowed game scenes: any.
-func (s *ServoGroup) NameStream() (*krpcgo.Stream[string], error) {
+func (s *ServoGroup) SpeedAsync() (*krpcgo.Future[float32], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "ServoGroup_get_Name",
+		Procedure: "ServoGroup_get_Speed",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1987,94 +5454,129 @@ func (s *ServoGroup) NameStream() (*krpcgo.Stream[string], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) string {
-		var value string
-		encode.Unmarshal(b, &value)
-		return value
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (float32, error) {
+		var vv float32
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
 	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
-	})
-	return stream, nil
+	return future, nil
 }
 
-// SetName - the name of the group.
+// SpeedStream - the speed multiplier for the group.
 //
 // Allowed game scenes: any.
-func (s *ServoGroup) SetName(value string) error {
+func (s *ServoGroup) SpeedStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[float32], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "ServoGroup_set_Name",
+		Procedure: "ServoGroup_get_Speed",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (float32, error) {
+		var value float32
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// SpeedCall builds a call to Speed without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *ServoGroup) SpeedCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "ServoGroup_get_Speed",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
+		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	return request, nil
+}
+
+// DecodeSpeed decodes the result of a call built with SpeedCall.
+func (s *ServoGroup) DecodeSpeed(result *types.ProcedureResult) (float32, error) {
+	var vv float32
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	return nil
+	return vv, nil
+}
+
+// SetSpeed - the speed multiplier for the group.
+//
+// Allowed game scenes: any.
+func (s *ServoGroup) SetSpeed(value float32) error {
+	return s.SetSpeedContext(context.Background(), value)
 }
 
-// ForwardKey - the key assigned to be the "forward" key for the group.
+// SetSpeedContext - the speed multiplier for the group.
 //
 // Allowed game scenes: any.
-func (s *ServoGroup) ForwardKey() (string, error) {
+func (s *ServoGroup) SetSpeedContext(ctx context.Context, value float32) error {
 	var err error
 	var argBytes []byte
-	var vv string
 	request := &types.ProcedureCall{
-		Procedure: "ServoGroup_get_ForwardKey",
+		Procedure: "ServoGroup_set_Speed",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	return vv, nil
+	return nil
 }
 
-// ForwardKeyStream - the key assigned to be the "forward" key for the group.
+// SetSpeedAsync - the speed multiplier for the group.
 //
 // Allowed game scenes: any.
-func (s *ServoGroup) ForwardKeyStream() (*krpcgo.Stream[string], error) {
+func (s *ServoGroup) SetSpeedAsync(value float32) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "ServoGroup_get_ForwardKey",
+		Procedure: "ServoGroup_set_Speed",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2085,36 +5587,33 @@ func (s *ServoGroup) ForwardKeyStream() (*krpcgo.Stream[string], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) string {
-		var value string
-		encode.Unmarshal(b, &value)
-		return value
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
 	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetForwardKey - the key assigned to be the "forward" key for the group.
-//
-// Allowed game scenes: any.
-func (s *ServoGroup) SetForwardKey(value string) error {
+// SetSpeedCall builds a call to SetSpeed without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *ServoGroup) SetSpeedCall(value float32) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "ServoGroup_set_ForwardKey",
+		Procedure: "ServoGroup_set_Speed",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
@@ -2122,28 +5621,31 @@ func (s *ServoGroup) SetForwardKey(value string) error {
 	})
 	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return request, nil
 }
 
-// ReverseKey - the key assigned to be the "reverse" key for the group.
+// Expanded - whether the group is expanded in the InfernalRobotics UI.
 //
 // Allowed game scenes: any.
-func (s *ServoGroup) ReverseKey() (string, error) {
+func (s *ServoGroup) Expanded() (bool, error) {
+	return s.ExpandedContext(context.Background())
+}
+
+// ExpandedContext - whether the group is expanded in the InfernalRobotics UI.
+//
+// Allowed game scenes: any.
+func (s *ServoGroup) ExpandedContext(ctx context.Context) (bool, error) {
 	var err error
 	var argBytes []byte
-	var vv string
+	var vv bool
 	request := &types.ProcedureCall{
-		Procedure: "ServoGroup_get_ReverseKey",
+		Procedure: "ServoGroup_get_Expanded",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2154,7 +5656,7 @@ func (s *ServoGroup) ReverseKey() (string, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -2165,14 +5667,14 @@ func (s *ServoGroup) ReverseKey() (string, error) {
 	return vv, nil
 }
 
-// ReverseKeyStream - the key assigned to be the "reverse" key for the group.
+// ExpandedAsync - whether the group is expanded in the InfernalRobotics UI.
 //
 // Allowed game scenes: any.
-func (s *ServoGroup) ReverseKeyStream() (*krpcgo.Stream[string], error) {
+func (s *ServoGroup) ExpandedAsync() (*krpcgo.Future[bool], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "ServoGroup_get_ReverseKey",
+		Procedure: "ServoGroup_get_Expanded",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2183,94 +5685,130 @@ func (s *ServoGroup) ReverseKeyStream() (*krpcgo.Stream[string], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) string {
-		var value string
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (bool, error) {
+		var vv bool
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetReverseKey - the key assigned to be the "reverse" key for the group.
+// ExpandedStream - whether the group is expanded in the InfernalRobotics UI.
 //
 // Allowed game scenes: any.
-func (s *ServoGroup) SetReverseKey(value string) error {
+func (s *ServoGroup) ExpandedStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[bool], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "ServoGroup_set_ReverseKey",
+		Procedure: "ServoGroup_get_Expanded",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (bool, error) {
+		var value bool
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// ExpandedCall builds a call to Expanded without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *ServoGroup) ExpandedCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "ServoGroup_get_Expanded",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
+		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	return request, nil
+}
+
+// DecodeExpanded decodes the result of a call built with ExpandedCall.
+func (s *ServoGroup) DecodeExpanded(result *types.ProcedureResult) (bool, error) {
+	var vv bool
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	return nil
+	return vv, nil
 }
 
-// Speed - the speed multiplier for the group.
+// SetExpanded - whether the group is expanded in the InfernalRobotics UI.
 //
 // Allowed game scenes: any.
-func (s *ServoGroup) Speed() (float32, error) {
+func (s *ServoGroup) SetExpanded(value bool) error {
+	return s.SetExpandedContext(context.Background(), value)
+}
+
+// SetExpandedContext - whether the group is expanded in the InfernalRobotics
+// UI.
+//
+// Allowed game scenes: any.
+func (s *ServoGroup) SetExpandedContext(ctx context.Context, value bool) error {
 	var err error
 	var argBytes []byte
-	var vv float32
 	request := &types.ProcedureCall{
-		Procedure: "ServoGroup_get_Speed",
+		Procedure: "ServoGroup_set_Expanded",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	return vv, nil
+	return nil
 }
 
-// SpeedStream - the speed multiplier for the group.
+// SetExpandedAsync - whether the group is expanded in the InfernalRobotics UI.
 //
 // Allowed game scenes: any.
-func (s *ServoGroup) SpeedStream() (*krpcgo.Stream[float32], error) {
+func (s *ServoGroup) SetExpandedAsync(value bool) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "ServoGroup_get_Speed",
+		Procedure: "ServoGroup_set_Expanded",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2281,36 +5819,33 @@ func (s *ServoGroup) SpeedStream() (*krpcgo.Stream[float32], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) float32 {
-		var value float32
-		encode.Unmarshal(b, &value)
-		return value
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
 	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetSpeed - the speed multiplier for the group.
-//
-// Allowed game scenes: any.
-func (s *ServoGroup) SetSpeed(value float32) error {
+// SetExpandedCall builds a call to SetExpanded without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *ServoGroup) SetExpandedCall(value bool) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "ServoGroup_set_Speed",
+		Procedure: "ServoGroup_set_Expanded",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
@@ -2318,28 +5853,31 @@ func (s *ServoGroup) SetSpeed(value float32) error {
 	})
 	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return request, nil
 }
 
-// Expanded - whether the group is expanded in the InfernalRobotics UI.
+// Servos - the servos that are in the group.
 //
 // Allowed game scenes: any.
-func (s *ServoGroup) Expanded() (bool, error) {
+func (s *ServoGroup) Servos() ([]*Servo, error) {
+	return s.ServosContext(context.Background())
+}
+
+// ServosContext - the servos that are in the group.
+//
+// Allowed game scenes: any.
+func (s *ServoGroup) ServosContext(ctx context.Context) ([]*Servo, error) {
 	var err error
 	var argBytes []byte
-	var vv bool
+	var vv []*Servo
 	request := &types.ProcedureCall{
-		Procedure: "ServoGroup_get_Expanded",
+		Procedure: "ServoGroup_get_Servos",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2350,7 +5888,7 @@ func (s *ServoGroup) Expanded() (bool, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -2361,14 +5899,14 @@ func (s *ServoGroup) Expanded() (bool, error) {
 	return vv, nil
 }
 
-// ExpandedStream - whether the group is expanded in the InfernalRobotics UI.
+// ServosAsync - the servos that are in the group.
 //
 // Allowed game scenes: any.
-func (s *ServoGroup) ExpandedStream() (*krpcgo.Stream[bool], error) {
+func (s *ServoGroup) ServosAsync() (*krpcgo.Future[[]*Servo], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "ServoGroup_get_Expanded",
+		Procedure: "ServoGroup_get_Servos",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2379,65 +5917,97 @@ func (s *ServoGroup) ExpandedStream() (*krpcgo.Stream[bool], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) bool {
-		var value bool
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) ([]*Servo, error) {
+		var vv []*Servo
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetExpanded - whether the group is expanded in the InfernalRobotics UI.
+// ServosStream - the servos that are in the group.
 //
 // Allowed game scenes: any.
-func (s *ServoGroup) SetExpanded(value bool) error {
+func (s *ServoGroup) ServosStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[[]*Servo], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "ServoGroup_set_Expanded",
+		Procedure: "ServoGroup_get_Servos",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) ([]*Servo, error) {
+		var value []*Servo
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// ServosCall builds a call to Servos without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *ServoGroup) ServosCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "ServoGroup_get_Servos",
+		Service:   "InfernalRobotics",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
+		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	return request, nil
+}
+
+// DecodeServos decodes the result of a call built with ServosCall.
+func (s *ServoGroup) DecodeServos(result *types.ProcedureResult) ([]*Servo, error) {
+	var vv []*Servo
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	return nil
+	return vv, nil
 }
 
-// Servos - the servos that are in the group.
+// Parts - the parts containing the servos in the group.
 //
 // Allowed game scenes: any.
-func (s *ServoGroup) Servos() ([]*Servo, error) {
+func (s *ServoGroup) Parts() ([]*spacecenter.Part, error) {
+	return s.PartsContext(context.Background())
+}
+
+// PartsContext - the parts containing the servos in the group.
+//
+// Allowed game scenes: any.
+func (s *ServoGroup) PartsContext(ctx context.Context) ([]*spacecenter.Part, error) {
 	var err error
 	var argBytes []byte
-	var vv []*Servo
+	var vv []*spacecenter.Part
 	request := &types.ProcedureCall{
-		Procedure: "ServoGroup_get_Servos",
+		Procedure: "ServoGroup_get_Parts",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2448,7 +6018,7 @@ func (s *ServoGroup) Servos() ([]*Servo, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -2459,14 +6029,14 @@ func (s *ServoGroup) Servos() ([]*Servo, error) {
 	return vv, nil
 }
 
-// ServosStream - the servos that are in the group.
+// PartsAsync - the parts containing the servos in the group.
 //
 // Allowed game scenes: any.
-func (s *ServoGroup) ServosStream() (*krpcgo.Stream[[]*Servo], error) {
+func (s *ServoGroup) PartsAsync() (*krpcgo.Future[[]*spacecenter.Part], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "ServoGroup_get_Servos",
+		Procedure: "ServoGroup_get_Parts",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2477,57 +6047,51 @@ func (s *ServoGroup) ServosStream() (*krpcgo.Stream[[]*Servo], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) []*Servo {
-		var value []*Servo
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) ([]*spacecenter.Part, error) {
+		var vv []*spacecenter.Part
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// Parts - the parts containing the servos in the group.
+// PartsStream - the parts containing the servos in the group.
 //
 // Allowed game scenes: any.
-func (s *ServoGroup) Parts() ([]*spacecenter.Part, error) {
+func (s *ServoGroup) PartsStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[[]*spacecenter.Part], error) {
 	var err error
 	var argBytes []byte
-	var vv []*spacecenter.Part
 	request := &types.ProcedureCall{
 		Procedure: "ServoGroup_get_Parts",
 		Service:   "InfernalRobotics",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	return vv, nil
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) ([]*spacecenter.Part, error) {
+		var value []*spacecenter.Part
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
 }
 
-// PartsStream - the parts containing the servos in the group.
-//
-// Allowed game scenes: any.
-func (s *ServoGroup) PartsStream() (*krpcgo.Stream[[]*spacecenter.Part], error) {
+// PartsCall builds a call to Parts without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *ServoGroup) PartsCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
@@ -2542,19 +6106,87 @@ func (s *ServoGroup) PartsStream() (*krpcgo.Stream[[]*spacecenter.Part], error)
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	return request, nil
+}
+
+// DecodeParts decodes the result of a call built with PartsCall.
+func (s *ServoGroup) DecodeParts(result *types.ProcedureResult) ([]*spacecenter.Part, error) {
+	var vv []*spacecenter.Part
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return nil, tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) []*spacecenter.Part {
-		var value []*spacecenter.Part
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	return vv, nil
+}
+func init() {
+	krpcgo.RegisterReturnTypes("InfernalRobotics", map[string]*types.Type{
+		"ServoGroupWithName": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "ServoGroup",
+			Service: "InfernalRobotics",
+		},
+		"ServoGroup_ServoWithName": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Servo",
+			Service: "InfernalRobotics",
+		},
+		"ServoGroup_get_Expanded":   &types.Type{Code: types.Type_BOOL},
+		"ServoGroup_get_ForwardKey": &types.Type{Code: types.Type_STRING},
+		"ServoGroup_get_Name":       &types.Type{Code: types.Type_STRING},
+		"ServoGroup_get_Parts": &types.Type{
+			Code: types.Type_LIST,
+			Types: []*types.Type{&types.Type{
+				Code:    types.Type_CLASS,
+				Name:    "Part",
+				Service: "SpaceCenter",
+			}},
+		},
+		"ServoGroup_get_ReverseKey": &types.Type{Code: types.Type_STRING},
+		"ServoGroup_get_Servos": &types.Type{
+			Code: types.Type_LIST,
+			Types: []*types.Type{&types.Type{
+				Code:    types.Type_CLASS,
+				Name:    "Servo",
+				Service: "InfernalRobotics",
+			}},
+		},
+		"ServoGroup_get_Speed": &types.Type{Code: types.Type_FLOAT},
+		"ServoGroups": &types.Type{
+			Code: types.Type_LIST,
+			Types: []*types.Type{&types.Type{
+				Code:    types.Type_CLASS,
+				Name:    "ServoGroup",
+				Service: "InfernalRobotics",
+			}},
+		},
+		"ServoWithName": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Servo",
+			Service: "InfernalRobotics",
+		},
+		"Servo_get_Acceleration":      &types.Type{Code: types.Type_FLOAT},
+		"Servo_get_ConfigSpeed":       &types.Type{Code: types.Type_FLOAT},
+		"Servo_get_CurrentSpeed":      &types.Type{Code: types.Type_FLOAT},
+		"Servo_get_IsAxisInverted":    &types.Type{Code: types.Type_BOOL},
+		"Servo_get_IsFreeMoving":      &types.Type{Code: types.Type_BOOL},
+		"Servo_get_IsLocked":          &types.Type{Code: types.Type_BOOL},
+		"Servo_get_IsMoving":          &types.Type{Code: types.Type_BOOL},
+		"Servo_get_MaxConfigPosition": &types.Type{Code: types.Type_FLOAT},
+		"Servo_get_MaxPosition":       &types.Type{Code: types.Type_FLOAT},
+		"Servo_get_MinConfigPosition": &types.Type{Code: types.Type_FLOAT},
+		"Servo_get_MinPosition":       &types.Type{Code: types.Type_FLOAT},
+		"Servo_get_Name":              &types.Type{Code: types.Type_STRING},
+		"Servo_get_Part": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Part",
+			Service: "SpaceCenter",
+		},
+		"Servo_get_Position": &types.Type{Code: types.Type_FLOAT},
+		"Servo_get_Speed":    &types.Type{Code: types.Type_FLOAT},
+		"get_Available":      &types.Type{Code: types.Type_BOOL},
+		"get_Ready":          &types.Type{Code: types.Type_BOOL},
 	})
-	return stream, nil
 }
@@ -148,23 +148,17 @@ func TestLaunch(t *testing.T) {
 
 	// Fine tune apoapsis approach
 	require.NoError(t, control.SetThrottle(0.25))
-	for apoapsis < targetAltitude {
-		select {
-		case apoapsis = <-apoapsisStream.C:
-		case <-ctx.Done():
-			return
-		}
-	}
+	_, err = apoapsisStream.WaitUntil(ctx, func(apoapsis float64) bool {
+		return apoapsis >= targetAltitude
+	})
+	require.NoError(t, err)
 	require.NoError(t, control.SetThrottle(0))
 
 	// Coast out of the atmosphere
-	for apoapsis < 70500 {
-		select {
-		case apoapsis = <-apoapsisStream.C:
-		case <-ctx.Done():
-			return
-		}
-	}
+	_, err = apoapsisStream.WaitUntil(ctx, func(apoapsis float64) bool {
+		return apoapsis >= 70500
+	})
+	require.NoError(t, err)
 
 	// Plan circularization
 	body, err := orbit.Body()
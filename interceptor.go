@@ -0,0 +1,152 @@
+package krpcgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/atburke/krpc-go/types"
+	"github.com/ztrue/tracerr"
+)
+
+// Invoker sends a batch of procedure calls to the server.
+type Invoker func(ctx context.Context, calls []*types.ProcedureCall) ([]*types.ProcedureResult, error)
+
+// Interceptor intercepts every batch of procedure calls made with
+// CallMultipleContext, and so every call made through the client. It calls
+// invoker to continue with the next interceptor and eventually send the
+// calls, and may inspect or change the calls and results on the way, or skip
+// invoker to veto the calls.
+type Interceptor func(ctx context.Context, calls []*types.ProcedureCall, invoker Invoker) ([]*types.ProcedureResult, error)
+
+// chainInterceptors wraps an invoker in interceptors. The first interceptor
+// is the outermost one.
+func chainInterceptors(interceptors []Interceptor, invoker Invoker) Invoker {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], invoker
+		invoker = func(ctx context.Context, calls []*types.ProcedureCall) ([]*types.ProcedureResult, error) {
+			return interceptor(ctx, calls, next)
+		}
+	}
+	return invoker
+}
+
+// procedureName gets the full name of the procedure called, e.g.
+// "SpaceCenter.Quickload".
+func procedureName(call *types.ProcedureCall) string {
+	return call.Service + "." + call.Procedure
+}
+
+// RetryPolicy controls how calls are retried by RetryInterceptor.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first
+	// one. Defaults to 3.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry. Defaults to 100ms.
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum delay between attempts. The delay doubles
+	// after each failed attempt until it reaches MaxBackoff. Defaults to 5s.
+	MaxBackoff time.Duration
+}
+
+// SetDefaults sets the policy defaults.
+func (p *RetryPolicy) SetDefaults() {
+	if p.MaxAttempts == 0 {
+		p.MaxAttempts = 3
+	}
+	if p.InitialBackoff == 0 {
+		p.InitialBackoff = 100 * time.Millisecond
+	}
+	if p.MaxBackoff == 0 {
+		p.MaxBackoff = 5 * time.Second
+	}
+	if p.MaxBackoff < p.InitialBackoff {
+		p.MaxBackoff = p.InitialBackoff
+	}
+}
+
+// RetryInterceptor retries calls that fail because of a transport error,
+// such as a lost connection, rather than an error from the server. Calls may
+// have been carried out before the connection was lost, so only use it for
+// calls that are safe to repeat. It's most useful together with
+// KRPCClientConfig.Reconnect, which reconnects before the call is retried.
+func RetryInterceptor(policy RetryPolicy) Interceptor {
+	policy.SetDefaults()
+	return func(ctx context.Context, calls []*types.ProcedureCall, invoker Invoker) ([]*types.ProcedureResult, error) {
+		backoff := policy.InitialBackoff
+		for attempt := 1; ; attempt++ {
+			results, err := invoker(ctx, calls)
+			if err == nil || attempt >= policy.MaxAttempts || !isTransportError(ctx, err) {
+				return results, tracerr.Wrap(err)
+			}
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+				return nil, tracerr.Wrap(ctx.Err())
+			}
+			backoff *= 2
+			if backoff > policy.MaxBackoff {
+				backoff = policy.MaxBackoff
+			}
+		}
+	}
+}
+
+// isTransportError checks if a call failed because of a transport error
+// rather than an error from the server, an interceptor vetoing the call or
+// the end of ctx.
+func isTransportError(ctx context.Context, err error) bool {
+	var serverErr *types.Error
+	var deniedErr *DeniedError
+	return ctx.Err() == nil && !errors.As(err, &serverErr) && !errors.As(err, &deniedErr)
+}
+
+// DeniedError is returned when an interceptor vetoes a procedure call.
+type DeniedError struct {
+	// Procedure is the full name of the procedure, e.g.
+	// "SpaceCenter.Quickload".
+	Procedure string
+}
+
+// Error returns a human-readable error.
+func (e *DeniedError) Error() string {
+	return fmt.Sprintf("Calls to %v are not allowed", e.Procedure)
+}
+
+// AllowProcedures only allows calls to the given procedures, and returns a
+// *DeniedError for any others. Procedures are given by their full name,
+// e.g. "SpaceCenter.get_ActiveVessel", or as e.g. "SpaceCenter.*" to match
+// every procedure of a service. If any call in a batch is denied, none of
+// them are sent. Streams are added and removed with calls to KRPC.AddStream
+// and KRPC.RemoveStream, which have to be allowed to use streams.
+func AllowProcedures(procedures ...string) Interceptor {
+	return filterProcedures(procedures, true)
+}
+
+// DenyProcedures returns a *DeniedError for calls to the given procedures,
+// e.g. "SpaceCenter.Quickload". See AllowProcedures for how procedures are
+// matched.
+func DenyProcedures(procedures ...string) Interceptor {
+	return filterProcedures(procedures, false)
+}
+
+// filterProcedures vetoes calls depending on whether they match the given
+// procedures.
+func filterProcedures(procedures []string, allow bool) Interceptor {
+	names := make(map[string]bool)
+	for _, name := range procedures {
+		names[name] = true
+	}
+	return func(ctx context.Context, calls []*types.ProcedureCall, invoker Invoker) ([]*types.ProcedureResult, error) {
+		for _, call := range calls {
+			name := procedureName(call)
+			matches := names[name] || names[call.Service+".*"]
+			if matches != allow {
+				return nil, tracerr.Wrap(&DeniedError{Procedure: name})
+			}
+		}
+		results, err := invoker(ctx, calls)
+		return results, tracerr.Wrap(err)
+	}
+}
@@ -0,0 +1,122 @@
+package krpcgo
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/atburke/krpc-go/krpctest"
+	"github.com/atburke/krpc-go/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInterceptors(t *testing.T) {
+	server := krpctest.NewServer(t)
+	server.HandleDefault(func(call *types.ProcedureCall) ([]byte, error) {
+		return []byte(call.Procedure), nil
+	})
+	var order []string
+	record := func(name string) Interceptor {
+		return func(ctx context.Context, calls []*types.ProcedureCall, invoker Invoker) ([]*types.ProcedureResult, error) {
+			order = append(order, name)
+			return invoker(ctx, calls)
+		}
+	}
+	rename := func(ctx context.Context, calls []*types.ProcedureCall, invoker Invoker) ([]*types.ProcedureResult, error) {
+		calls[0].Procedure = "Renamed"
+		return invoker(ctx, calls)
+	}
+	client := connect(t, server, KRPCClientConfig{
+		Interceptors: []Interceptor{record("outer"), record("inner"), rename},
+	})
+
+	result, err := client.Call(&types.ProcedureCall{Service: "Test", Procedure: "Echo"})
+	require.NoError(t, err)
+	require.Equal(t, "Renamed", string(result.Value))
+	require.Equal(t, []string{"outer", "inner"}, order)
+}
+
+func TestDenyProcedures(t *testing.T) {
+	server := krpctest.NewServer(t)
+	server.HandleDefault(func(call *types.ProcedureCall) ([]byte, error) {
+		return nil, nil
+	})
+	client := connect(t, server, KRPCClientConfig{
+		Interceptors: []Interceptor{DenyProcedures("SpaceCenter.Quickload")},
+	})
+
+	_, err := client.Call(&types.ProcedureCall{Service: "SpaceCenter", Procedure: "Quickload"})
+	var deniedErr *DeniedError
+	require.ErrorAs(t, err, &deniedErr)
+	require.Equal(t, "SpaceCenter.Quickload", deniedErr.Procedure)
+	server.RequireNotCalled(t, "SpaceCenter", "Quickload")
+
+	_, err = client.Call(&types.ProcedureCall{Service: "SpaceCenter", Procedure: "Quicksave"})
+	require.NoError(t, err)
+}
+
+func TestAllowProcedures(t *testing.T) {
+	server := krpctest.NewServer(t)
+	server.HandleDefault(func(call *types.ProcedureCall) ([]byte, error) {
+		return nil, nil
+	})
+	client := connect(t, server, KRPCClientConfig{
+		Interceptors: []Interceptor{AllowProcedures("SpaceCenter.*", "KRPC.GetStatus")},
+	})
+
+	_, err := client.Call(&types.ProcedureCall{Service: "SpaceCenter", Procedure: "Quicksave"})
+	require.NoError(t, err)
+	_, err = client.Call(&types.ProcedureCall{Service: "KRPC", Procedure: "GetStatus"})
+	require.NoError(t, err)
+
+	// A batch is only sent if every call is allowed.
+	_, err = client.CallMultiple([]*types.ProcedureCall{
+		{Service: "SpaceCenter", Procedure: "Quicksave"},
+		{Service: "KRPC", Procedure: "GetClients"},
+	})
+	var deniedErr *DeniedError
+	require.ErrorAs(t, err, &deniedErr)
+	require.Equal(t, "KRPC.GetClients", deniedErr.Procedure)
+	require.Len(t, server.CallsTo("SpaceCenter", "Quicksave"), 1)
+}
+
+func TestRetryInterceptor(t *testing.T) {
+	retry := RetryInterceptor(RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond})
+	calls := []*types.ProcedureCall{{Service: "Test", Procedure: "Echo"}}
+
+	t.Run("transport errors", func(t *testing.T) {
+		attempts := 0
+		results, err := retry(context.Background(), calls, func(ctx context.Context, calls []*types.ProcedureCall) ([]*types.ProcedureResult, error) {
+			attempts++
+			if attempts < 3 {
+				return nil, io.EOF
+			}
+			return []*types.ProcedureResult{{}}, nil
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.Equal(t, 3, attempts)
+	})
+
+	t.Run("gives up", func(t *testing.T) {
+		attempts := 0
+		_, err := retry(context.Background(), calls, func(ctx context.Context, calls []*types.ProcedureCall) ([]*types.ProcedureResult, error) {
+			attempts++
+			return nil, io.EOF
+		})
+		require.True(t, errors.Is(err, io.EOF))
+		require.Equal(t, 3, attempts)
+	})
+
+	t.Run("server errors", func(t *testing.T) {
+		attempts := 0
+		_, err := retry(context.Background(), calls, func(ctx context.Context, calls []*types.ProcedureCall) ([]*types.ProcedureResult, error) {
+			attempts++
+			return nil, &types.Error{Description: "Invalid request"}
+		})
+		require.Error(t, err)
+		require.Equal(t, 1, attempts)
+	})
+}
@@ -10,10 +10,10 @@ import (
 // BasicKRPC is a partial implementation of the KRPC service. This should only
 // be used to fetch the rest of the services.
 type BasicKRPC struct {
-	client *krpcgo.KRPCClient
+	client krpcgo.Caller
 }
 
-func NewBasicKRPC(client *krpcgo.KRPCClient) *BasicKRPC {
+func NewBasicKRPC(client krpcgo.Caller) *BasicKRPC {
 	return &BasicKRPC{client: client}
 }
 
@@ -0,0 +1,256 @@
+// Package websocket implements the parts of the WebSocket protocol (RFC 6455)
+// needed to talk to a kRPC server: the opening handshake and binary messages.
+package websocket
+
+import (
+	"bufio"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ztrue/tracerr"
+)
+
+// MaxMessageSize is the largest message that will be read.
+const MaxMessageSize = 64 * 1024 * 1024
+
+// acceptGUID is appended to the handshake key to compute the accept key.
+const acceptGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+const (
+	opContinuation = 0x0
+	opText         = 0x1
+	opBinary       = 0x2
+	opClose        = 0x8
+	opPing         = 0x9
+	opPong         = 0xa
+)
+
+// Conn is a WebSocket connection that sends and receives binary messages.
+type Conn struct {
+	conn net.Conn
+	r    *bufio.Reader
+	// client is true for the client end of the connection, which must mask
+	// the frames it sends.
+	client bool
+	wmu    sync.Mutex
+}
+
+// acceptKey computes the Sec-WebSocket-Accept value for a key.
+func acceptKey(key string) string {
+	h := sha1.New()
+	h.Write([]byte(key + acceptGUID))
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+// Client performs the opening handshake for u over an open connection.
+func Client(conn net.Conn, u *url.URL) (*Conn, error) {
+	rawKey := make([]byte, 16)
+	if _, err := rand.Read(rawKey); err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	key := base64.StdEncoding.EncodeToString(rawKey)
+
+	httpURL := *u
+	httpURL.Scheme = strings.Replace(httpURL.Scheme, "ws", "http", 1)
+	req, err := http.NewRequest(http.MethodGet, httpURL.String(), nil)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Sec-WebSocket-Key", key)
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	if err := req.Write(conn); err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+
+	r := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(r, req)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		return nil, tracerr.Errorf("WebSocket handshake failed: %v", resp.Status)
+	}
+	if !strings.EqualFold(resp.Header.Get("Upgrade"), "websocket") ||
+		resp.Header.Get("Sec-WebSocket-Accept") != acceptKey(key) {
+		return nil, tracerr.Errorf("Invalid WebSocket handshake response")
+	}
+	return &Conn{conn: conn, r: r, client: true}, nil
+}
+
+// Accept performs the opening handshake as a server over an open
+// connection. If check returns an error, the handshake is rejected with its
+// message.
+func Accept(conn net.Conn, check func(*http.Request) error) (*Conn, error) {
+	r := bufio.NewReader(conn)
+	req, err := http.ReadRequest(r)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	key := req.Header.Get("Sec-WebSocket-Key")
+	if !strings.EqualFold(req.Header.Get("Upgrade"), "websocket") || key == "" {
+		err = tracerr.Errorf("Not a WebSocket handshake")
+	} else if check != nil {
+		err = check(req)
+	}
+	if err != nil {
+		resp := &http.Response{
+			StatusCode:    http.StatusBadRequest,
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Body:          io.NopCloser(strings.NewReader(err.Error())),
+			ContentLength: int64(len(err.Error())),
+		}
+		resp.Write(conn)
+		return nil, tracerr.Wrap(err)
+	}
+
+	if _, err := io.WriteString(conn, "HTTP/1.1 101 Switching Protocols\r\n"+
+		"Upgrade: websocket\r\n"+
+		"Connection: Upgrade\r\n"+
+		"Sec-WebSocket-Accept: "+acceptKey(key)+"\r\n\r\n"); err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	return &Conn{conn: conn, r: r}, nil
+}
+
+// ReadMessage reads the next data message. Pings are answered while waiting
+// for it. io.EOF is returned once the other end closes the connection.
+func (c *Conn) ReadMessage() ([]byte, error) {
+	var msg []byte
+	for {
+		fin, opcode, payload, err := c.readFrame()
+		if err != nil {
+			return nil, tracerr.Wrap(err)
+		}
+		switch opcode {
+		case opPing:
+			if err := c.writeFrame(opPong, payload); err != nil {
+				return nil, tracerr.Wrap(err)
+			}
+		case opPong:
+		case opClose:
+			c.writeFrame(opClose, nil)
+			return nil, io.EOF
+		case opText, opBinary, opContinuation:
+			if len(msg)+len(payload) > MaxMessageSize {
+				return nil, tracerr.Errorf("Message is too large")
+			}
+			msg = append(msg, payload...)
+			if fin {
+				return msg, nil
+			}
+		default:
+			return nil, tracerr.Errorf("Unknown WebSocket opcode %v", opcode)
+		}
+	}
+}
+
+// readFrame reads a single frame.
+func (c *Conn) readFrame() (fin bool, opcode byte, payload []byte, err error) {
+	var header [2]byte
+	if _, err := io.ReadFull(c.r, header[:]); err != nil {
+		return false, 0, nil, tracerr.Wrap(err)
+	}
+	fin = header[0]&0x80 != 0
+	opcode = header[0] & 0x0f
+	masked := header[1]&0x80 != 0
+
+	length := uint64(header[1] & 0x7f)
+	switch length {
+	case 126:
+		var ext [2]byte
+		if _, err := io.ReadFull(c.r, ext[:]); err != nil {
+			return false, 0, nil, tracerr.Wrap(err)
+		}
+		length = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err := io.ReadFull(c.r, ext[:]); err != nil {
+			return false, 0, nil, tracerr.Wrap(err)
+		}
+		length = binary.BigEndian.Uint64(ext[:])
+	}
+	if length > MaxMessageSize {
+		return false, 0, nil, tracerr.Errorf("Frame is too large")
+	}
+
+	var mask [4]byte
+	if masked {
+		if _, err := io.ReadFull(c.r, mask[:]); err != nil {
+			return false, 0, nil, tracerr.Wrap(err)
+		}
+	}
+	payload = make([]byte, length)
+	if _, err := io.ReadFull(c.r, payload); err != nil {
+		return false, 0, nil, tracerr.Wrap(err)
+	}
+	if masked {
+		for i := range payload {
+			payload[i] ^= mask[i%4]
+		}
+	}
+	return fin, opcode, payload, nil
+}
+
+// WriteMessage sends a binary message.
+func (c *Conn) WriteMessage(data []byte) error {
+	return tracerr.Wrap(c.writeFrame(opBinary, data))
+}
+
+// writeFrame sends a single, final frame.
+func (c *Conn) writeFrame(opcode byte, payload []byte) error {
+	frame := []byte{0x80 | opcode, 0}
+	switch {
+	case len(payload) < 126:
+		frame[1] = byte(len(payload))
+	case len(payload) <= 0xffff:
+		frame[1] = 126
+		frame = binary.BigEndian.AppendUint16(frame, uint16(len(payload)))
+	default:
+		frame[1] = 127
+		frame = binary.BigEndian.AppendUint64(frame, uint64(len(payload)))
+	}
+
+	if c.client {
+		frame[1] |= 0x80
+		var mask [4]byte
+		if _, err := rand.Read(mask[:]); err != nil {
+			return tracerr.Wrap(err)
+		}
+		frame = append(frame, mask[:]...)
+		start := len(frame)
+		frame = append(frame, payload...)
+		for i := range payload {
+			frame[start+i] ^= mask[i%4]
+		}
+	} else {
+		frame = append(frame, payload...)
+	}
+
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	_, err := c.conn.Write(frame)
+	return tracerr.Wrap(err)
+}
+
+// SetDeadline sets the read and write deadlines of the connection.
+func (c *Conn) SetDeadline(t time.Time) error {
+	return tracerr.Wrap(c.conn.SetDeadline(t))
+}
+
+// Close closes the connection.
+func (c *Conn) Close() error {
+	return tracerr.Wrap(c.conn.Close())
+}
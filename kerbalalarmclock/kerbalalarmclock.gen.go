@@ -7,8 +7,8 @@
 package kerbalalarmclock
 
 import (
+	"context"
 	krpcgo "github.com/atburke/krpc-go"
-	krpc "github.com/atburke/krpc-go/krpc"
 	encode "github.com/atburke/krpc-go/lib/encode"
 	service "github.com/atburke/krpc-go/lib/service"
 	spacecenter "github.com/atburke/krpc-go/spacecenter"
@@ -109,7 +109,7 @@ type Alarm struct {
 }
 
 // NewAlarm creates a new Alarm.
-func NewAlarm(id uint64, client *krpcgo.KRPCClient) *Alarm {
+func NewAlarm(id uint64, client krpcgo.Caller) *Alarm {
 	c := &Alarm{BaseClass: service.BaseClass{Client: client}}
 	c.SetID(id)
 	return c
@@ -119,11 +119,11 @@ func NewAlarm(id uint64, client *krpcgo.KRPCClient) *Alarm {
 // href="https://forum.kerbalspaceprogram.com/index.php?/topic/22809-13x-kerbal-alarm-clock-v3850-may-30/">Kerbal
 // Alarm Clock</a>.
 type KerbalAlarmClock struct {
-	Client *krpcgo.KRPCClient
+	Client krpcgo.Caller
 }
 
 // New creates a new KerbalAlarmClock.
-func New(client *krpcgo.KRPCClient) *KerbalAlarmClock {
+func New(client krpcgo.Caller) *KerbalAlarmClock {
 	return &KerbalAlarmClock{Client: client}
 }
 
@@ -133,6 +133,15 @@ func New(client *krpcgo.KRPCClient) *KerbalAlarmClock {
 //
 // Allowed game scenes: any.
 func (s *KerbalAlarmClock) AlarmWithName(name string) (*Alarm, error) {
+	return s.AlarmWithNameContext(context.Background(), name)
+}
+
+// AlarmWithNameContext - get the alarm with the given <paramref name="name" />,
+// or nil if no alarms have that name. If more than one alarm has the name, only
+// returns one of them.
+//
+// Allowed game scenes: any.
+func (s *KerbalAlarmClock) AlarmWithNameContext(ctx context.Context, name string) (*Alarm, error) {
 	var err error
 	var argBytes []byte
 	var vv Alarm
@@ -148,7 +157,7 @@ func (s *KerbalAlarmClock) AlarmWithName(name string) (*Alarm, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -160,11 +169,87 @@ func (s *KerbalAlarmClock) AlarmWithName(name string) (*Alarm, error) {
 	return &vv, nil
 }
 
+// AlarmWithNameAsync - get the alarm with the given <paramref name="name" />,
+// or nil if no alarms have that name. If more than one alarm has the name, only
+// returns one of them.
+//
+// Allowed game scenes: any.
+func (s *KerbalAlarmClock) AlarmWithNameAsync(name string) (*krpcgo.Future[*Alarm], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "AlarmWithName",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(name)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Alarm, error) {
+		var vv Alarm
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// AlarmWithNameCall builds a call to AlarmWithName without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *KerbalAlarmClock) AlarmWithNameCall(name string) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "AlarmWithName",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(name)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeAlarmWithName decodes the result of a call built with
+// AlarmWithNameCall.
+func (s *KerbalAlarmClock) DecodeAlarmWithName(result *types.ProcedureResult) (*Alarm, error) {
+	var vv Alarm
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
 // AlarmsWithType - get a list of alarms of the specified <paramref name="type"
 // />.
 //
 // Allowed game scenes: any.
 func (s *KerbalAlarmClock) AlarmsWithType(t AlarmType) ([]*Alarm, error) {
+	return s.AlarmsWithTypeContext(context.Background(), t)
+}
+
+// AlarmsWithTypeContext - get a list of alarms of the specified <paramref
+// name="type" />.
+//
+// Allowed game scenes: any.
+func (s *KerbalAlarmClock) AlarmsWithTypeContext(ctx context.Context, t AlarmType) ([]*Alarm, error) {
 	var err error
 	var argBytes []byte
 	var vv []*Alarm
@@ -180,7 +265,7 @@ func (s *KerbalAlarmClock) AlarmsWithType(t AlarmType) ([]*Alarm, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -191,11 +276,41 @@ func (s *KerbalAlarmClock) AlarmsWithType(t AlarmType) ([]*Alarm, error) {
 	return vv, nil
 }
 
+// AlarmsWithTypeAsync - get a list of alarms of the specified <paramref
+// name="type" />.
+//
+// Allowed game scenes: any.
+func (s *KerbalAlarmClock) AlarmsWithTypeAsync(t AlarmType) (*krpcgo.Future[[]*Alarm], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "AlarmsWithType",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(t)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) ([]*Alarm, error) {
+		var vv []*Alarm
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
 // AlarmsWithTypeStream - get a list of alarms of the specified <paramref
 // name="type" />.
 //
 // Allowed game scenes: any.
-func (s *KerbalAlarmClock) AlarmsWithTypeStream(t AlarmType) (*krpcgo.Stream[[]*Alarm], error) {
+func (s *KerbalAlarmClock) AlarmsWithTypeStream(t AlarmType, opts ...krpcgo.StreamOption) (*krpcgo.Stream[[]*Alarm], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
@@ -210,27 +325,64 @@ func (s *KerbalAlarmClock) AlarmsWithTypeStream(t AlarmType) (*krpcgo.Stream[[]*
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) []*Alarm {
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) ([]*Alarm, error) {
 		var value []*Alarm
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
 	return stream, nil
 }
 
+// AlarmsWithTypeCall builds a call to AlarmsWithType without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *KerbalAlarmClock) AlarmsWithTypeCall(t AlarmType) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "AlarmsWithType",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(t)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeAlarmsWithType decodes the result of a call built with
+// AlarmsWithTypeCall.
+func (s *KerbalAlarmClock) DecodeAlarmsWithType(result *types.ProcedureResult) ([]*Alarm, error) {
+	var vv []*Alarm
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
 // CreateAlarm - create a new alarm and return it.
 //
 // Allowed game scenes: any.
 func (s *KerbalAlarmClock) CreateAlarm(t AlarmType, name string, ut float64) (*Alarm, error) {
+	return s.CreateAlarmContext(context.Background(), t, name, ut)
+}
+
+// CreateAlarmContext - create a new alarm and return it.
+//
+// Allowed game scenes: any.
+func (s *KerbalAlarmClock) CreateAlarmContext(ctx context.Context, t AlarmType, name string, ut float64) (*Alarm, error) {
 	var err error
 	var argBytes []byte
 	var vv Alarm
@@ -262,7 +414,7 @@ func (s *KerbalAlarmClock) CreateAlarm(t AlarmType, name string, ut float64) (*A
 		Position: uint32(0x2),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -274,17 +426,121 @@ func (s *KerbalAlarmClock) CreateAlarm(t AlarmType, name string, ut float64) (*A
 	return &vv, nil
 }
 
+// CreateAlarmAsync - create a new alarm and return it.
+//
+// Allowed game scenes: any.
+func (s *KerbalAlarmClock) CreateAlarmAsync(t AlarmType, name string, ut float64) (*krpcgo.Future[*Alarm], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "CreateAlarm",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(t)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(name)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(ut)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x2),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Alarm, error) {
+		var vv Alarm
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// CreateAlarmCall builds a call to CreateAlarm without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *KerbalAlarmClock) CreateAlarmCall(t AlarmType, name string, ut float64) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "CreateAlarm",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(t)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(name)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(ut)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x2),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeCreateAlarm decodes the result of a call built with CreateAlarmCall.
+func (s *KerbalAlarmClock) DecodeCreateAlarm(result *types.ProcedureResult) (*Alarm, error) {
+	var vv Alarm
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
 // Available - whether Kerbal Alarm Clock is available.
 //
 // Allowed game scenes: any.
 func (s *KerbalAlarmClock) Available() (bool, error) {
+	return s.AvailableContext(context.Background())
+}
+
+// AvailableContext - whether Kerbal Alarm Clock is available.
+//
+// Allowed game scenes: any.
+func (s *KerbalAlarmClock) AvailableContext(ctx context.Context) (bool, error) {
 	var err error
 	var vv bool
 	request := &types.ProcedureCall{
 		Procedure: "get_Available",
 		Service:   "KerbalAlarmClock",
 	}
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -295,43 +551,88 @@ func (s *KerbalAlarmClock) Available() (bool, error) {
 	return vv, nil
 }
 
+// AvailableAsync - whether Kerbal Alarm Clock is available.
+//
+// Allowed game scenes: any.
+func (s *KerbalAlarmClock) AvailableAsync() (*krpcgo.Future[bool], error) {
+	request := &types.ProcedureCall{
+		Procedure: "get_Available",
+		Service:   "KerbalAlarmClock",
+	}
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (bool, error) {
+		var vv bool
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
 // AvailableStream - whether Kerbal Alarm Clock is available.
 //
 // Allowed game scenes: any.
-func (s *KerbalAlarmClock) AvailableStream() (*krpcgo.Stream[bool], error) {
+func (s *KerbalAlarmClock) AvailableStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[bool], error) {
 	var err error
 	request := &types.ProcedureCall{
 		Procedure: "get_Available",
 		Service:   "KerbalAlarmClock",
 	}
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) bool {
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (bool, error) {
 		var value bool
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
 	return stream, nil
 }
 
+// AvailableCall builds a call to Available without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *KerbalAlarmClock) AvailableCall() (*types.ProcedureCall, error) {
+	request := &types.ProcedureCall{
+		Procedure: "get_Available",
+		Service:   "KerbalAlarmClock",
+	}
+	return request, nil
+}
+
+// DecodeAvailable decodes the result of a call built with AvailableCall.
+func (s *KerbalAlarmClock) DecodeAvailable(result *types.ProcedureResult) (bool, error) {
+	var vv bool
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
 // Alarms - a list of all the alarms.
 //
 // Allowed game scenes: any.
 func (s *KerbalAlarmClock) Alarms() ([]*Alarm, error) {
+	return s.AlarmsContext(context.Background())
+}
+
+// AlarmsContext - a list of all the alarms.
+//
+// Allowed game scenes: any.
+func (s *KerbalAlarmClock) AlarmsContext(ctx context.Context) ([]*Alarm, error) {
 	var err error
 	var vv []*Alarm
 	request := &types.ProcedureCall{
 		Procedure: "get_Alarms",
 		Service:   "KerbalAlarmClock",
 	}
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -342,36 +643,81 @@ func (s *KerbalAlarmClock) Alarms() ([]*Alarm, error) {
 	return vv, nil
 }
 
+// AlarmsAsync - a list of all the alarms.
+//
+// Allowed game scenes: any.
+func (s *KerbalAlarmClock) AlarmsAsync() (*krpcgo.Future[[]*Alarm], error) {
+	request := &types.ProcedureCall{
+		Procedure: "get_Alarms",
+		Service:   "KerbalAlarmClock",
+	}
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) ([]*Alarm, error) {
+		var vv []*Alarm
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
 // AlarmsStream - a list of all the alarms.
 //
 // Allowed game scenes: any.
-func (s *KerbalAlarmClock) AlarmsStream() (*krpcgo.Stream[[]*Alarm], error) {
+func (s *KerbalAlarmClock) AlarmsStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[[]*Alarm], error) {
 	var err error
 	request := &types.ProcedureCall{
 		Procedure: "get_Alarms",
 		Service:   "KerbalAlarmClock",
 	}
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) []*Alarm {
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) ([]*Alarm, error) {
 		var value []*Alarm
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
 	return stream, nil
 }
 
+// AlarmsCall builds a call to Alarms without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *KerbalAlarmClock) AlarmsCall() (*types.ProcedureCall, error) {
+	request := &types.ProcedureCall{
+		Procedure: "get_Alarms",
+		Service:   "KerbalAlarmClock",
+	}
+	return request, nil
+}
+
+// DecodeAlarms decodes the result of a call built with AlarmsCall.
+func (s *KerbalAlarmClock) DecodeAlarms(result *types.ProcedureResult) ([]*Alarm, error) {
+	var vv []*Alarm
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
 // Remove - removes the alarm.
 //
 // Allowed game scenes: any.
 func (s *Alarm) Remove() error {
+	return s.RemoveContext(context.Background())
+}
+
+// RemoveContext - removes the alarm.
+//
+// Allowed game scenes: any.
+func (s *Alarm) RemoveContext(ctx context.Context) error {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
@@ -386,47 +732,128 @@ func (s *Alarm) Remove() error {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
 		return tracerr.Wrap(err)
 	}
 	return nil
 }
 
-// Action - the action that the alarm triggers.
+// RemoveAsync - removes the alarm.
 //
 // Allowed game scenes: any.
-func (s *Alarm) Action() (AlarmAction, error) {
+func (s *Alarm) RemoveAsync() (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
-	var vv AlarmAction
 	request := &types.ProcedureCall{
-		Procedure: "Alarm_get_Action",
+		Procedure: "Alarm_Remove",
 		Service:   "KerbalAlarmClock",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// RemoveCall builds a call to Remove without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Alarm) RemoveCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_Remove",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// Action - the action that the alarm triggers.
+//
+// Allowed game scenes: any.
+func (s *Alarm) Action() (AlarmAction, error) {
+	return s.ActionContext(context.Background())
+}
+
+// ActionContext - the action that the alarm triggers.
+//
+// Allowed game scenes: any.
+func (s *Alarm) ActionContext(ctx context.Context) (AlarmAction, error) {
+	var err error
+	var argBytes []byte
+	var vv AlarmAction
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_get_Action",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
 	return vv, nil
 }
 
+// ActionAsync - the action that the alarm triggers.
+//
+// Allowed game scenes: any.
+func (s *Alarm) ActionAsync() (*krpcgo.Future[AlarmAction], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_get_Action",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (AlarmAction, error) {
+		var vv AlarmAction
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
 // ActionStream - the action that the alarm triggers.
 //
 // Allowed game scenes: any.
-func (s *Alarm) ActionStream() (*krpcgo.Stream[AlarmAction], error) {
+func (s *Alarm) ActionStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[AlarmAction], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
@@ -441,95 +868,1545 @@ func (s *Alarm) ActionStream() (*krpcgo.Stream[AlarmAction], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) AlarmAction {
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (AlarmAction, error) {
 		var value AlarmAction
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
 	return stream, nil
 }
 
+// ActionCall builds a call to Action without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Alarm) ActionCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_get_Action",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeAction decodes the result of a call built with ActionCall.
+func (s *Alarm) DecodeAction(result *types.ProcedureResult) (AlarmAction, error) {
+	var vv AlarmAction
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
 // SetAction - the action that the alarm triggers.
 //
 // Allowed game scenes: any.
 func (s *Alarm) SetAction(value AlarmAction) error {
+	return s.SetActionContext(context.Background(), value)
+}
+
+// SetActionContext - the action that the alarm triggers.
+//
+// Allowed game scenes: any.
+func (s *Alarm) SetActionContext(ctx context.Context, value AlarmAction) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_set_Action",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetActionAsync - the action that the alarm triggers.
+//
+// Allowed game scenes: any.
+func (s *Alarm) SetActionAsync(value AlarmAction) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_set_Action",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetActionCall builds a call to SetAction without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Alarm) SetActionCall(value AlarmAction) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_set_Action",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// Margin - the number of seconds before the event that the alarm will fire.
+//
+// Allowed game scenes: any.
+func (s *Alarm) Margin() (float64, error) {
+	return s.MarginContext(context.Background())
+}
+
+// MarginContext - the number of seconds before the event that the alarm will
+// fire.
+//
+// Allowed game scenes: any.
+func (s *Alarm) MarginContext(ctx context.Context) (float64, error) {
+	var err error
+	var argBytes []byte
+	var vv float64
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_get_Margin",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// MarginAsync - the number of seconds before the event that the alarm will
+// fire.
+//
+// Allowed game scenes: any.
+func (s *Alarm) MarginAsync() (*krpcgo.Future[float64], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_get_Margin",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (float64, error) {
+		var vv float64
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// MarginStream - the number of seconds before the event that the alarm will
+// fire.
+//
+// Allowed game scenes: any.
+func (s *Alarm) MarginStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[float64], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_get_Margin",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (float64, error) {
+		var value float64
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// MarginCall builds a call to Margin without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Alarm) MarginCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Alarm_set_Action",
+		Procedure: "Alarm_get_Margin",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeMargin decodes the result of a call built with MarginCall.
+func (s *Alarm) DecodeMargin(result *types.ProcedureResult) (float64, error) {
+	var vv float64
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetMargin - the number of seconds before the event that the alarm will fire.
+//
+// Allowed game scenes: any.
+func (s *Alarm) SetMargin(value float64) error {
+	return s.SetMarginContext(context.Background(), value)
+}
+
+// SetMarginContext - the number of seconds before the event that the alarm will
+// fire.
+//
+// Allowed game scenes: any.
+func (s *Alarm) SetMarginContext(ctx context.Context, value float64) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_set_Margin",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetMarginAsync - the number of seconds before the event that the alarm will
+// fire.
+//
+// Allowed game scenes: any.
+func (s *Alarm) SetMarginAsync(value float64) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_set_Margin",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetMarginCall builds a call to SetMargin without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Alarm) SetMarginCall(value float64) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_set_Margin",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// Time - the time at which the alarm will fire.
+//
+// Allowed game scenes: any.
+func (s *Alarm) Time() (float64, error) {
+	return s.TimeContext(context.Background())
+}
+
+// TimeContext - the time at which the alarm will fire.
+//
+// Allowed game scenes: any.
+func (s *Alarm) TimeContext(ctx context.Context) (float64, error) {
+	var err error
+	var argBytes []byte
+	var vv float64
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_get_Time",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// TimeAsync - the time at which the alarm will fire.
+//
+// Allowed game scenes: any.
+func (s *Alarm) TimeAsync() (*krpcgo.Future[float64], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_get_Time",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (float64, error) {
+		var vv float64
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// TimeStream - the time at which the alarm will fire.
+//
+// Allowed game scenes: any.
+func (s *Alarm) TimeStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[float64], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_get_Time",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (float64, error) {
+		var value float64
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// TimeCall builds a call to Time without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Alarm) TimeCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_get_Time",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeTime decodes the result of a call built with TimeCall.
+func (s *Alarm) DecodeTime(result *types.ProcedureResult) (float64, error) {
+	var vv float64
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetTime - the time at which the alarm will fire.
+//
+// Allowed game scenes: any.
+func (s *Alarm) SetTime(value float64) error {
+	return s.SetTimeContext(context.Background(), value)
+}
+
+// SetTimeContext - the time at which the alarm will fire.
+//
+// Allowed game scenes: any.
+func (s *Alarm) SetTimeContext(ctx context.Context, value float64) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_set_Time",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetTimeAsync - the time at which the alarm will fire.
+//
+// Allowed game scenes: any.
+func (s *Alarm) SetTimeAsync(value float64) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_set_Time",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetTimeCall builds a call to SetTime without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Alarm) SetTimeCall(value float64) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_set_Time",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// Type - the type of the alarm.
+//
+// Allowed game scenes: any.
+func (s *Alarm) Type() (AlarmType, error) {
+	return s.TypeContext(context.Background())
+}
+
+// TypeContext - the type of the alarm.
+//
+// Allowed game scenes: any.
+func (s *Alarm) TypeContext(ctx context.Context) (AlarmType, error) {
+	var err error
+	var argBytes []byte
+	var vv AlarmType
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_get_Type",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// TypeAsync - the type of the alarm.
+//
+// Allowed game scenes: any.
+func (s *Alarm) TypeAsync() (*krpcgo.Future[AlarmType], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_get_Type",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (AlarmType, error) {
+		var vv AlarmType
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// TypeStream - the type of the alarm.
+//
+// Allowed game scenes: any.
+func (s *Alarm) TypeStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[AlarmType], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_get_Type",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (AlarmType, error) {
+		var value AlarmType
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// TypeCall builds a call to Type without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Alarm) TypeCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_get_Type",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeType decodes the result of a call built with TypeCall.
+func (s *Alarm) DecodeType(result *types.ProcedureResult) (AlarmType, error) {
+	var vv AlarmType
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// ID - the unique identifier for the alarm.
+//
+// Allowed game scenes: any.
+func (s *Alarm) ID() (string, error) {
+	return s.IDContext(context.Background())
+}
+
+// IDContext - the unique identifier for the alarm.
+//
+// Allowed game scenes: any.
+func (s *Alarm) IDContext(ctx context.Context) (string, error) {
+	var err error
+	var argBytes []byte
+	var vv string
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_get_ID",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// IDAsync - the unique identifier for the alarm.
+//
+// Allowed game scenes: any.
+func (s *Alarm) IDAsync() (*krpcgo.Future[string], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_get_ID",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (string, error) {
+		var vv string
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// IDStream - the unique identifier for the alarm.
+//
+// Allowed game scenes: any.
+func (s *Alarm) IDStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[string], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_get_ID",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (string, error) {
+		var value string
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// IDCall builds a call to ID without making it, such as to make several calls
+// with CallMultiple, add a stream with AddStream or use it in an expression.
+func (s *Alarm) IDCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_get_ID",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeID decodes the result of a call built with IDCall.
+func (s *Alarm) DecodeID(result *types.ProcedureResult) (string, error) {
+	var vv string
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// Name - the short name of the alarm.
+//
+// Allowed game scenes: any.
+func (s *Alarm) Name() (string, error) {
+	return s.NameContext(context.Background())
+}
+
+// NameContext - the short name of the alarm.
+//
+// Allowed game scenes: any.
+func (s *Alarm) NameContext(ctx context.Context) (string, error) {
+	var err error
+	var argBytes []byte
+	var vv string
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_get_Name",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// NameAsync - the short name of the alarm.
+//
+// Allowed game scenes: any.
+func (s *Alarm) NameAsync() (*krpcgo.Future[string], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_get_Name",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (string, error) {
+		var vv string
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// NameStream - the short name of the alarm.
+//
+// Allowed game scenes: any.
+func (s *Alarm) NameStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[string], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_get_Name",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (string, error) {
+		var value string
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// NameCall builds a call to Name without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Alarm) NameCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_get_Name",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeName decodes the result of a call built with NameCall.
+func (s *Alarm) DecodeName(result *types.ProcedureResult) (string, error) {
+	var vv string
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetName - the short name of the alarm.
+//
+// Allowed game scenes: any.
+func (s *Alarm) SetName(value string) error {
+	return s.SetNameContext(context.Background(), value)
+}
+
+// SetNameContext - the short name of the alarm.
+//
+// Allowed game scenes: any.
+func (s *Alarm) SetNameContext(ctx context.Context, value string) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_set_Name",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetNameAsync - the short name of the alarm.
+//
+// Allowed game scenes: any.
+func (s *Alarm) SetNameAsync(value string) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_set_Name",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetNameCall builds a call to SetName without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Alarm) SetNameCall(value string) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_set_Name",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// Notes - the long description of the alarm.
+//
+// Allowed game scenes: any.
+func (s *Alarm) Notes() (string, error) {
+	return s.NotesContext(context.Background())
+}
+
+// NotesContext - the long description of the alarm.
+//
+// Allowed game scenes: any.
+func (s *Alarm) NotesContext(ctx context.Context) (string, error) {
+	var err error
+	var argBytes []byte
+	var vv string
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_get_Notes",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// NotesAsync - the long description of the alarm.
+//
+// Allowed game scenes: any.
+func (s *Alarm) NotesAsync() (*krpcgo.Future[string], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_get_Notes",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (string, error) {
+		var vv string
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// NotesStream - the long description of the alarm.
+//
+// Allowed game scenes: any.
+func (s *Alarm) NotesStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[string], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_get_Notes",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (string, error) {
+		var value string
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// NotesCall builds a call to Notes without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Alarm) NotesCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_get_Notes",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeNotes decodes the result of a call built with NotesCall.
+func (s *Alarm) DecodeNotes(result *types.ProcedureResult) (string, error) {
+	var vv string
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetNotes - the long description of the alarm.
+//
+// Allowed game scenes: any.
+func (s *Alarm) SetNotes(value string) error {
+	return s.SetNotesContext(context.Background(), value)
+}
+
+// SetNotesContext - the long description of the alarm.
+//
+// Allowed game scenes: any.
+func (s *Alarm) SetNotesContext(ctx context.Context, value string) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_set_Notes",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetNotesAsync - the long description of the alarm.
+//
+// Allowed game scenes: any.
+func (s *Alarm) SetNotesAsync(value string) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_set_Notes",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetNotesCall builds a call to SetNotes without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Alarm) SetNotesCall(value string) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_set_Notes",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// Remaining - the number of seconds until the alarm will fire.
+//
+// Allowed game scenes: any.
+func (s *Alarm) Remaining() (float64, error) {
+	return s.RemainingContext(context.Background())
+}
+
+// RemainingContext - the number of seconds until the alarm will fire.
+//
+// Allowed game scenes: any.
+func (s *Alarm) RemainingContext(ctx context.Context) (float64, error) {
+	var err error
+	var argBytes []byte
+	var vv float64
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_get_Remaining",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// RemainingAsync - the number of seconds until the alarm will fire.
+//
+// Allowed game scenes: any.
+func (s *Alarm) RemainingAsync() (*krpcgo.Future[float64], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_get_Remaining",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (float64, error) {
+		var vv float64
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// RemainingStream - the number of seconds until the alarm will fire.
+//
+// Allowed game scenes: any.
+func (s *Alarm) RemainingStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[float64], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_get_Remaining",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (float64, error) {
+		var value float64
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// RemainingCall builds a call to Remaining without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Alarm) RemainingCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_get_Remaining",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeRemaining decodes the result of a call built with RemainingCall.
+func (s *Alarm) DecodeRemaining(result *types.ProcedureResult) (float64, error) {
+	var vv float64
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// Repeat - whether the alarm will be repeated after it has fired.
+//
+// Allowed game scenes: any.
+func (s *Alarm) Repeat() (bool, error) {
+	return s.RepeatContext(context.Background())
+}
+
+// RepeatContext - whether the alarm will be repeated after it has fired.
+//
+// Allowed game scenes: any.
+func (s *Alarm) RepeatContext(ctx context.Context) (bool, error) {
+	var err error
+	var argBytes []byte
+	var vv bool
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_get_Repeat",
 		Service:   "KerbalAlarmClock",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
-	})
-	_, err = s.Client.Call(request)
+	err = encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	return nil
+	return vv, nil
 }
 
-// Margin - the number of seconds before the event that the alarm will fire.
+// RepeatAsync - whether the alarm will be repeated after it has fired.
 //
 // Allowed game scenes: any.
-func (s *Alarm) Margin() (float64, error) {
+func (s *Alarm) RepeatAsync() (*krpcgo.Future[bool], error) {
 	var err error
 	var argBytes []byte
-	var vv float64
 	request := &types.ProcedureCall{
-		Procedure: "Alarm_get_Margin",
+		Procedure: "Alarm_get_Repeat",
 		Service:   "KerbalAlarmClock",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	return vv, nil
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (bool, error) {
+		var vv bool
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
 }
 
-// MarginStream - the number of seconds before the event that the alarm will
-// fire.
+// RepeatStream - whether the alarm will be repeated after it has fired.
 //
 // Allowed game scenes: any.
-func (s *Alarm) MarginStream() (*krpcgo.Stream[float64], error) {
+func (s *Alarm) RepeatStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[bool], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Alarm_get_Margin",
+		Procedure: "Alarm_get_Repeat",
 		Service:   "KerbalAlarmClock",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -540,94 +2417,100 @@ func (s *Alarm) MarginStream() (*krpcgo.Stream[float64], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) float64 {
-		var value float64
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (bool, error) {
+		var value bool
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
 	return stream, nil
 }
 
-// SetMargin - the number of seconds before the event that the alarm will fire.
-//
-// Allowed game scenes: any.
-func (s *Alarm) SetMargin(value float64) error {
+// RepeatCall builds a call to Repeat without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Alarm) RepeatCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Alarm_set_Margin",
+		Procedure: "Alarm_get_Repeat",
 		Service:   "KerbalAlarmClock",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
-	if err != nil {
-		return tracerr.Wrap(err)
+	return request, nil
+}
+
+// DecodeRepeat decodes the result of a call built with RepeatCall.
+func (s *Alarm) DecodeRepeat(result *types.ProcedureResult) (bool, error) {
+	var vv bool
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
 	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
-	})
-	_, err = s.Client.Call(request)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	return nil
+	return vv, nil
 }
 
-// Time - the time at which the alarm will fire.
+// SetRepeat - whether the alarm will be repeated after it has fired.
 //
 // Allowed game scenes: any.
-func (s *Alarm) Time() (float64, error) {
+func (s *Alarm) SetRepeat(value bool) error {
+	return s.SetRepeatContext(context.Background(), value)
+}
+
+// SetRepeatContext - whether the alarm will be repeated after it has fired.
+//
+// Allowed game scenes: any.
+func (s *Alarm) SetRepeatContext(ctx context.Context, value bool) error {
 	var err error
 	var argBytes []byte
-	var vv float64
 	request := &types.ProcedureCall{
-		Procedure: "Alarm_get_Time",
+		Procedure: "Alarm_set_Repeat",
 		Service:   "KerbalAlarmClock",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	return vv, nil
+	return nil
 }
 
-// TimeStream - the time at which the alarm will fire.
+// SetRepeatAsync - whether the alarm will be repeated after it has fired.
 //
 // Allowed game scenes: any.
-func (s *Alarm) TimeStream() (*krpcgo.Stream[float64], error) {
+func (s *Alarm) SetRepeatAsync(value bool) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Alarm_get_Time",
+		Procedure: "Alarm_set_Repeat",
 		Service:   "KerbalAlarmClock",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -638,36 +2521,33 @@ func (s *Alarm) TimeStream() (*krpcgo.Stream[float64], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) float64 {
-		var value float64
-		encode.Unmarshal(b, &value)
-		return value
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
 	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetTime - the time at which the alarm will fire.
-//
-// Allowed game scenes: any.
-func (s *Alarm) SetTime(value float64) error {
+// SetRepeatCall builds a call to SetRepeat without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Alarm) SetRepeatCall(value bool) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Alarm_set_Time",
+		Procedure: "Alarm_set_Repeat",
 		Service:   "KerbalAlarmClock",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
@@ -675,28 +2555,33 @@ func (s *Alarm) SetTime(value float64) error {
 	})
 	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return request, nil
 }
 
-// Type - the type of the alarm.
+// RepeatPeriod - the time delay to automatically create an alarm after it has
+// fired.
 //
 // Allowed game scenes: any.
-func (s *Alarm) Type() (AlarmType, error) {
+func (s *Alarm) RepeatPeriod() (float64, error) {
+	return s.RepeatPeriodContext(context.Background())
+}
+
+// RepeatPeriodContext - the time delay to automatically create an alarm after
+// it has fired.
+//
+// Allowed game scenes: any.
+func (s *Alarm) RepeatPeriodContext(ctx context.Context) (float64, error) {
 	var err error
 	var argBytes []byte
-	var vv AlarmType
+	var vv float64
 	request := &types.ProcedureCall{
-		Procedure: "Alarm_get_Type",
+		Procedure: "Alarm_get_RepeatPeriod",
 		Service:   "KerbalAlarmClock",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -707,7 +2592,7 @@ func (s *Alarm) Type() (AlarmType, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -718,14 +2603,15 @@ func (s *Alarm) Type() (AlarmType, error) {
 	return vv, nil
 }
 
-// TypeStream - the type of the alarm.
+// RepeatPeriodAsync - the time delay to automatically create an alarm after it
+// has fired.
 //
 // Allowed game scenes: any.
-func (s *Alarm) TypeStream() (*krpcgo.Stream[AlarmType], error) {
+func (s *Alarm) RepeatPeriodAsync() (*krpcgo.Future[float64], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Alarm_get_Type",
+		Procedure: "Alarm_get_RepeatPeriod",
 		Service:   "KerbalAlarmClock",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -736,61 +2622,56 @@ func (s *Alarm) TypeStream() (*krpcgo.Stream[AlarmType], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) AlarmType {
-		var value AlarmType
-		encode.Unmarshal(b, &value)
-		return value
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (float64, error) {
+		var vv float64
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
 	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
-	})
-	return stream, nil
+	return future, nil
 }
 
-// ID - the unique identifier for the alarm.
+// RepeatPeriodStream - the time delay to automatically create an alarm after it
+// has fired.
 //
 // Allowed game scenes: any.
-func (s *Alarm) ID() (string, error) {
+func (s *Alarm) RepeatPeriodStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[float64], error) {
 	var err error
 	var argBytes []byte
-	var vv string
 	request := &types.ProcedureCall{
-		Procedure: "Alarm_get_ID",
+		Procedure: "Alarm_get_RepeatPeriod",
 		Service:   "KerbalAlarmClock",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	return vv, nil
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (float64, error) {
+		var value float64
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
 }
 
-// IDStream - the unique identifier for the alarm.
-//
-// Allowed game scenes: any.
-func (s *Alarm) IDStream() (*krpcgo.Stream[string], error) {
+// RepeatPeriodCall builds a call to RepeatPeriod without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *Alarm) RepeatPeriodCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Alarm_get_ID",
+		Procedure: "Alarm_get_RepeatPeriod",
 		Service:   "KerbalAlarmClock",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -801,61 +2682,73 @@ func (s *Alarm) IDStream() (*krpcgo.Stream[string], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	return request, nil
+}
+
+// DecodeRepeatPeriod decodes the result of a call built with RepeatPeriodCall.
+func (s *Alarm) DecodeRepeatPeriod(result *types.ProcedureResult) (float64, error) {
+	var vv float64
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return nil, tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) string {
-		var value string
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
-	})
-	return stream, nil
+	return vv, nil
 }
 
-// Name - the short name of the alarm.
+// SetRepeatPeriod - the time delay to automatically create an alarm after it
+// has fired.
 //
 // Allowed game scenes: any.
-func (s *Alarm) Name() (string, error) {
+func (s *Alarm) SetRepeatPeriod(value float64) error {
+	return s.SetRepeatPeriodContext(context.Background(), value)
+}
+
+// SetRepeatPeriodContext - the time delay to automatically create an alarm
+// after it has fired.
+//
+// Allowed game scenes: any.
+func (s *Alarm) SetRepeatPeriodContext(ctx context.Context, value float64) error {
 	var err error
 	var argBytes []byte
-	var vv string
 	request := &types.ProcedureCall{
-		Procedure: "Alarm_get_Name",
+		Procedure: "Alarm_set_RepeatPeriod",
 		Service:   "KerbalAlarmClock",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	return vv, nil
+	return nil
 }
 
-// NameStream - the short name of the alarm.
+// SetRepeatPeriodAsync - the time delay to automatically create an alarm after
+// it has fired.
 //
 // Allowed game scenes: any.
-func (s *Alarm) NameStream() (*krpcgo.Stream[string], error) {
+func (s *Alarm) SetRepeatPeriodAsync(value float64) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Alarm_get_Name",
+		Procedure: "Alarm_set_RepeatPeriod",
 		Service:   "KerbalAlarmClock",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -866,36 +2759,33 @@ func (s *Alarm) NameStream() (*krpcgo.Stream[string], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) string {
-		var value string
-		encode.Unmarshal(b, &value)
-		return value
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
 	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetName - the short name of the alarm.
-//
-// Allowed game scenes: any.
-func (s *Alarm) SetName(value string) error {
+// SetRepeatPeriodCall builds a call to SetRepeatPeriod without making it, such
+// as to make several calls with CallMultiple, add a stream with AddStream or
+// use it in an expression.
+func (s *Alarm) SetRepeatPeriodCall(value float64) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Alarm_set_Name",
+		Procedure: "Alarm_set_RepeatPeriod",
 		Service:   "KerbalAlarmClock",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
@@ -903,57 +2793,91 @@ func (s *Alarm) SetName(value string) error {
 	})
 	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return request, nil
 }
 
-// Notes - the long description of the alarm.
+// Vessel - the vessel that the alarm is attached to.
 //
 // Allowed game scenes: any.
-func (s *Alarm) Notes() (string, error) {
+func (s *Alarm) Vessel() (*spacecenter.Vessel, error) {
+	return s.VesselContext(context.Background())
+}
+
+// VesselContext - the vessel that the alarm is attached to.
+//
+// Allowed game scenes: any.
+func (s *Alarm) VesselContext(ctx context.Context) (*spacecenter.Vessel, error) {
 	var err error
 	var argBytes []byte
-	var vv string
+	var vv spacecenter.Vessel
 	request := &types.ProcedureCall{
-		Procedure: "Alarm_get_Notes",
+		Procedure: "Alarm_get_Vessel",
 		Service:   "KerbalAlarmClock",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return &vv, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return &vv, tracerr.Wrap(err)
 	}
 	err = encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return &vv, tracerr.Wrap(err)
 	}
-	return vv, nil
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// VesselAsync - the vessel that the alarm is attached to.
+//
+// Allowed game scenes: any.
+func (s *Alarm) VesselAsync() (*krpcgo.Future[*spacecenter.Vessel], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Alarm_get_Vessel",
+		Service:   "KerbalAlarmClock",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*spacecenter.Vessel, error) {
+		var vv spacecenter.Vessel
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
 }
 
-// NotesStream - the long description of the alarm.
-//
-// Allowed game scenes: any.
-func (s *Alarm) NotesStream() (*krpcgo.Stream[string], error) {
+// VesselCall builds a call to Vessel without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Alarm) VesselCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Alarm_get_Notes",
+		Procedure: "Alarm_get_Vessel",
 		Service:   "KerbalAlarmClock",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -964,31 +2888,38 @@ func (s *Alarm) NotesStream() (*krpcgo.Stream[string], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	return request, nil
+}
+
+// DecodeVessel decodes the result of a call built with VesselCall.
+func (s *Alarm) DecodeVessel(result *types.ProcedureResult) (*spacecenter.Vessel, error) {
+	var vv spacecenter.Vessel
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return nil, tracerr.Wrap(err)
+		return &vv, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) string {
-		var value string
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
-	})
-	return stream, nil
+	vv.Client = s.Client
+	return &vv, nil
 }
 
-// SetNotes - the long description of the alarm.
+// SetVessel - the vessel that the alarm is attached to.
 //
 // Allowed game scenes: any.
-func (s *Alarm) SetNotes(value string) error {
+func (s *Alarm) SetVessel(value *spacecenter.Vessel) error {
+	return s.SetVesselContext(context.Background(), value)
+}
+
+// SetVesselContext - the vessel that the alarm is attached to.
+//
+// Allowed game scenes: any.
+func (s *Alarm) SetVesselContext(ctx context.Context, value *spacecenter.Vessel) error {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Alarm_set_Notes",
+		Procedure: "Alarm_set_Vessel",
 		Service:   "KerbalAlarmClock",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1007,51 +2938,53 @@ func (s *Alarm) SetNotes(value string) error {
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
 		return tracerr.Wrap(err)
 	}
 	return nil
 }
 
-// Remaining - the number of seconds until the alarm will fire.
+// SetVesselAsync - the vessel that the alarm is attached to.
 //
 // Allowed game scenes: any.
-func (s *Alarm) Remaining() (float64, error) {
+func (s *Alarm) SetVesselAsync(value *spacecenter.Vessel) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
-	var vv float64
 	request := &types.ProcedureCall{
-		Procedure: "Alarm_get_Remaining",
+		Procedure: "Alarm_set_Vessel",
 		Service:   "KerbalAlarmClock",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	return vv, nil
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
 }
 
-// RemainingStream - the number of seconds until the alarm will fire.
-//
-// Allowed game scenes: any.
-func (s *Alarm) RemainingStream() (*krpcgo.Stream[float64], error) {
+// SetVesselCall builds a call to SetVessel without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Alarm) SetVesselCall(value *spacecenter.Vessel) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Alarm_get_Remaining",
+		Procedure: "Alarm_set_Vessel",
 		Service:   "KerbalAlarmClock",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1062,61 +2995,63 @@ func (s *Alarm) RemainingStream() (*krpcgo.Stream[float64], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) float64 {
-		var value float64
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
 	})
-	return stream, nil
+	return request, nil
 }
 
-// Repeat - whether the alarm will be repeated after it has fired.
+// XferOriginBody - the celestial body the vessel is departing from.
 //
 // Allowed game scenes: any.
-func (s *Alarm) Repeat() (bool, error) {
+func (s *Alarm) XferOriginBody() (*spacecenter.CelestialBody, error) {
+	return s.XferOriginBodyContext(context.Background())
+}
+
+// XferOriginBodyContext - the celestial body the vessel is departing from.
+//
+// Allowed game scenes: any.
+func (s *Alarm) XferOriginBodyContext(ctx context.Context) (*spacecenter.CelestialBody, error) {
 	var err error
 	var argBytes []byte
-	var vv bool
+	var vv spacecenter.CelestialBody
 	request := &types.ProcedureCall{
-		Procedure: "Alarm_get_Repeat",
+		Procedure: "Alarm_get_XferOriginBody",
 		Service:   "KerbalAlarmClock",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return &vv, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return &vv, tracerr.Wrap(err)
 	}
 	err = encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return &vv, tracerr.Wrap(err)
 	}
-	return vv, nil
+	vv.Client = s.Client
+	return &vv, nil
 }
 
-// RepeatStream - whether the alarm will be repeated after it has fired.
+// XferOriginBodyAsync - the celestial body the vessel is departing from.
 //
 // Allowed game scenes: any.
-func (s *Alarm) RepeatStream() (*krpcgo.Stream[bool], error) {
+func (s *Alarm) XferOriginBodyAsync() (*krpcgo.Future[*spacecenter.CelestialBody], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Alarm_get_Repeat",
+		Procedure: "Alarm_get_XferOriginBody",
 		Service:   "KerbalAlarmClock",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1127,96 +3062,102 @@ func (s *Alarm) RepeatStream() (*krpcgo.Stream[bool], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) bool {
-		var value bool
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*spacecenter.CelestialBody, error) {
+		var vv spacecenter.CelestialBody
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetRepeat - whether the alarm will be repeated after it has fired.
-//
-// Allowed game scenes: any.
-func (s *Alarm) SetRepeat(value bool) error {
+// XferOriginBodyCall builds a call to XferOriginBody without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *Alarm) XferOriginBodyCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Alarm_set_Repeat",
+		Procedure: "Alarm_get_XferOriginBody",
 		Service:   "KerbalAlarmClock",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
-	if err != nil {
-		return tracerr.Wrap(err)
+	return request, nil
+}
+
+// DecodeXferOriginBody decodes the result of a call built with
+// XferOriginBodyCall.
+func (s *Alarm) DecodeXferOriginBody(result *types.ProcedureResult) (*spacecenter.CelestialBody, error) {
+	var vv spacecenter.CelestialBody
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
 	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
-	})
-	_, err = s.Client.Call(request)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return &vv, tracerr.Wrap(err)
 	}
-	return nil
+	vv.Client = s.Client
+	return &vv, nil
 }
 
-// RepeatPeriod - the time delay to automatically create an alarm after it has
-// fired.
+// SetXferOriginBody - the celestial body the vessel is departing from.
 //
 // Allowed game scenes: any.
-func (s *Alarm) RepeatPeriod() (float64, error) {
+func (s *Alarm) SetXferOriginBody(value *spacecenter.CelestialBody) error {
+	return s.SetXferOriginBodyContext(context.Background(), value)
+}
+
+// SetXferOriginBodyContext - the celestial body the vessel is departing from.
+//
+// Allowed game scenes: any.
+func (s *Alarm) SetXferOriginBodyContext(ctx context.Context, value *spacecenter.CelestialBody) error {
 	var err error
 	var argBytes []byte
-	var vv float64
 	request := &types.ProcedureCall{
-		Procedure: "Alarm_get_RepeatPeriod",
+		Procedure: "Alarm_set_XferOriginBody",
 		Service:   "KerbalAlarmClock",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	return vv, nil
+	return nil
 }
 
-// RepeatPeriodStream - the time delay to automatically create an alarm after it
-// has fired.
+// SetXferOriginBodyAsync - the celestial body the vessel is departing from.
 //
 // Allowed game scenes: any.
-func (s *Alarm) RepeatPeriodStream() (*krpcgo.Stream[float64], error) {
+func (s *Alarm) SetXferOriginBodyAsync(value *spacecenter.CelestialBody) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Alarm_get_RepeatPeriod",
+		Procedure: "Alarm_set_XferOriginBody",
 		Service:   "KerbalAlarmClock",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1227,37 +3168,33 @@ func (s *Alarm) RepeatPeriodStream() (*krpcgo.Stream[float64], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) float64 {
-		var value float64
-		encode.Unmarshal(b, &value)
-		return value
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
 	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetRepeatPeriod - the time delay to automatically create an alarm after it
-// has fired.
-//
-// Allowed game scenes: any.
-func (s *Alarm) SetRepeatPeriod(value float64) error {
+// SetXferOriginBodyCall builds a call to SetXferOriginBody without making it,
+// such as to make several calls with CallMultiple, add a stream with AddStream
+// or use it in an expression.
+func (s *Alarm) SetXferOriginBodyCall(value *spacecenter.CelestialBody) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Alarm_set_RepeatPeriod",
+		Procedure: "Alarm_set_XferOriginBody",
 		Service:   "KerbalAlarmClock",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
@@ -1265,28 +3202,31 @@ func (s *Alarm) SetRepeatPeriod(value float64) error {
 	})
 	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return request, nil
 }
 
-// Vessel - the vessel that the alarm is attached to.
+// XferTargetBody - the celestial body the vessel is arriving at.
 //
 // Allowed game scenes: any.
-func (s *Alarm) Vessel() (*spacecenter.Vessel, error) {
+func (s *Alarm) XferTargetBody() (*spacecenter.CelestialBody, error) {
+	return s.XferTargetBodyContext(context.Background())
+}
+
+// XferTargetBodyContext - the celestial body the vessel is arriving at.
+//
+// Allowed game scenes: any.
+func (s *Alarm) XferTargetBodyContext(ctx context.Context) (*spacecenter.CelestialBody, error) {
 	var err error
 	var argBytes []byte
-	var vv spacecenter.Vessel
+	var vv spacecenter.CelestialBody
 	request := &types.ProcedureCall{
-		Procedure: "Alarm_get_Vessel",
+		Procedure: "Alarm_get_XferTargetBody",
 		Service:   "KerbalAlarmClock",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1297,7 +3237,7 @@ func (s *Alarm) Vessel() (*spacecenter.Vessel, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -1309,63 +3249,65 @@ func (s *Alarm) Vessel() (*spacecenter.Vessel, error) {
 	return &vv, nil
 }
 
-// SetVessel - the vessel that the alarm is attached to.
+// XferTargetBodyAsync - the celestial body the vessel is arriving at.
 //
 // Allowed game scenes: any.
-func (s *Alarm) SetVessel(value *spacecenter.Vessel) error {
+func (s *Alarm) XferTargetBodyAsync() (*krpcgo.Future[*spacecenter.CelestialBody], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Alarm_set_Vessel",
+		Procedure: "Alarm_get_XferTargetBody",
 		Service:   "KerbalAlarmClock",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*spacecenter.CelestialBody, error) {
+		var vv spacecenter.CelestialBody
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return future, nil
 }
 
-// XferOriginBody - the celestial body the vessel is departing from.
-//
-// Allowed game scenes: any.
-func (s *Alarm) XferOriginBody() (*spacecenter.CelestialBody, error) {
+// XferTargetBodyCall builds a call to XferTargetBody without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *Alarm) XferTargetBodyCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv spacecenter.CelestialBody
 	request := &types.ProcedureCall{
-		Procedure: "Alarm_get_XferOriginBody",
+		Procedure: "Alarm_get_XferTargetBody",
 		Service:   "KerbalAlarmClock",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
+	return request, nil
+}
+
+// DecodeXferTargetBody decodes the result of a call built with
+// XferTargetBodyCall.
+func (s *Alarm) DecodeXferTargetBody(result *types.ProcedureResult) (*spacecenter.CelestialBody, error) {
+	var vv spacecenter.CelestialBody
+	if err := krpcgo.ResultError(result); err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -1373,14 +3315,21 @@ func (s *Alarm) XferOriginBody() (*spacecenter.CelestialBody, error) {
 	return &vv, nil
 }
 
-// SetXferOriginBody - the celestial body the vessel is departing from.
+// SetXferTargetBody - the celestial body the vessel is arriving at.
 //
 // Allowed game scenes: any.
-func (s *Alarm) SetXferOriginBody(value *spacecenter.CelestialBody) error {
+func (s *Alarm) SetXferTargetBody(value *spacecenter.CelestialBody) error {
+	return s.SetXferTargetBodyContext(context.Background(), value)
+}
+
+// SetXferTargetBodyContext - the celestial body the vessel is arriving at.
+//
+// Allowed game scenes: any.
+func (s *Alarm) SetXferTargetBodyContext(ctx context.Context, value *spacecenter.CelestialBody) error {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Alarm_set_XferOriginBody",
+		Procedure: "Alarm_set_XferTargetBody",
 		Service:   "KerbalAlarmClock",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1399,48 +3348,49 @@ func (s *Alarm) SetXferOriginBody(value *spacecenter.CelestialBody) error {
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
 		return tracerr.Wrap(err)
 	}
 	return nil
 }
 
-// XferTargetBody - the celestial body the vessel is arriving at.
+// SetXferTargetBodyAsync - the celestial body the vessel is arriving at.
 //
 // Allowed game scenes: any.
-func (s *Alarm) XferTargetBody() (*spacecenter.CelestialBody, error) {
+func (s *Alarm) SetXferTargetBodyAsync(value *spacecenter.CelestialBody) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
-	var vv spacecenter.CelestialBody
 	request := &types.ProcedureCall{
-		Procedure: "Alarm_get_XferTargetBody",
+		Procedure: "Alarm_set_XferTargetBody",
 		Service:   "KerbalAlarmClock",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	vv.Client = s.Client
-	return &vv, nil
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
 }
 
-// SetXferTargetBody - the celestial body the vessel is arriving at.
-//
-// Allowed game scenes: any.
-func (s *Alarm) SetXferTargetBody(value *spacecenter.CelestialBody) error {
+// SetXferTargetBodyCall builds a call to SetXferTargetBody without making it,
+// such as to make several calls with CallMultiple, add a stream with AddStream
+// or use it in an expression.
+func (s *Alarm) SetXferTargetBodyCall(value *spacecenter.CelestialBody) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
@@ -1449,7 +3399,7 @@ func (s *Alarm) SetXferTargetBody(value *spacecenter.CelestialBody) error {
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
@@ -1457,15 +3407,75 @@ func (s *Alarm) SetXferTargetBody(value *spacecenter.CelestialBody) error {
 	})
 	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return request, nil
+}
+func init() {
+	krpcgo.RegisterReturnTypes("KerbalAlarmClock", map[string]*types.Type{
+		"AlarmWithName": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Alarm",
+			Service: "KerbalAlarmClock",
+		},
+		"Alarm_get_Action": &types.Type{
+			Code:    types.Type_ENUMERATION,
+			Name:    "AlarmAction",
+			Service: "KerbalAlarmClock",
+		},
+		"Alarm_get_ID":           &types.Type{Code: types.Type_STRING},
+		"Alarm_get_Margin":       &types.Type{Code: types.Type_DOUBLE},
+		"Alarm_get_Name":         &types.Type{Code: types.Type_STRING},
+		"Alarm_get_Notes":        &types.Type{Code: types.Type_STRING},
+		"Alarm_get_Remaining":    &types.Type{Code: types.Type_DOUBLE},
+		"Alarm_get_Repeat":       &types.Type{Code: types.Type_BOOL},
+		"Alarm_get_RepeatPeriod": &types.Type{Code: types.Type_DOUBLE},
+		"Alarm_get_Time":         &types.Type{Code: types.Type_DOUBLE},
+		"Alarm_get_Type": &types.Type{
+			Code:    types.Type_ENUMERATION,
+			Name:    "AlarmType",
+			Service: "KerbalAlarmClock",
+		},
+		"Alarm_get_Vessel": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Vessel",
+			Service: "SpaceCenter",
+		},
+		"Alarm_get_XferOriginBody": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "CelestialBody",
+			Service: "SpaceCenter",
+		},
+		"Alarm_get_XferTargetBody": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "CelestialBody",
+			Service: "SpaceCenter",
+		},
+		"AlarmsWithType": &types.Type{
+			Code: types.Type_LIST,
+			Types: []*types.Type{&types.Type{
+				Code:    types.Type_CLASS,
+				Name:    "Alarm",
+				Service: "KerbalAlarmClock",
+			}},
+		},
+		"CreateAlarm": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Alarm",
+			Service: "KerbalAlarmClock",
+		},
+		"get_Alarms": &types.Type{
+			Code: types.Type_LIST,
+			Types: []*types.Type{&types.Type{
+				Code:    types.Type_CLASS,
+				Name:    "Alarm",
+				Service: "KerbalAlarmClock",
+			}},
+		},
+		"get_Available": &types.Type{Code: types.Type_BOOL},
+	})
 }
@@ -5,6 +5,7 @@
 package krpc
 
 import (
+	"context"
 	krpcgo "github.com/atburke/krpc-go"
 	encode "github.com/atburke/krpc-go/lib/encode"
 	service "github.com/atburke/krpc-go/lib/service"
@@ -30,6 +31,12 @@ func (err ErrArgument) Error() string {
 	return err.msg
 }
 
+func init() {
+	krpcgo.RegisterException("KRPC", "ArgumentException", func(msg string) error {
+		return &ErrArgument{msg: msg}
+	})
+}
+
 // ErrArgumentNull - a null reference was passed to a method that does not
 // accept it as a valid argument.
 type ErrArgumentNull struct {
@@ -46,6 +53,12 @@ func (err ErrArgumentNull) Error() string {
 	return err.msg
 }
 
+func init() {
+	krpcgo.RegisterException("KRPC", "ArgumentNullException", func(msg string) error {
+		return &ErrArgumentNull{msg: msg}
+	})
+}
+
 // ErrArgumentOutOfRange - the value of an argument is outside the allowable
 // range of values as defined by the invoked method.
 type ErrArgumentOutOfRange struct {
@@ -62,6 +75,12 @@ func (err ErrArgumentOutOfRange) Error() string {
 	return err.msg
 }
 
+func init() {
+	krpcgo.RegisterException("KRPC", "ArgumentOutOfRangeException", func(msg string) error {
+		return &ErrArgumentOutOfRange{msg: msg}
+	})
+}
+
 // ErrInvalidOperation - a method call was made to a method that is invalid
 // given the current state of the object.
 type ErrInvalidOperation struct {
@@ -78,6 +97,12 @@ func (err ErrInvalidOperation) Error() string {
 	return err.msg
 }
 
+func init() {
+	krpcgo.RegisterException("KRPC", "InvalidOperationException", func(msg string) error {
+		return &ErrInvalidOperation{msg: msg}
+	})
+}
+
 // GameScene - the game scene. See <see cref="M:KRPC.CurrentGameScene" />.
 type GameScene int32
 
@@ -107,7 +132,7 @@ type Expression struct {
 }
 
 // NewExpression creates a new Expression.
-func NewExpression(id uint64, client *krpcgo.KRPCClient) *Expression {
+func NewExpression(id uint64, client krpcgo.Caller) *Expression {
 	c := &Expression{BaseClass: service.BaseClass{Client: client}}
 	c.SetID(id)
 	return c
@@ -119,7 +144,7 @@ type Type struct {
 }
 
 // NewType creates a new Type.
-func NewType(id uint64, client *krpcgo.KRPCClient) *Type {
+func NewType(id uint64, client krpcgo.Caller) *Type {
 	c := &Type{BaseClass: service.BaseClass{Client: client}}
 	c.SetID(id)
 	return c
@@ -128,11 +153,11 @@ func NewType(id uint64, client *krpcgo.KRPCClient) *Type {
 // KRPC - main kRPC service, used by clients to interact with basic server
 // functionality.
 type KRPC struct {
-	Client *krpcgo.KRPCClient
+	Client krpcgo.Caller
 }
 
 // New creates a new KRPC.
-func New(client *krpcgo.KRPCClient) *KRPC {
+func New(client krpcgo.Caller) *KRPC {
 	return &KRPC{Client: client}
 }
 
@@ -140,13 +165,20 @@ func New(client *krpcgo.KRPCClient) *KRPC {
 //
 // Allowed game scenes: any.
 func (s *KRPC) GetClientID() ([]byte, error) {
+	return s.GetClientIDContext(context.Background())
+}
+
+// GetClientIDContext - returns the identifier for the current client.
+//
+// Allowed game scenes: any.
+func (s *KRPC) GetClientIDContext(ctx context.Context) ([]byte, error) {
 	var err error
 	var vv []byte
 	request := &types.ProcedureCall{
 		Procedure: "GetClientID",
 		Service:   "KRPC",
 	}
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -157,44 +189,90 @@ func (s *KRPC) GetClientID() ([]byte, error) {
 	return vv, nil
 }
 
+// GetClientIDAsync - returns the identifier for the current client.
+//
+// Allowed game scenes: any.
+func (s *KRPC) GetClientIDAsync() (*krpcgo.Future[[]byte], error) {
+	request := &types.ProcedureCall{
+		Procedure: "GetClientID",
+		Service:   "KRPC",
+	}
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) ([]byte, error) {
+		var vv []byte
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
 // GetClientIDStream - returns the identifier for the current client.
 //
 // Allowed game scenes: any.
-func (s *KRPC) GetClientIDStream() (*krpcgo.Stream[[]byte], error) {
+func (s *KRPC) GetClientIDStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[[]byte], error) {
 	var err error
 	request := &types.ProcedureCall{
 		Procedure: "GetClientID",
 		Service:   "KRPC",
 	}
-	krpc := New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) []byte {
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) ([]byte, error) {
 		var value []byte
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
 	return stream, nil
 }
 
+// GetClientIDCall builds a call to GetClientID without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *KRPC) GetClientIDCall() (*types.ProcedureCall, error) {
+	request := &types.ProcedureCall{
+		Procedure: "GetClientID",
+		Service:   "KRPC",
+	}
+	return request, nil
+}
+
+// DecodeGetClientID decodes the result of a call built with GetClientIDCall.
+func (s *KRPC) DecodeGetClientID(result *types.ProcedureResult) ([]byte, error) {
+	var vv []byte
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
 // GetClientName - returns the name of the current client. This is an empty
 // string if the client has no name.
 //
 // Allowed game scenes: any.
 func (s *KRPC) GetClientName() (string, error) {
+	return s.GetClientNameContext(context.Background())
+}
+
+// GetClientNameContext - returns the name of the current client. This is an
+// empty string if the client has no name.
+//
+// Allowed game scenes: any.
+func (s *KRPC) GetClientNameContext(ctx context.Context) (string, error) {
 	var err error
 	var vv string
 	request := &types.ProcedureCall{
 		Procedure: "GetClientName",
 		Service:   "KRPC",
 	}
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -205,44 +283,92 @@ func (s *KRPC) GetClientName() (string, error) {
 	return vv, nil
 }
 
+// GetClientNameAsync - returns the name of the current client. This is an empty
+// string if the client has no name.
+//
+// Allowed game scenes: any.
+func (s *KRPC) GetClientNameAsync() (*krpcgo.Future[string], error) {
+	request := &types.ProcedureCall{
+		Procedure: "GetClientName",
+		Service:   "KRPC",
+	}
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (string, error) {
+		var vv string
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
 // GetClientNameStream - returns the name of the current client. This is an
 // empty string if the client has no name.
 //
 // Allowed game scenes: any.
-func (s *KRPC) GetClientNameStream() (*krpcgo.Stream[string], error) {
+func (s *KRPC) GetClientNameStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[string], error) {
 	var err error
 	request := &types.ProcedureCall{
 		Procedure: "GetClientName",
 		Service:   "KRPC",
 	}
-	krpc := New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) string {
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (string, error) {
 		var value string
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
 	return stream, nil
 }
 
+// GetClientNameCall builds a call to GetClientName without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *KRPC) GetClientNameCall() (*types.ProcedureCall, error) {
+	request := &types.ProcedureCall{
+		Procedure: "GetClientName",
+		Service:   "KRPC",
+	}
+	return request, nil
+}
+
+// DecodeGetClientName decodes the result of a call built with
+// GetClientNameCall.
+func (s *KRPC) DecodeGetClientName(result *types.ProcedureResult) (string, error) {
+	var vv string
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
 // GetStatus - returns some information about the server, such as the version.
 //
 // Allowed game scenes: any.
 func (s *KRPC) GetStatus() (*types.Status, error) {
+	return s.GetStatusContext(context.Background())
+}
+
+// GetStatusContext - returns some information about the server, such as the
+// version.
+//
+// Allowed game scenes: any.
+func (s *KRPC) GetStatusContext(ctx context.Context) (*types.Status, error) {
 	var err error
 	var vv types.Status
 	request := &types.ProcedureCall{
 		Procedure: "GetStatus",
 		Service:   "KRPC",
 	}
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -253,19 +379,72 @@ func (s *KRPC) GetStatus() (*types.Status, error) {
 	return &vv, nil
 }
 
+// GetStatusAsync - returns some information about the server, such as the
+// version.
+//
+// Allowed game scenes: any.
+func (s *KRPC) GetStatusAsync() (*krpcgo.Future[*types.Status], error) {
+	request := &types.ProcedureCall{
+		Procedure: "GetStatus",
+		Service:   "KRPC",
+	}
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*types.Status, error) {
+		var vv types.Status
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// GetStatusCall builds a call to GetStatus without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *KRPC) GetStatusCall() (*types.ProcedureCall, error) {
+	request := &types.ProcedureCall{
+		Procedure: "GetStatus",
+		Service:   "KRPC",
+	}
+	return request, nil
+}
+
+// DecodeGetStatus decodes the result of a call built with GetStatusCall.
+func (s *KRPC) DecodeGetStatus(result *types.ProcedureResult) (*types.Status, error) {
+	var vv types.Status
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	return &vv, nil
+}
+
 // GetServices - returns information on all services, procedures, classes,
 // properties etc. provided by the server. Can be used by client libraries to
 // automatically create functionality such as stubs.
 //
 // Allowed game scenes: any.
 func (s *KRPC) GetServices() (*types.Services, error) {
+	return s.GetServicesContext(context.Background())
+}
+
+// GetServicesContext - returns information on all services, procedures,
+// classes, properties etc. provided by the server. Can be used by client
+// libraries to automatically create functionality such as stubs.
+//
+// Allowed game scenes: any.
+func (s *KRPC) GetServicesContext(ctx context.Context) (*types.Services, error) {
 	var err error
 	var vv types.Services
 	request := &types.ProcedureCall{
 		Procedure: "GetServices",
 		Service:   "KRPC",
 	}
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -276,10 +455,62 @@ func (s *KRPC) GetServices() (*types.Services, error) {
 	return &vv, nil
 }
 
+// GetServicesAsync - returns information on all services, procedures, classes,
+// properties etc. provided by the server. Can be used by client libraries to
+// automatically create functionality such as stubs.
+//
+// Allowed game scenes: any.
+func (s *KRPC) GetServicesAsync() (*krpcgo.Future[*types.Services], error) {
+	request := &types.ProcedureCall{
+		Procedure: "GetServices",
+		Service:   "KRPC",
+	}
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*types.Services, error) {
+		var vv types.Services
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// GetServicesCall builds a call to GetServices without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *KRPC) GetServicesCall() (*types.ProcedureCall, error) {
+	request := &types.ProcedureCall{
+		Procedure: "GetServices",
+		Service:   "KRPC",
+	}
+	return request, nil
+}
+
+// DecodeGetServices decodes the result of a call built with GetServicesCall.
+func (s *KRPC) DecodeGetServices(result *types.ProcedureResult) (*types.Services, error) {
+	var vv types.Services
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	return &vv, nil
+}
+
 // AddStream - add a streaming request and return its identifier.
 //
 // Allowed game scenes: any.
 func (s *KRPC) AddStream(call *types.ProcedureCall, start bool) (*types.Stream, error) {
+	return s.AddStreamContext(context.Background(), call, start)
+}
+
+// AddStreamContext - add a streaming request and return its identifier.
+//
+// Allowed game scenes: any.
+func (s *KRPC) AddStreamContext(ctx context.Context, call *types.ProcedureCall, start bool) (*types.Stream, error) {
 	var err error
 	var argBytes []byte
 	var vv types.Stream
@@ -303,7 +534,7 @@ func (s *KRPC) AddStream(call *types.ProcedureCall, start bool) (*types.Stream,
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -314,72 +545,100 @@ func (s *KRPC) AddStream(call *types.ProcedureCall, start bool) (*types.Stream,
 	return &vv, nil
 }
 
-// StartStream - start a previously added streaming request.
+// AddStreamAsync - add a streaming request and return its identifier.
 //
 // Allowed game scenes: any.
-func (s *KRPC) StartStream(id uint64) error {
+func (s *KRPC) AddStreamAsync(call *types.ProcedureCall, start bool) (*krpcgo.Future[*types.Stream], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "StartStream",
+		Procedure: "AddStream",
 		Service:   "KRPC",
 	}
-	argBytes, err = encode.Marshal(id)
+	argBytes, err = encode.Marshal(call)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	argBytes, err = encode.Marshal(start)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	return nil
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*types.Stream, error) {
+		var vv types.Stream
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		return &vv, nil
+	})
+	return future, nil
 }
 
-// SetStreamRate - set the update rate for a stream in Hz.
-//
-// Allowed game scenes: any.
-func (s *KRPC) SetStreamRate(id uint64, rate float32) error {
+// AddStreamCall builds a call to AddStream without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *KRPC) AddStreamCall(call *types.ProcedureCall, start bool) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "SetStreamRate",
+		Procedure: "AddStream",
 		Service:   "KRPC",
 	}
-	argBytes, err = encode.Marshal(id)
+	argBytes, err = encode.Marshal(call)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(rate)
+	argBytes, err = encode.Marshal(start)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	return request, nil
+}
+
+// DecodeAddStream decodes the result of a call built with AddStreamCall.
+func (s *KRPC) DecodeAddStream(result *types.ProcedureResult) (*types.Stream, error) {
+	var vv types.Stream
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return &vv, tracerr.Wrap(err)
 	}
-	return nil
+	return &vv, nil
 }
 
-// RemoveStream - remove a streaming request.
+// StartStream - start a previously added streaming request.
 //
 // Allowed game scenes: any.
-func (s *KRPC) RemoveStream(id uint64) error {
+func (s *KRPC) StartStream(id uint64) error {
+	return s.StartStreamContext(context.Background(), id)
+}
+
+// StartStreamContext - start a previously added streaming request.
+//
+// Allowed game scenes: any.
+func (s *KRPC) StartStreamContext(ctx context.Context, id uint64) error {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "RemoveStream",
+		Procedure: "StartStream",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(id)
@@ -390,215 +649,4309 @@ func (s *KRPC) RemoveStream(id uint64) error {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
 		return tracerr.Wrap(err)
 	}
 	return nil
 }
 
-// AddEvent - create an event from a server side expression.
+// StartStreamAsync - start a previously added streaming request.
 //
 // Allowed game scenes: any.
-func (s *KRPC) AddEvent(expression *Expression) error {
+func (s *KRPC) StartStreamAsync(id uint64) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "AddEvent",
+		Procedure: "StartStream",
 		Service:   "KRPC",
 	}
-	argBytes, err = encode.Marshal(expression)
+	argBytes, err = encode.Marshal(id)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
 }
 
-// Clients - a list of RPC clients that are currently connected to the server.
-// Each entry in the list is a clients identifier, name and address.
-//
-// Allowed game scenes: any.
-func (s *KRPC) Clients() ([]types.Tuple3[[]byte, string, string], error) {
+// StartStreamCall builds a call to StartStream without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *KRPC) StartStreamCall(id uint64) (*types.ProcedureCall, error) {
 	var err error
-	var vv []types.Tuple3[[]byte, string, string]
+	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "get_Clients",
+		Procedure: "StartStream",
 		Service:   "KRPC",
 	}
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
+	argBytes, err = encode.Marshal(id)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	return vv, nil
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
 }
 
-// ClientsStream - a list of RPC clients that are currently connected to the
-// server. Each entry in the list is a clients identifier, name and address.
+// SetStreamRate - set the update rate for a stream in Hz.
+//
+// Allowed game scenes: any.
+func (s *KRPC) SetStreamRate(id uint64, rate float32) error {
+	return s.SetStreamRateContext(context.Background(), id, rate)
+}
+
+// SetStreamRateContext - set the update rate for a stream in Hz.
 //
 // Allowed game scenes: any.
-func (s *KRPC) ClientsStream() (*krpcgo.Stream[[]types.Tuple3[[]byte, string, string]], error) {
+func (s *KRPC) SetStreamRateContext(ctx context.Context, id uint64, rate float32) error {
 	var err error
+	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "get_Clients",
+		Procedure: "SetStreamRate",
 		Service:   "KRPC",
 	}
-	krpc := New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	argBytes, err = encode.Marshal(id)
 	if err != nil {
-		return nil, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) []types.Tuple3[[]byte, string, string] {
-		var value []types.Tuple3[[]byte, string, string]
-		encode.Unmarshal(b, &value)
-		return value
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
 	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	argBytes, err = encode.Marshal(rate)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
 	})
-	return stream, nil
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
 }
 
-// CurrentGameScene - get the current game scene.
+// SetStreamRateAsync - set the update rate for a stream in Hz.
 //
 // Allowed game scenes: any.
-func (s *KRPC) CurrentGameScene() (GameScene, error) {
+func (s *KRPC) SetStreamRateAsync(id uint64, rate float32) (*krpcgo.Future[struct{}], error) {
 	var err error
-	var vv GameScene
+	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "get_CurrentGameScene",
+		Procedure: "SetStreamRate",
 		Service:   "KRPC",
 	}
-	result, err := s.Client.Call(request)
+	argBytes, err = encode.Marshal(id)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(rate)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetStreamRateCall builds a call to SetStreamRate without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *KRPC) SetStreamRateCall(id uint64, rate float32) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "SetStreamRate",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(id)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(rate)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// RemoveStream - remove a streaming request.
+//
+// Allowed game scenes: any.
+func (s *KRPC) RemoveStream(id uint64) error {
+	return s.RemoveStreamContext(context.Background(), id)
+}
+
+// RemoveStreamContext - remove a streaming request.
+//
+// Allowed game scenes: any.
+func (s *KRPC) RemoveStreamContext(ctx context.Context, id uint64) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RemoveStream",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(id)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// RemoveStreamAsync - remove a streaming request.
+//
+// Allowed game scenes: any.
+func (s *KRPC) RemoveStreamAsync(id uint64) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RemoveStream",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(id)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// RemoveStreamCall builds a call to RemoveStream without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *KRPC) RemoveStreamCall(id uint64) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RemoveStream",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(id)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// AddEvent - create an event from a server side expression.
+//
+// Allowed game scenes: any.
+func (s *KRPC) AddEvent(expression *Expression) (*krpcgo.Event, error) {
+	return s.AddEventContext(context.Background(), expression)
+}
+
+// AddEventContext - create an event from a server side expression.
+//
+// Allowed game scenes: any.
+func (s *KRPC) AddEventContext(ctx context.Context, expression *Expression) (*krpcgo.Event, error) {
+	var err error
+	var argBytes []byte
+	var vv types.Event
+	request := &types.ProcedureCall{
+		Procedure: "AddEvent",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(expression)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	return krpcgo.NewEventContext(ctx, s.Client, &vv)
+}
+
+// AddEventAsync - create an event from a server side expression.
+//
+// Allowed game scenes: any.
+func (s *KRPC) AddEventAsync(expression *Expression) (*krpcgo.Future[*krpcgo.Event], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "AddEvent",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(expression)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*krpcgo.Event, error) {
+		var vv types.Event
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return nil, tracerr.Wrap(err)
+		}
+		return krpcgo.NewEvent(s.Client, &vv)
+	})
+	return future, nil
+}
+
+// AddEventCall builds a call to AddEvent without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *KRPC) AddEventCall(expression *Expression) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "AddEvent",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(expression)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeAddEvent decodes the result of a call built with AddEventCall.
+func (s *KRPC) DecodeAddEvent(result *types.ProcedureResult) (*krpcgo.Event, error) {
+	if err := krpcgo.ResultError(result); err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	var vv types.Event
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	return krpcgo.NewEvent(s.Client, &vv)
+}
+
+// Clients - a list of RPC clients that are currently connected to the server.
+// Each entry in the list is a clients identifier, name and address.
+//
+// Allowed game scenes: any.
+func (s *KRPC) Clients() ([]types.Tuple3[[]byte, string, string], error) {
+	return s.ClientsContext(context.Background())
+}
+
+// ClientsContext - a list of RPC clients that are currently connected to the
+// server. Each entry in the list is a clients identifier, name and address.
+//
+// Allowed game scenes: any.
+func (s *KRPC) ClientsContext(ctx context.Context) ([]types.Tuple3[[]byte, string, string], error) {
+	var err error
+	var vv []types.Tuple3[[]byte, string, string]
+	request := &types.ProcedureCall{
+		Procedure: "get_Clients",
+		Service:   "KRPC",
+	}
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// ClientsAsync - a list of RPC clients that are currently connected to the
+// server. Each entry in the list is a clients identifier, name and address.
+//
+// Allowed game scenes: any.
+func (s *KRPC) ClientsAsync() (*krpcgo.Future[[]types.Tuple3[[]byte, string, string]], error) {
+	request := &types.ProcedureCall{
+		Procedure: "get_Clients",
+		Service:   "KRPC",
+	}
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) ([]types.Tuple3[[]byte, string, string], error) {
+		var vv []types.Tuple3[[]byte, string, string]
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// ClientsStream - a list of RPC clients that are currently connected to the
+// server. Each entry in the list is a clients identifier, name and address.
+//
+// Allowed game scenes: any.
+func (s *KRPC) ClientsStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[[]types.Tuple3[[]byte, string, string]], error) {
+	var err error
+	request := &types.ProcedureCall{
+		Procedure: "get_Clients",
+		Service:   "KRPC",
+	}
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) ([]types.Tuple3[[]byte, string, string], error) {
+		var value []types.Tuple3[[]byte, string, string]
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// ClientsCall builds a call to Clients without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *KRPC) ClientsCall() (*types.ProcedureCall, error) {
+	request := &types.ProcedureCall{
+		Procedure: "get_Clients",
+		Service:   "KRPC",
+	}
+	return request, nil
+}
+
+// DecodeClients decodes the result of a call built with ClientsCall.
+func (s *KRPC) DecodeClients(result *types.ProcedureResult) ([]types.Tuple3[[]byte, string, string], error) {
+	var vv []types.Tuple3[[]byte, string, string]
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// CurrentGameScene - get the current game scene.
+//
+// Allowed game scenes: any.
+func (s *KRPC) CurrentGameScene() (GameScene, error) {
+	return s.CurrentGameSceneContext(context.Background())
+}
+
+// CurrentGameSceneContext - get the current game scene.
+//
+// Allowed game scenes: any.
+func (s *KRPC) CurrentGameSceneContext(ctx context.Context) (GameScene, error) {
+	var err error
+	var vv GameScene
+	request := &types.ProcedureCall{
+		Procedure: "get_CurrentGameScene",
+		Service:   "KRPC",
+	}
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// CurrentGameSceneAsync - get the current game scene.
+//
+// Allowed game scenes: any.
+func (s *KRPC) CurrentGameSceneAsync() (*krpcgo.Future[GameScene], error) {
+	request := &types.ProcedureCall{
+		Procedure: "get_CurrentGameScene",
+		Service:   "KRPC",
+	}
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (GameScene, error) {
+		var vv GameScene
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// CurrentGameSceneStream - get the current game scene.
+//
+// Allowed game scenes: any.
+func (s *KRPC) CurrentGameSceneStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[GameScene], error) {
+	var err error
+	request := &types.ProcedureCall{
+		Procedure: "get_CurrentGameScene",
+		Service:   "KRPC",
+	}
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (GameScene, error) {
+		var value GameScene
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// CurrentGameSceneCall builds a call to CurrentGameScene without making it,
+// such as to make several calls with CallMultiple, add a stream with AddStream
+// or use it in an expression.
+func (s *KRPC) CurrentGameSceneCall() (*types.ProcedureCall, error) {
+	request := &types.ProcedureCall{
+		Procedure: "get_CurrentGameScene",
+		Service:   "KRPC",
+	}
+	return request, nil
+}
+
+// DecodeCurrentGameScene decodes the result of a call built with
+// CurrentGameSceneCall.
+func (s *KRPC) DecodeCurrentGameScene(result *types.ProcedureResult) (GameScene, error) {
+	var vv GameScene
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// Paused - whether the game is paused.
+//
+// Allowed game scenes: any.
+func (s *KRPC) Paused() (bool, error) {
+	return s.PausedContext(context.Background())
+}
+
+// PausedContext - whether the game is paused.
+//
+// Allowed game scenes: any.
+func (s *KRPC) PausedContext(ctx context.Context) (bool, error) {
+	var err error
+	var vv bool
+	request := &types.ProcedureCall{
+		Procedure: "get_Paused",
+		Service:   "KRPC",
+	}
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// PausedAsync - whether the game is paused.
+//
+// Allowed game scenes: any.
+func (s *KRPC) PausedAsync() (*krpcgo.Future[bool], error) {
+	request := &types.ProcedureCall{
+		Procedure: "get_Paused",
+		Service:   "KRPC",
+	}
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (bool, error) {
+		var vv bool
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// PausedStream - whether the game is paused.
+//
+// Allowed game scenes: any.
+func (s *KRPC) PausedStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[bool], error) {
+	var err error
+	request := &types.ProcedureCall{
+		Procedure: "get_Paused",
+		Service:   "KRPC",
+	}
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (bool, error) {
+		var value bool
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// PausedCall builds a call to Paused without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *KRPC) PausedCall() (*types.ProcedureCall, error) {
+	request := &types.ProcedureCall{
+		Procedure: "get_Paused",
+		Service:   "KRPC",
+	}
+	return request, nil
+}
+
+// DecodePaused decodes the result of a call built with PausedCall.
+func (s *KRPC) DecodePaused(result *types.ProcedureResult) (bool, error) {
+	var vv bool
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetPaused - whether the game is paused.
+//
+// Allowed game scenes: any.
+func (s *KRPC) SetPaused(value bool) error {
+	return s.SetPausedContext(context.Background(), value)
+}
+
+// SetPausedContext - whether the game is paused.
+//
+// Allowed game scenes: any.
+func (s *KRPC) SetPausedContext(ctx context.Context, value bool) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "set_Paused",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetPausedAsync - whether the game is paused.
+//
+// Allowed game scenes: any.
+func (s *KRPC) SetPausedAsync(value bool) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "set_Paused",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetPausedCall builds a call to SetPaused without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *KRPC) SetPausedCall(value bool) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "set_Paused",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// ConstantDouble - a constant value of double precision floating point type.
+//
+// Allowed game scenes: any.
+func (s *Expression) ConstantDouble() (*Expression, error) {
+	return s.ConstantDoubleContext(context.Background())
+}
+
+// ConstantDoubleContext - a constant value of double precision floating point
+// type.
+//
+// Allowed game scenes: any.
+func (s *Expression) ConstantDoubleContext(ctx context.Context) (*Expression, error) {
+	var err error
+	var argBytes []byte
+	var vv Expression
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_ConstantDouble",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// ConstantDoubleAsync - a constant value of double precision floating point
+// type.
+//
+// Allowed game scenes: any.
+func (s *Expression) ConstantDoubleAsync() (*krpcgo.Future[*Expression], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_ConstantDouble",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// ConstantDoubleCall builds a call to ConstantDouble without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *Expression) ConstantDoubleCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_ConstantDouble",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeConstantDouble decodes the result of a call built with
+// ConstantDoubleCall.
+func (s *Expression) DecodeConstantDouble(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// ConstantFloat - a constant value of single precision floating point type.
+//
+// Allowed game scenes: any.
+func (s *Expression) ConstantFloat() (*Expression, error) {
+	return s.ConstantFloatContext(context.Background())
+}
+
+// ConstantFloatContext - a constant value of single precision floating point
+// type.
+//
+// Allowed game scenes: any.
+func (s *Expression) ConstantFloatContext(ctx context.Context) (*Expression, error) {
+	var err error
+	var argBytes []byte
+	var vv Expression
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_ConstantFloat",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// ConstantFloatAsync - a constant value of single precision floating point
+// type.
+//
+// Allowed game scenes: any.
+func (s *Expression) ConstantFloatAsync() (*krpcgo.Future[*Expression], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_ConstantFloat",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// ConstantFloatCall builds a call to ConstantFloat without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *Expression) ConstantFloatCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_ConstantFloat",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeConstantFloat decodes the result of a call built with
+// ConstantFloatCall.
+func (s *Expression) DecodeConstantFloat(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// ConstantInt - a constant value of integer type.
+//
+// Allowed game scenes: any.
+func (s *Expression) ConstantInt() (*Expression, error) {
+	return s.ConstantIntContext(context.Background())
+}
+
+// ConstantIntContext - a constant value of integer type.
+//
+// Allowed game scenes: any.
+func (s *Expression) ConstantIntContext(ctx context.Context) (*Expression, error) {
+	var err error
+	var argBytes []byte
+	var vv Expression
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_ConstantInt",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// ConstantIntAsync - a constant value of integer type.
+//
+// Allowed game scenes: any.
+func (s *Expression) ConstantIntAsync() (*krpcgo.Future[*Expression], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_ConstantInt",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// ConstantIntCall builds a call to ConstantInt without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *Expression) ConstantIntCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_ConstantInt",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeConstantInt decodes the result of a call built with ConstantIntCall.
+func (s *Expression) DecodeConstantInt(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// ConstantBool - a constant value of boolean type.
+//
+// Allowed game scenes: any.
+func (s *Expression) ConstantBool() (*Expression, error) {
+	return s.ConstantBoolContext(context.Background())
+}
+
+// ConstantBoolContext - a constant value of boolean type.
+//
+// Allowed game scenes: any.
+func (s *Expression) ConstantBoolContext(ctx context.Context) (*Expression, error) {
+	var err error
+	var argBytes []byte
+	var vv Expression
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_ConstantBool",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// ConstantBoolAsync - a constant value of boolean type.
+//
+// Allowed game scenes: any.
+func (s *Expression) ConstantBoolAsync() (*krpcgo.Future[*Expression], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_ConstantBool",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// ConstantBoolCall builds a call to ConstantBool without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *Expression) ConstantBoolCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_ConstantBool",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeConstantBool decodes the result of a call built with ConstantBoolCall.
+func (s *Expression) DecodeConstantBool(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// ConstantString - a constant value of string type.
+//
+// Allowed game scenes: any.
+func (s *Expression) ConstantString() (*Expression, error) {
+	return s.ConstantStringContext(context.Background())
+}
+
+// ConstantStringContext - a constant value of string type.
+//
+// Allowed game scenes: any.
+func (s *Expression) ConstantStringContext(ctx context.Context) (*Expression, error) {
+	var err error
+	var argBytes []byte
+	var vv Expression
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_ConstantString",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// ConstantStringAsync - a constant value of string type.
+//
+// Allowed game scenes: any.
+func (s *Expression) ConstantStringAsync() (*krpcgo.Future[*Expression], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_ConstantString",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// ConstantStringCall builds a call to ConstantString without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *Expression) ConstantStringCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_ConstantString",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeConstantString decodes the result of a call built with
+// ConstantStringCall.
+func (s *Expression) DecodeConstantString(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// Call - an RPC call.
+//
+// Allowed game scenes: any.
+func (s *Expression) Call() (*Expression, error) {
+	return s.CallContext(context.Background())
+}
+
+// CallContext - an RPC call.
+//
+// Allowed game scenes: any.
+func (s *Expression) CallContext(ctx context.Context) (*Expression, error) {
+	var err error
+	var argBytes []byte
+	var vv Expression
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Call",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// CallAsync - an RPC call.
+//
+// Allowed game scenes: any.
+func (s *Expression) CallAsync() (*krpcgo.Future[*Expression], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Call",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// CallCall builds a call to Call without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Expression) CallCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Call",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeCall decodes the result of a call built with CallCall.
+func (s *Expression) DecodeCall(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// Equal - equality comparison.
+//
+// Allowed game scenes: any.
+func (s *Expression) Equal(arg1 *Expression) (*Expression, error) {
+	return s.EqualContext(context.Background(), arg1)
+}
+
+// EqualContext - equality comparison.
+//
+// Allowed game scenes: any.
+func (s *Expression) EqualContext(ctx context.Context, arg1 *Expression) (*Expression, error) {
+	var err error
+	var argBytes []byte
+	var vv Expression
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Equal",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// EqualAsync - equality comparison.
+//
+// Allowed game scenes: any.
+func (s *Expression) EqualAsync(arg1 *Expression) (*krpcgo.Future[*Expression], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Equal",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// EqualCall builds a call to Equal without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Expression) EqualCall(arg1 *Expression) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Equal",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeEqual decodes the result of a call built with EqualCall.
+func (s *Expression) DecodeEqual(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// NotEqual - inequality comparison.
+//
+// Allowed game scenes: any.
+func (s *Expression) NotEqual(arg1 *Expression) (*Expression, error) {
+	return s.NotEqualContext(context.Background(), arg1)
+}
+
+// NotEqualContext - inequality comparison.
+//
+// Allowed game scenes: any.
+func (s *Expression) NotEqualContext(ctx context.Context, arg1 *Expression) (*Expression, error) {
+	var err error
+	var argBytes []byte
+	var vv Expression
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_NotEqual",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// NotEqualAsync - inequality comparison.
+//
+// Allowed game scenes: any.
+func (s *Expression) NotEqualAsync(arg1 *Expression) (*krpcgo.Future[*Expression], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_NotEqual",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// NotEqualCall builds a call to NotEqual without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Expression) NotEqualCall(arg1 *Expression) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_NotEqual",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeNotEqual decodes the result of a call built with NotEqualCall.
+func (s *Expression) DecodeNotEqual(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// GreaterThan - greater than numerical comparison.
+//
+// Allowed game scenes: any.
+func (s *Expression) GreaterThan(arg1 *Expression) (*Expression, error) {
+	return s.GreaterThanContext(context.Background(), arg1)
+}
+
+// GreaterThanContext - greater than numerical comparison.
+//
+// Allowed game scenes: any.
+func (s *Expression) GreaterThanContext(ctx context.Context, arg1 *Expression) (*Expression, error) {
+	var err error
+	var argBytes []byte
+	var vv Expression
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_GreaterThan",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// GreaterThanAsync - greater than numerical comparison.
+//
+// Allowed game scenes: any.
+func (s *Expression) GreaterThanAsync(arg1 *Expression) (*krpcgo.Future[*Expression], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_GreaterThan",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// GreaterThanCall builds a call to GreaterThan without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *Expression) GreaterThanCall(arg1 *Expression) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_GreaterThan",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeGreaterThan decodes the result of a call built with GreaterThanCall.
+func (s *Expression) DecodeGreaterThan(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// GreaterThanOrEqual - greater than or equal numerical comparison.
+//
+// Allowed game scenes: any.
+func (s *Expression) GreaterThanOrEqual(arg1 *Expression) (*Expression, error) {
+	return s.GreaterThanOrEqualContext(context.Background(), arg1)
+}
+
+// GreaterThanOrEqualContext - greater than or equal numerical comparison.
+//
+// Allowed game scenes: any.
+func (s *Expression) GreaterThanOrEqualContext(ctx context.Context, arg1 *Expression) (*Expression, error) {
+	var err error
+	var argBytes []byte
+	var vv Expression
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_GreaterThanOrEqual",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// GreaterThanOrEqualAsync - greater than or equal numerical comparison.
+//
+// Allowed game scenes: any.
+func (s *Expression) GreaterThanOrEqualAsync(arg1 *Expression) (*krpcgo.Future[*Expression], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_GreaterThanOrEqual",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// GreaterThanOrEqualCall builds a call to GreaterThanOrEqual without making it,
+// such as to make several calls with CallMultiple, add a stream with AddStream
+// or use it in an expression.
+func (s *Expression) GreaterThanOrEqualCall(arg1 *Expression) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_GreaterThanOrEqual",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeGreaterThanOrEqual decodes the result of a call built with
+// GreaterThanOrEqualCall.
+func (s *Expression) DecodeGreaterThanOrEqual(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// LessThan - less than numerical comparison.
+//
+// Allowed game scenes: any.
+func (s *Expression) LessThan(arg1 *Expression) (*Expression, error) {
+	return s.LessThanContext(context.Background(), arg1)
+}
+
+// LessThanContext - less than numerical comparison.
+//
+// Allowed game scenes: any.
+func (s *Expression) LessThanContext(ctx context.Context, arg1 *Expression) (*Expression, error) {
+	var err error
+	var argBytes []byte
+	var vv Expression
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_LessThan",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// LessThanAsync - less than numerical comparison.
+//
+// Allowed game scenes: any.
+func (s *Expression) LessThanAsync(arg1 *Expression) (*krpcgo.Future[*Expression], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_LessThan",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// LessThanCall builds a call to LessThan without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Expression) LessThanCall(arg1 *Expression) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_LessThan",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeLessThan decodes the result of a call built with LessThanCall.
+func (s *Expression) DecodeLessThan(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// LessThanOrEqual - less than or equal numerical comparison.
+//
+// Allowed game scenes: any.
+func (s *Expression) LessThanOrEqual(arg1 *Expression) (*Expression, error) {
+	return s.LessThanOrEqualContext(context.Background(), arg1)
+}
+
+// LessThanOrEqualContext - less than or equal numerical comparison.
+//
+// Allowed game scenes: any.
+func (s *Expression) LessThanOrEqualContext(ctx context.Context, arg1 *Expression) (*Expression, error) {
+	var err error
+	var argBytes []byte
+	var vv Expression
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_LessThanOrEqual",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// LessThanOrEqualAsync - less than or equal numerical comparison.
+//
+// Allowed game scenes: any.
+func (s *Expression) LessThanOrEqualAsync(arg1 *Expression) (*krpcgo.Future[*Expression], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_LessThanOrEqual",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// LessThanOrEqualCall builds a call to LessThanOrEqual without making it, such
+// as to make several calls with CallMultiple, add a stream with AddStream or
+// use it in an expression.
+func (s *Expression) LessThanOrEqualCall(arg1 *Expression) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_LessThanOrEqual",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeLessThanOrEqual decodes the result of a call built with
+// LessThanOrEqualCall.
+func (s *Expression) DecodeLessThanOrEqual(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// And - boolean and operator.
+//
+// Allowed game scenes: any.
+func (s *Expression) And(arg1 *Expression) (*Expression, error) {
+	return s.AndContext(context.Background(), arg1)
+}
+
+// AndContext - boolean and operator.
+//
+// Allowed game scenes: any.
+func (s *Expression) AndContext(ctx context.Context, arg1 *Expression) (*Expression, error) {
+	var err error
+	var argBytes []byte
+	var vv Expression
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_And",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// AndAsync - boolean and operator.
+//
+// Allowed game scenes: any.
+func (s *Expression) AndAsync(arg1 *Expression) (*krpcgo.Future[*Expression], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_And",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// AndCall builds a call to And without making it, such as to make several calls
+// with CallMultiple, add a stream with AddStream or use it in an expression.
+func (s *Expression) AndCall(arg1 *Expression) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_And",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeAnd decodes the result of a call built with AndCall.
+func (s *Expression) DecodeAnd(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// Or - boolean or operator.
+//
+// Allowed game scenes: any.
+func (s *Expression) Or(arg1 *Expression) (*Expression, error) {
+	return s.OrContext(context.Background(), arg1)
+}
+
+// OrContext - boolean or operator.
+//
+// Allowed game scenes: any.
+func (s *Expression) OrContext(ctx context.Context, arg1 *Expression) (*Expression, error) {
+	var err error
+	var argBytes []byte
+	var vv Expression
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Or",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// OrAsync - boolean or operator.
+//
+// Allowed game scenes: any.
+func (s *Expression) OrAsync(arg1 *Expression) (*krpcgo.Future[*Expression], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Or",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// OrCall builds a call to Or without making it, such as to make several calls
+// with CallMultiple, add a stream with AddStream or use it in an expression.
+func (s *Expression) OrCall(arg1 *Expression) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Or",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeOr decodes the result of a call built with OrCall.
+func (s *Expression) DecodeOr(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// ExclusiveOr - boolean exclusive-or operator.
+//
+// Allowed game scenes: any.
+func (s *Expression) ExclusiveOr(arg1 *Expression) (*Expression, error) {
+	return s.ExclusiveOrContext(context.Background(), arg1)
+}
+
+// ExclusiveOrContext - boolean exclusive-or operator.
+//
+// Allowed game scenes: any.
+func (s *Expression) ExclusiveOrContext(ctx context.Context, arg1 *Expression) (*Expression, error) {
+	var err error
+	var argBytes []byte
+	var vv Expression
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_ExclusiveOr",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// ExclusiveOrAsync - boolean exclusive-or operator.
+//
+// Allowed game scenes: any.
+func (s *Expression) ExclusiveOrAsync(arg1 *Expression) (*krpcgo.Future[*Expression], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_ExclusiveOr",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// ExclusiveOrCall builds a call to ExclusiveOr without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *Expression) ExclusiveOrCall(arg1 *Expression) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_ExclusiveOr",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeExclusiveOr decodes the result of a call built with ExclusiveOrCall.
+func (s *Expression) DecodeExclusiveOr(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// Not - boolean negation operator.
+//
+// Allowed game scenes: any.
+func (s *Expression) Not() (*Expression, error) {
+	return s.NotContext(context.Background())
+}
+
+// NotContext - boolean negation operator.
+//
+// Allowed game scenes: any.
+func (s *Expression) NotContext(ctx context.Context) (*Expression, error) {
+	var err error
+	var argBytes []byte
+	var vv Expression
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Not",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// NotAsync - boolean negation operator.
+//
+// Allowed game scenes: any.
+func (s *Expression) NotAsync() (*krpcgo.Future[*Expression], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Not",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// NotCall builds a call to Not without making it, such as to make several calls
+// with CallMultiple, add a stream with AddStream or use it in an expression.
+func (s *Expression) NotCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Not",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeNot decodes the result of a call built with NotCall.
+func (s *Expression) DecodeNot(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// Add - numerical addition.
+//
+// Allowed game scenes: any.
+func (s *Expression) Add(arg1 *Expression) (*Expression, error) {
+	return s.AddContext(context.Background(), arg1)
+}
+
+// AddContext - numerical addition.
+//
+// Allowed game scenes: any.
+func (s *Expression) AddContext(ctx context.Context, arg1 *Expression) (*Expression, error) {
+	var err error
+	var argBytes []byte
+	var vv Expression
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Add",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// AddAsync - numerical addition.
+//
+// Allowed game scenes: any.
+func (s *Expression) AddAsync(arg1 *Expression) (*krpcgo.Future[*Expression], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Add",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// AddCall builds a call to Add without making it, such as to make several calls
+// with CallMultiple, add a stream with AddStream or use it in an expression.
+func (s *Expression) AddCall(arg1 *Expression) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Add",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeAdd decodes the result of a call built with AddCall.
+func (s *Expression) DecodeAdd(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// Subtract - numerical subtraction.
+//
+// Allowed game scenes: any.
+func (s *Expression) Subtract(arg1 *Expression) (*Expression, error) {
+	return s.SubtractContext(context.Background(), arg1)
+}
+
+// SubtractContext - numerical subtraction.
+//
+// Allowed game scenes: any.
+func (s *Expression) SubtractContext(ctx context.Context, arg1 *Expression) (*Expression, error) {
+	var err error
+	var argBytes []byte
+	var vv Expression
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Subtract",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// SubtractAsync - numerical subtraction.
+//
+// Allowed game scenes: any.
+func (s *Expression) SubtractAsync(arg1 *Expression) (*krpcgo.Future[*Expression], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Subtract",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// SubtractCall builds a call to Subtract without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Expression) SubtractCall(arg1 *Expression) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Subtract",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeSubtract decodes the result of a call built with SubtractCall.
+func (s *Expression) DecodeSubtract(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// Multiply - numerical multiplication.
+//
+// Allowed game scenes: any.
+func (s *Expression) Multiply(arg1 *Expression) (*Expression, error) {
+	return s.MultiplyContext(context.Background(), arg1)
+}
+
+// MultiplyContext - numerical multiplication.
+//
+// Allowed game scenes: any.
+func (s *Expression) MultiplyContext(ctx context.Context, arg1 *Expression) (*Expression, error) {
+	var err error
+	var argBytes []byte
+	var vv Expression
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Multiply",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// MultiplyAsync - numerical multiplication.
+//
+// Allowed game scenes: any.
+func (s *Expression) MultiplyAsync(arg1 *Expression) (*krpcgo.Future[*Expression], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Multiply",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// MultiplyCall builds a call to Multiply without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Expression) MultiplyCall(arg1 *Expression) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Multiply",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeMultiply decodes the result of a call built with MultiplyCall.
+func (s *Expression) DecodeMultiply(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// Divide - numerical division.
+//
+// Allowed game scenes: any.
+func (s *Expression) Divide(arg1 *Expression) (*Expression, error) {
+	return s.DivideContext(context.Background(), arg1)
+}
+
+// DivideContext - numerical division.
+//
+// Allowed game scenes: any.
+func (s *Expression) DivideContext(ctx context.Context, arg1 *Expression) (*Expression, error) {
+	var err error
+	var argBytes []byte
+	var vv Expression
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Divide",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// DivideAsync - numerical division.
+//
+// Allowed game scenes: any.
+func (s *Expression) DivideAsync(arg1 *Expression) (*krpcgo.Future[*Expression], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Divide",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// DivideCall builds a call to Divide without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Expression) DivideCall(arg1 *Expression) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Divide",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeDivide decodes the result of a call built with DivideCall.
+func (s *Expression) DecodeDivide(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// Modulo - numerical modulo operator.
+//
+// Allowed game scenes: any.
+func (s *Expression) Modulo(arg1 *Expression) (*Expression, error) {
+	return s.ModuloContext(context.Background(), arg1)
+}
+
+// ModuloContext - numerical modulo operator.
+//
+// Allowed game scenes: any.
+func (s *Expression) ModuloContext(ctx context.Context, arg1 *Expression) (*Expression, error) {
+	var err error
+	var argBytes []byte
+	var vv Expression
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Modulo",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// ModuloAsync - numerical modulo operator.
+//
+// Allowed game scenes: any.
+func (s *Expression) ModuloAsync(arg1 *Expression) (*krpcgo.Future[*Expression], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Modulo",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// ModuloCall builds a call to Modulo without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Expression) ModuloCall(arg1 *Expression) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Modulo",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeModulo decodes the result of a call built with ModuloCall.
+func (s *Expression) DecodeModulo(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// Power - numerical power operator.
+//
+// Allowed game scenes: any.
+func (s *Expression) Power(arg1 *Expression) (*Expression, error) {
+	return s.PowerContext(context.Background(), arg1)
+}
+
+// PowerContext - numerical power operator.
+//
+// Allowed game scenes: any.
+func (s *Expression) PowerContext(ctx context.Context, arg1 *Expression) (*Expression, error) {
+	var err error
+	var argBytes []byte
+	var vv Expression
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Power",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// PowerAsync - numerical power operator.
+//
+// Allowed game scenes: any.
+func (s *Expression) PowerAsync(arg1 *Expression) (*krpcgo.Future[*Expression], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Power",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// PowerCall builds a call to Power without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Expression) PowerCall(arg1 *Expression) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Power",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodePower decodes the result of a call built with PowerCall.
+func (s *Expression) DecodePower(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// LeftShift - bitwise left shift.
+//
+// Allowed game scenes: any.
+func (s *Expression) LeftShift(arg1 *Expression) (*Expression, error) {
+	return s.LeftShiftContext(context.Background(), arg1)
+}
+
+// LeftShiftContext - bitwise left shift.
+//
+// Allowed game scenes: any.
+func (s *Expression) LeftShiftContext(ctx context.Context, arg1 *Expression) (*Expression, error) {
+	var err error
+	var argBytes []byte
+	var vv Expression
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_LeftShift",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// LeftShiftAsync - bitwise left shift.
+//
+// Allowed game scenes: any.
+func (s *Expression) LeftShiftAsync(arg1 *Expression) (*krpcgo.Future[*Expression], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_LeftShift",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// LeftShiftCall builds a call to LeftShift without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Expression) LeftShiftCall(arg1 *Expression) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_LeftShift",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeLeftShift decodes the result of a call built with LeftShiftCall.
+func (s *Expression) DecodeLeftShift(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// RightShift - bitwise right shift.
+//
+// Allowed game scenes: any.
+func (s *Expression) RightShift(arg1 *Expression) (*Expression, error) {
+	return s.RightShiftContext(context.Background(), arg1)
+}
+
+// RightShiftContext - bitwise right shift.
+//
+// Allowed game scenes: any.
+func (s *Expression) RightShiftContext(ctx context.Context, arg1 *Expression) (*Expression, error) {
+	var err error
+	var argBytes []byte
+	var vv Expression
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_RightShift",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// RightShiftAsync - bitwise right shift.
+//
+// Allowed game scenes: any.
+func (s *Expression) RightShiftAsync(arg1 *Expression) (*krpcgo.Future[*Expression], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_RightShift",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// RightShiftCall builds a call to RightShift without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Expression) RightShiftCall(arg1 *Expression) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_RightShift",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(arg1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeRightShift decodes the result of a call built with RightShiftCall.
+func (s *Expression) DecodeRightShift(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// Cast - perform a cast to the given type.
+//
+// Allowed game scenes: any.
+func (s *Expression) Cast(t *Type) (*Expression, error) {
+	return s.CastContext(context.Background(), t)
+}
+
+// CastContext - perform a cast to the given type.
+//
+// Allowed game scenes: any.
+func (s *Expression) CastContext(ctx context.Context, t *Type) (*Expression, error) {
+	var err error
+	var argBytes []byte
+	var vv Expression
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Cast",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(t)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// CastAsync - perform a cast to the given type.
+//
+// Allowed game scenes: any.
+func (s *Expression) CastAsync(t *Type) (*krpcgo.Future[*Expression], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Cast",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(t)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// CastCall builds a call to Cast without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Expression) CastCall(t *Type) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Cast",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(t)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeCast decodes the result of a call built with CastCall.
+func (s *Expression) DecodeCast(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// Parameter - a named parameter of type double.
+//
+// Allowed game scenes: any.
+func (s *Expression) Parameter(t *Type) (*Expression, error) {
+	return s.ParameterContext(context.Background(), t)
+}
+
+// ParameterContext - a named parameter of type double.
+//
+// Allowed game scenes: any.
+func (s *Expression) ParameterContext(ctx context.Context, t *Type) (*Expression, error) {
+	var err error
+	var argBytes []byte
+	var vv Expression
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Parameter",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(t)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// ParameterAsync - a named parameter of type double.
+//
+// Allowed game scenes: any.
+func (s *Expression) ParameterAsync(t *Type) (*krpcgo.Future[*Expression], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Parameter",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(t)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// ParameterCall builds a call to Parameter without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Expression) ParameterCall(t *Type) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Parameter",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(t)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeParameter decodes the result of a call built with ParameterCall.
+func (s *Expression) DecodeParameter(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// Function - a function.
+//
+// Allowed game scenes: any.
+func (s *Expression) Function(body *Expression) (*Expression, error) {
+	return s.FunctionContext(context.Background(), body)
+}
+
+// FunctionContext - a function.
+//
+// Allowed game scenes: any.
+func (s *Expression) FunctionContext(ctx context.Context, body *Expression) (*Expression, error) {
+	var err error
+	var argBytes []byte
+	var vv Expression
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Function",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(body)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// FunctionAsync - a function.
+//
+// Allowed game scenes: any.
+func (s *Expression) FunctionAsync(body *Expression) (*krpcgo.Future[*Expression], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Function",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(body)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// FunctionCall builds a call to Function without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Expression) FunctionCall(body *Expression) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Function",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(body)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeFunction decodes the result of a call built with FunctionCall.
+func (s *Expression) DecodeFunction(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// Invoke - a function call.
+//
+// Allowed game scenes: any.
+func (s *Expression) Invoke(args map[string]*Expression) (*Expression, error) {
+	return s.InvokeContext(context.Background(), args)
+}
+
+// InvokeContext - a function call.
+//
+// Allowed game scenes: any.
+func (s *Expression) InvokeContext(ctx context.Context, args map[string]*Expression) (*Expression, error) {
+	var err error
+	var argBytes []byte
+	var vv Expression
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Invoke",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(args)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// InvokeAsync - a function call.
+//
+// Allowed game scenes: any.
+func (s *Expression) InvokeAsync(args map[string]*Expression) (*krpcgo.Future[*Expression], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Invoke",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(args)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// InvokeCall builds a call to Invoke without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Expression) InvokeCall(args map[string]*Expression) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Invoke",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(args)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeInvoke decodes the result of a call built with InvokeCall.
+func (s *Expression) DecodeInvoke(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// CreateTuple - construct a tuple.
+//
+// Allowed game scenes: any.
+func (s *Expression) CreateTuple() (*Expression, error) {
+	return s.CreateTupleContext(context.Background())
+}
+
+// CreateTupleContext - construct a tuple.
+//
+// Allowed game scenes: any.
+func (s *Expression) CreateTupleContext(ctx context.Context) (*Expression, error) {
+	var err error
+	var argBytes []byte
+	var vv Expression
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_CreateTuple",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
 	}
 	err = encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return &vv, tracerr.Wrap(err)
 	}
-	return vv, nil
+	vv.Client = s.Client
+	return &vv, nil
 }
 
-// CurrentGameSceneStream - get the current game scene.
+// CreateTupleAsync - construct a tuple.
 //
 // Allowed game scenes: any.
-func (s *KRPC) CurrentGameSceneStream() (*krpcgo.Stream[GameScene], error) {
+func (s *Expression) CreateTupleAsync() (*krpcgo.Future[*Expression], error) {
 	var err error
+	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "get_CurrentGameScene",
+		Procedure: "Expression_static_CreateTuple",
 		Service:   "KRPC",
 	}
-	krpc := New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	argBytes, err = encode.Marshal(s)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) GameScene {
-		var value GameScene
-		encode.Unmarshal(b, &value)
-		return value
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
 	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// Paused - whether the game is paused.
+// CreateTupleCall builds a call to CreateTuple without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *Expression) CreateTupleCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_CreateTuple",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeCreateTuple decodes the result of a call built with CreateTupleCall.
+func (s *Expression) DecodeCreateTuple(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// CreateList - construct a list.
 //
 // Allowed game scenes: any.
-func (s *KRPC) Paused() (bool, error) {
+func (s *Expression) CreateList() (*Expression, error) {
+	return s.CreateListContext(context.Background())
+}
+
+// CreateListContext - construct a list.
+//
+// Allowed game scenes: any.
+func (s *Expression) CreateListContext(ctx context.Context) (*Expression, error) {
 	var err error
-	var vv bool
+	var argBytes []byte
+	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "get_Paused",
+		Procedure: "Expression_static_CreateList",
 		Service:   "KRPC",
 	}
-	result, err := s.Client.Call(request)
+	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
 	}
 	err = encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return &vv, tracerr.Wrap(err)
 	}
-	return vv, nil
+	vv.Client = s.Client
+	return &vv, nil
 }
 
-// PausedStream - whether the game is paused.
+// CreateListAsync - construct a list.
 //
 // Allowed game scenes: any.
-func (s *KRPC) PausedStream() (*krpcgo.Stream[bool], error) {
+func (s *Expression) CreateListAsync() (*krpcgo.Future[*Expression], error) {
 	var err error
+	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "get_Paused",
+		Procedure: "Expression_static_CreateList",
 		Service:   "KRPC",
 	}
-	krpc := New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	argBytes, err = encode.Marshal(s)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) bool {
-		var value bool
-		encode.Unmarshal(b, &value)
-		return value
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
 	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetPaused - whether the game is paused.
-//
-// Allowed game scenes: any.
-func (s *KRPC) SetPaused(value bool) error {
+// CreateListCall builds a call to CreateList without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Expression) CreateListCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "set_Paused",
+		Procedure: "Expression_static_CreateList",
 		Service:   "KRPC",
 	}
-	argBytes, err = encode.Marshal(value)
+	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	return request, nil
+}
+
+// DecodeCreateList decodes the result of a call built with CreateListCall.
+func (s *Expression) DecodeCreateList(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return &vv, tracerr.Wrap(err)
 	}
-	return nil
+	vv.Client = s.Client
+	return &vv, nil
 }
 
-// ConstantDouble - a constant value of double precision floating point type.
+// CreateSet - construct a set.
 //
 // Allowed game scenes: any.
-func (s *Expression) ConstantDouble() (*Expression, error) {
+func (s *Expression) CreateSet() (*Expression, error) {
+	return s.CreateSetContext(context.Background())
+}
+
+// CreateSetContext - construct a set.
+//
+// Allowed game scenes: any.
+func (s *Expression) CreateSetContext(ctx context.Context) (*Expression, error) {
 	var err error
 	var argBytes []byte
 	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_ConstantDouble",
+		Procedure: "Expression_static_CreateSet",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -609,7 +4962,7 @@ func (s *Expression) ConstantDouble() (*Expression, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -621,30 +4974,64 @@ func (s *Expression) ConstantDouble() (*Expression, error) {
 	return &vv, nil
 }
 
-// ConstantFloat - a constant value of single precision floating point type.
+// CreateSetAsync - construct a set.
 //
 // Allowed game scenes: any.
-func (s *Expression) ConstantFloat() (*Expression, error) {
+func (s *Expression) CreateSetAsync() (*krpcgo.Future[*Expression], error) {
 	var err error
 	var argBytes []byte
-	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_ConstantFloat",
+		Procedure: "Expression_static_CreateSet",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// CreateSetCall builds a call to CreateSet without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Expression) CreateSetCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_CreateSet",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
 	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeCreateSet decodes the result of a call built with CreateSetCall.
+func (s *Expression) DecodeCreateSet(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -652,15 +5039,24 @@ func (s *Expression) ConstantFloat() (*Expression, error) {
 	return &vv, nil
 }
 
-// ConstantInt - a constant value of integer type.
+// CreateDictionary - construct a dictionary, from a list of corresponding keys
+// and values.
 //
 // Allowed game scenes: any.
-func (s *Expression) ConstantInt() (*Expression, error) {
+func (s *Expression) CreateDictionary(values []*Expression) (*Expression, error) {
+	return s.CreateDictionaryContext(context.Background(), values)
+}
+
+// CreateDictionaryContext - construct a dictionary, from a list of
+// corresponding keys and values.
+//
+// Allowed game scenes: any.
+func (s *Expression) CreateDictionaryContext(ctx context.Context, values []*Expression) (*Expression, error) {
 	var err error
 	var argBytes []byte
 	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_ConstantInt",
+		Procedure: "Expression_static_CreateDictionary",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -671,7 +5067,15 @@ func (s *Expression) ConstantInt() (*Expression, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	argBytes, err = encode.Marshal(values)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -683,15 +5087,105 @@ func (s *Expression) ConstantInt() (*Expression, error) {
 	return &vv, nil
 }
 
-// ConstantBool - a constant value of boolean type.
+// CreateDictionaryAsync - construct a dictionary, from a list of corresponding
+// keys and values.
 //
 // Allowed game scenes: any.
-func (s *Expression) ConstantBool() (*Expression, error) {
+func (s *Expression) CreateDictionaryAsync(values []*Expression) (*krpcgo.Future[*Expression], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_CreateDictionary",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(values)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// CreateDictionaryCall builds a call to CreateDictionary without making it,
+// such as to make several calls with CallMultiple, add a stream with AddStream
+// or use it in an expression.
+func (s *Expression) CreateDictionaryCall(values []*Expression) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_CreateDictionary",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(values)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeCreateDictionary decodes the result of a call built with
+// CreateDictionaryCall.
+func (s *Expression) DecodeCreateDictionary(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// ToList - convert a collection to a list.
+//
+// Allowed game scenes: any.
+func (s *Expression) ToList() (*Expression, error) {
+	return s.ToListContext(context.Background())
+}
+
+// ToListContext - convert a collection to a list.
+//
+// Allowed game scenes: any.
+func (s *Expression) ToListContext(ctx context.Context) (*Expression, error) {
 	var err error
 	var argBytes []byte
 	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_ConstantBool",
+		Procedure: "Expression_static_ToList",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -702,7 +5196,7 @@ func (s *Expression) ConstantBool() (*Expression, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -714,61 +5208,64 @@ func (s *Expression) ConstantBool() (*Expression, error) {
 	return &vv, nil
 }
 
-// ConstantString - a constant value of string type.
+// ToListAsync - convert a collection to a list.
 //
 // Allowed game scenes: any.
-func (s *Expression) ConstantString() (*Expression, error) {
+func (s *Expression) ToListAsync() (*krpcgo.Future[*Expression], error) {
 	var err error
 	var argBytes []byte
-	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_ConstantString",
+		Procedure: "Expression_static_ToList",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	vv.Client = s.Client
-	return &vv, nil
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
 }
 
-// Call - an RPC call.
-//
-// Allowed game scenes: any.
-func (s *Expression) Call() (*Expression, error) {
+// ToListCall builds a call to ToList without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Expression) ToListCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_Call",
+		Procedure: "Expression_static_ToList",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
+	return request, nil
+}
+
+// DecodeToList decodes the result of a call built with ToListCall.
+func (s *Expression) DecodeToList(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -776,15 +5273,22 @@ func (s *Expression) Call() (*Expression, error) {
 	return &vv, nil
 }
 
-// Equal - equality comparison.
+// ToSet - convert a collection to a set.
 //
 // Allowed game scenes: any.
-func (s *Expression) Equal(arg1 *Expression) (*Expression, error) {
+func (s *Expression) ToSet() (*Expression, error) {
+	return s.ToSetContext(context.Background())
+}
+
+// ToSetContext - convert a collection to a set.
+//
+// Allowed game scenes: any.
+func (s *Expression) ToSetContext(ctx context.Context) (*Expression, error) {
 	var err error
 	var argBytes []byte
 	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_Equal",
+		Procedure: "Expression_static_ToSet",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -795,15 +5299,7 @@ func (s *Expression) Equal(arg1 *Expression) (*Expression, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(arg1)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
-	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -815,38 +5311,64 @@ func (s *Expression) Equal(arg1 *Expression) (*Expression, error) {
 	return &vv, nil
 }
 
-// NotEqual - inequality comparison.
+// ToSetAsync - convert a collection to a set.
 //
 // Allowed game scenes: any.
-func (s *Expression) NotEqual(arg1 *Expression) (*Expression, error) {
+func (s *Expression) ToSetAsync() (*krpcgo.Future[*Expression], error) {
 	var err error
 	var argBytes []byte
-	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_NotEqual",
+		Procedure: "Expression_static_ToSet",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(arg1)
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// ToSetCall builds a call to ToSet without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Expression) ToSetCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_ToSet",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
+		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
+	return request, nil
+}
+
+// DecodeToSet decodes the result of a call built with ToSetCall.
+func (s *Expression) DecodeToSet(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -854,15 +5376,22 @@ func (s *Expression) NotEqual(arg1 *Expression) (*Expression, error) {
 	return &vv, nil
 }
 
-// GreaterThan - greater than numerical comparison.
+// Get - access an element in a tuple, list or dictionary.
 //
 // Allowed game scenes: any.
-func (s *Expression) GreaterThan(arg1 *Expression) (*Expression, error) {
+func (s *Expression) Get(index *Expression) (*Expression, error) {
+	return s.GetContext(context.Background(), index)
+}
+
+// GetContext - access an element in a tuple, list or dictionary.
+//
+// Allowed game scenes: any.
+func (s *Expression) GetContext(ctx context.Context, index *Expression) (*Expression, error) {
 	var err error
 	var argBytes []byte
 	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_GreaterThan",
+		Procedure: "Expression_static_Get",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -873,7 +5402,7 @@ func (s *Expression) GreaterThan(arg1 *Expression) (*Expression, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(arg1)
+	argBytes, err = encode.Marshal(index)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -881,7 +5410,7 @@ func (s *Expression) GreaterThan(arg1 *Expression) (*Expression, error) {
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -893,77 +5422,79 @@ func (s *Expression) GreaterThan(arg1 *Expression) (*Expression, error) {
 	return &vv, nil
 }
 
-// GreaterThanOrEqual - greater than or equal numerical comparison.
+// GetAsync - access an element in a tuple, list or dictionary.
 //
 // Allowed game scenes: any.
-func (s *Expression) GreaterThanOrEqual(arg1 *Expression) (*Expression, error) {
+func (s *Expression) GetAsync(index *Expression) (*krpcgo.Future[*Expression], error) {
 	var err error
 	var argBytes []byte
-	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_GreaterThanOrEqual",
+		Procedure: "Expression_static_Get",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(arg1)
+	argBytes, err = encode.Marshal(index)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	vv.Client = s.Client
-	return &vv, nil
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
 }
 
-// LessThan - less than numerical comparison.
-//
-// Allowed game scenes: any.
-func (s *Expression) LessThan(arg1 *Expression) (*Expression, error) {
+// GetCall builds a call to Get without making it, such as to make several calls
+// with CallMultiple, add a stream with AddStream or use it in an expression.
+func (s *Expression) GetCall(index *Expression) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_LessThan",
+		Procedure: "Expression_static_Get",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(arg1)
+	argBytes, err = encode.Marshal(index)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
+	return request, nil
+}
+
+// DecodeGet decodes the result of a call built with GetCall.
+func (s *Expression) DecodeGet(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -971,15 +5502,22 @@ func (s *Expression) LessThan(arg1 *Expression) (*Expression, error) {
 	return &vv, nil
 }
 
-// LessThanOrEqual - less than or equal numerical comparison.
+// Count - number of elements in a collection.
 //
 // Allowed game scenes: any.
-func (s *Expression) LessThanOrEqual(arg1 *Expression) (*Expression, error) {
+func (s *Expression) Count() (*Expression, error) {
+	return s.CountContext(context.Background())
+}
+
+// CountContext - number of elements in a collection.
+//
+// Allowed game scenes: any.
+func (s *Expression) CountContext(ctx context.Context) (*Expression, error) {
 	var err error
 	var argBytes []byte
 	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_LessThanOrEqual",
+		Procedure: "Expression_static_Count",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -990,15 +5528,7 @@ func (s *Expression) LessThanOrEqual(arg1 *Expression) (*Expression, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(arg1)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
-	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -1010,38 +5540,64 @@ func (s *Expression) LessThanOrEqual(arg1 *Expression) (*Expression, error) {
 	return &vv, nil
 }
 
-// And - boolean and operator.
+// CountAsync - number of elements in a collection.
 //
 // Allowed game scenes: any.
-func (s *Expression) And(arg1 *Expression) (*Expression, error) {
+func (s *Expression) CountAsync() (*krpcgo.Future[*Expression], error) {
 	var err error
 	var argBytes []byte
-	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_And",
+		Procedure: "Expression_static_Count",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(arg1)
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// CountCall builds a call to Count without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Expression) CountCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Count",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
+		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
+	return request, nil
+}
+
+// DecodeCount decodes the result of a call built with CountCall.
+func (s *Expression) DecodeCount(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -1049,15 +5605,22 @@ func (s *Expression) And(arg1 *Expression) (*Expression, error) {
 	return &vv, nil
 }
 
-// Or - boolean or operator.
+// Sum - sum all elements of a collection.
 //
 // Allowed game scenes: any.
-func (s *Expression) Or(arg1 *Expression) (*Expression, error) {
+func (s *Expression) Sum() (*Expression, error) {
+	return s.SumContext(context.Background())
+}
+
+// SumContext - sum all elements of a collection.
+//
+// Allowed game scenes: any.
+func (s *Expression) SumContext(ctx context.Context) (*Expression, error) {
 	var err error
 	var argBytes []byte
 	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_Or",
+		Procedure: "Expression_static_Sum",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1068,15 +5631,7 @@ func (s *Expression) Or(arg1 *Expression) (*Expression, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(arg1)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
-	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -1088,38 +5643,63 @@ func (s *Expression) Or(arg1 *Expression) (*Expression, error) {
 	return &vv, nil
 }
 
-// ExclusiveOr - boolean exclusive-or operator.
+// SumAsync - sum all elements of a collection.
 //
 // Allowed game scenes: any.
-func (s *Expression) ExclusiveOr(arg1 *Expression) (*Expression, error) {
+func (s *Expression) SumAsync() (*krpcgo.Future[*Expression], error) {
 	var err error
 	var argBytes []byte
-	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_ExclusiveOr",
+		Procedure: "Expression_static_Sum",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(arg1)
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// SumCall builds a call to Sum without making it, such as to make several calls
+// with CallMultiple, add a stream with AddStream or use it in an expression.
+func (s *Expression) SumCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Sum",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
+		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
+	return request, nil
+}
+
+// DecodeSum decodes the result of a call built with SumCall.
+func (s *Expression) DecodeSum(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -1127,15 +5707,22 @@ func (s *Expression) ExclusiveOr(arg1 *Expression) (*Expression, error) {
 	return &vv, nil
 }
 
-// Not - boolean negation operator.
+// Max - maximum of all elements in a collection.
 //
 // Allowed game scenes: any.
-func (s *Expression) Not() (*Expression, error) {
+func (s *Expression) Max() (*Expression, error) {
+	return s.MaxContext(context.Background())
+}
+
+// MaxContext - maximum of all elements in a collection.
+//
+// Allowed game scenes: any.
+func (s *Expression) MaxContext(ctx context.Context) (*Expression, error) {
 	var err error
 	var argBytes []byte
 	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_Not",
+		Procedure: "Expression_static_Max",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1146,7 +5733,7 @@ func (s *Expression) Not() (*Expression, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -1158,77 +5745,63 @@ func (s *Expression) Not() (*Expression, error) {
 	return &vv, nil
 }
 
-// Add - numerical addition.
+// MaxAsync - maximum of all elements in a collection.
 //
 // Allowed game scenes: any.
-func (s *Expression) Add(arg1 *Expression) (*Expression, error) {
+func (s *Expression) MaxAsync() (*krpcgo.Future[*Expression], error) {
 	var err error
 	var argBytes []byte
-	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_Add",
+		Procedure: "Expression_static_Max",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(arg1)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	vv.Client = s.Client
-	return &vv, nil
+	return future, nil
 }
-
-// Subtract - numerical subtraction.
-//
-// Allowed game scenes: any.
-func (s *Expression) Subtract(arg1 *Expression) (*Expression, error) {
+
+// MaxCall builds a call to Max without making it, such as to make several calls
+// with CallMultiple, add a stream with AddStream or use it in an expression.
+func (s *Expression) MaxCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_Subtract",
+		Procedure: "Expression_static_Max",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(arg1)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
-	})
-	result, err := s.Client.Call(request)
-	if err != nil {
+	return request, nil
+}
+
+// DecodeMax decodes the result of a call built with MaxCall.
+func (s *Expression) DecodeMax(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -1236,15 +5809,22 @@ func (s *Expression) Subtract(arg1 *Expression) (*Expression, error) {
 	return &vv, nil
 }
 
-// Multiply - numerical multiplication.
+// Min - minimum of all elements in a collection.
 //
 // Allowed game scenes: any.
-func (s *Expression) Multiply(arg1 *Expression) (*Expression, error) {
+func (s *Expression) Min() (*Expression, error) {
+	return s.MinContext(context.Background())
+}
+
+// MinContext - minimum of all elements in a collection.
+//
+// Allowed game scenes: any.
+func (s *Expression) MinContext(ctx context.Context) (*Expression, error) {
 	var err error
 	var argBytes []byte
 	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_Multiply",
+		Procedure: "Expression_static_Min",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1255,15 +5835,7 @@ func (s *Expression) Multiply(arg1 *Expression) (*Expression, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(arg1)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
-	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -1275,38 +5847,63 @@ func (s *Expression) Multiply(arg1 *Expression) (*Expression, error) {
 	return &vv, nil
 }
 
-// Divide - numerical division.
+// MinAsync - minimum of all elements in a collection.
 //
 // Allowed game scenes: any.
-func (s *Expression) Divide(arg1 *Expression) (*Expression, error) {
+func (s *Expression) MinAsync() (*krpcgo.Future[*Expression], error) {
 	var err error
 	var argBytes []byte
-	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_Divide",
+		Procedure: "Expression_static_Min",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(arg1)
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// MinCall builds a call to Min without making it, such as to make several calls
+// with CallMultiple, add a stream with AddStream or use it in an expression.
+func (s *Expression) MinCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Min",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
+		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
+	return request, nil
+}
+
+// DecodeMin decodes the result of a call built with MinCall.
+func (s *Expression) DecodeMin(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -1314,15 +5911,22 @@ func (s *Expression) Divide(arg1 *Expression) (*Expression, error) {
 	return &vv, nil
 }
 
-// Modulo - numerical modulo operator.
+// Average - minimum of all elements in a collection.
 //
 // Allowed game scenes: any.
-func (s *Expression) Modulo(arg1 *Expression) (*Expression, error) {
+func (s *Expression) Average() (*Expression, error) {
+	return s.AverageContext(context.Background())
+}
+
+// AverageContext - minimum of all elements in a collection.
+//
+// Allowed game scenes: any.
+func (s *Expression) AverageContext(ctx context.Context) (*Expression, error) {
 	var err error
 	var argBytes []byte
 	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_Modulo",
+		Procedure: "Expression_static_Average",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1333,15 +5937,7 @@ func (s *Expression) Modulo(arg1 *Expression) (*Expression, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(arg1)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
-	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -1353,38 +5949,64 @@ func (s *Expression) Modulo(arg1 *Expression) (*Expression, error) {
 	return &vv, nil
 }
 
-// Power - numerical power operator.
+// AverageAsync - minimum of all elements in a collection.
 //
 // Allowed game scenes: any.
-func (s *Expression) Power(arg1 *Expression) (*Expression, error) {
+func (s *Expression) AverageAsync() (*krpcgo.Future[*Expression], error) {
 	var err error
 	var argBytes []byte
-	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_Power",
+		Procedure: "Expression_static_Average",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(arg1)
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// AverageCall builds a call to Average without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Expression) AverageCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Expression_static_Average",
+		Service:   "KRPC",
+	}
+	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
+		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
+	return request, nil
+}
+
+// DecodeAverage decodes the result of a call built with AverageCall.
+func (s *Expression) DecodeAverage(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -1392,15 +6014,22 @@ func (s *Expression) Power(arg1 *Expression) (*Expression, error) {
 	return &vv, nil
 }
 
-// LeftShift - bitwise left shift.
+// Select - run a function on every element in the collection.
 //
 // Allowed game scenes: any.
-func (s *Expression) LeftShift(arg1 *Expression) (*Expression, error) {
+func (s *Expression) Select(f *Expression) (*Expression, error) {
+	return s.SelectContext(context.Background(), f)
+}
+
+// SelectContext - run a function on every element in the collection.
+//
+// Allowed game scenes: any.
+func (s *Expression) SelectContext(ctx context.Context, f *Expression) (*Expression, error) {
 	var err error
 	var argBytes []byte
 	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_LeftShift",
+		Procedure: "Expression_static_Select",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1411,7 +6040,7 @@ func (s *Expression) LeftShift(arg1 *Expression) (*Expression, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(arg1)
+	argBytes, err = encode.Marshal(f)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -1419,7 +6048,7 @@ func (s *Expression) LeftShift(arg1 *Expression) (*Expression, error) {
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -1431,77 +6060,80 @@ func (s *Expression) LeftShift(arg1 *Expression) (*Expression, error) {
 	return &vv, nil
 }
 
-// RightShift - bitwise right shift.
+// SelectAsync - run a function on every element in the collection.
 //
 // Allowed game scenes: any.
-func (s *Expression) RightShift(arg1 *Expression) (*Expression, error) {
+func (s *Expression) SelectAsync(f *Expression) (*krpcgo.Future[*Expression], error) {
 	var err error
 	var argBytes []byte
-	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_RightShift",
+		Procedure: "Expression_static_Select",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(arg1)
+	argBytes, err = encode.Marshal(f)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	vv.Client = s.Client
-	return &vv, nil
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
 }
 
-// Cast - perform a cast to the given type.
-//
-// Allowed game scenes: any.
-func (s *Expression) Cast(t *Type) (*Expression, error) {
+// SelectCall builds a call to Select without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Expression) SelectCall(f *Expression) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_Cast",
+		Procedure: "Expression_static_Select",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(t)
+	argBytes, err = encode.Marshal(f)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
+	return request, nil
+}
+
+// DecodeSelect decodes the result of a call built with SelectCall.
+func (s *Expression) DecodeSelect(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -1509,15 +6141,22 @@ func (s *Expression) Cast(t *Type) (*Expression, error) {
 	return &vv, nil
 }
 
-// Parameter - a named parameter of type double.
+// Where - run a function on every element in the collection.
 //
 // Allowed game scenes: any.
-func (s *Expression) Parameter(t *Type) (*Expression, error) {
+func (s *Expression) Where(f *Expression) (*Expression, error) {
+	return s.WhereContext(context.Background(), f)
+}
+
+// WhereContext - run a function on every element in the collection.
+//
+// Allowed game scenes: any.
+func (s *Expression) WhereContext(ctx context.Context, f *Expression) (*Expression, error) {
 	var err error
 	var argBytes []byte
 	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_Parameter",
+		Procedure: "Expression_static_Where",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1528,7 +6167,7 @@ func (s *Expression) Parameter(t *Type) (*Expression, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(t)
+	argBytes, err = encode.Marshal(f)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -1536,7 +6175,7 @@ func (s *Expression) Parameter(t *Type) (*Expression, error) {
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -1548,77 +6187,80 @@ func (s *Expression) Parameter(t *Type) (*Expression, error) {
 	return &vv, nil
 }
 
-// Function - a function.
+// WhereAsync - run a function on every element in the collection.
 //
 // Allowed game scenes: any.
-func (s *Expression) Function(body *Expression) (*Expression, error) {
+func (s *Expression) WhereAsync(f *Expression) (*krpcgo.Future[*Expression], error) {
 	var err error
 	var argBytes []byte
-	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_Function",
+		Procedure: "Expression_static_Where",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(body)
+	argBytes, err = encode.Marshal(f)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	vv.Client = s.Client
-	return &vv, nil
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
 }
 
-// Invoke - a function call.
-//
-// Allowed game scenes: any.
-func (s *Expression) Invoke(args map[string]*Expression) (*Expression, error) {
+// WhereCall builds a call to Where without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Expression) WhereCall(f *Expression) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_Invoke",
+		Procedure: "Expression_static_Where",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(args)
+	argBytes, err = encode.Marshal(f)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
+	return request, nil
+}
+
+// DecodeWhere decodes the result of a call built with WhereCall.
+func (s *Expression) DecodeWhere(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -1626,15 +6268,22 @@ func (s *Expression) Invoke(args map[string]*Expression) (*Expression, error) {
 	return &vv, nil
 }
 
-// CreateTuple - construct a tuple.
+// Contains - determine if a collection contains a value.
 //
 // Allowed game scenes: any.
-func (s *Expression) CreateTuple() (*Expression, error) {
+func (s *Expression) Contains(value *Expression) (*Expression, error) {
+	return s.ContainsContext(context.Background(), value)
+}
+
+// ContainsContext - determine if a collection contains a value.
+//
+// Allowed game scenes: any.
+func (s *Expression) ContainsContext(ctx context.Context, value *Expression) (*Expression, error) {
 	var err error
 	var argBytes []byte
 	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_CreateTuple",
+		Procedure: "Expression_static_Contains",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1645,7 +6294,15 @@ func (s *Expression) CreateTuple() (*Expression, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -1657,61 +6314,80 @@ func (s *Expression) CreateTuple() (*Expression, error) {
 	return &vv, nil
 }
 
-// CreateList - construct a list.
+// ContainsAsync - determine if a collection contains a value.
 //
 // Allowed game scenes: any.
-func (s *Expression) CreateList() (*Expression, error) {
+func (s *Expression) ContainsAsync(value *Expression) (*krpcgo.Future[*Expression], error) {
 	var err error
 	var argBytes []byte
-	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_CreateList",
+		Procedure: "Expression_static_Contains",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	vv.Client = s.Client
-	return &vv, nil
-}
-
-// CreateSet - construct a set.
-//
-// Allowed game scenes: any.
-func (s *Expression) CreateSet() (*Expression, error) {
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// ContainsCall builds a call to Contains without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Expression) ContainsCall(value *Expression) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_CreateSet",
+		Procedure: "Expression_static_Contains",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeContains decodes the result of a call built with ContainsCall.
+func (s *Expression) DecodeContains(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -1719,16 +6395,22 @@ func (s *Expression) CreateSet() (*Expression, error) {
 	return &vv, nil
 }
 
-// CreateDictionary - construct a dictionary, from a list of corresponding keys
-// and values.
+// Aggregate - applies an accumulator function over a sequence.
 //
 // Allowed game scenes: any.
-func (s *Expression) CreateDictionary(values []*Expression) (*Expression, error) {
+func (s *Expression) Aggregate(f *Expression) (*Expression, error) {
+	return s.AggregateContext(context.Background(), f)
+}
+
+// AggregateContext - applies an accumulator function over a sequence.
+//
+// Allowed game scenes: any.
+func (s *Expression) AggregateContext(ctx context.Context, f *Expression) (*Expression, error) {
 	var err error
 	var argBytes []byte
 	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_CreateDictionary",
+		Procedure: "Expression_static_Aggregate",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1739,7 +6421,7 @@ func (s *Expression) CreateDictionary(values []*Expression) (*Expression, error)
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(values)
+	argBytes, err = encode.Marshal(f)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -1747,7 +6429,7 @@ func (s *Expression) CreateDictionary(values []*Expression) (*Expression, error)
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -1759,61 +6441,80 @@ func (s *Expression) CreateDictionary(values []*Expression) (*Expression, error)
 	return &vv, nil
 }
 
-// ToList - convert a collection to a list.
+// AggregateAsync - applies an accumulator function over a sequence.
 //
 // Allowed game scenes: any.
-func (s *Expression) ToList() (*Expression, error) {
+func (s *Expression) AggregateAsync(f *Expression) (*krpcgo.Future[*Expression], error) {
 	var err error
 	var argBytes []byte
-	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_ToList",
+		Procedure: "Expression_static_Aggregate",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
+	argBytes, err = encode.Marshal(f)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	vv.Client = s.Client
-	return &vv, nil
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
 }
 
-// ToSet - convert a collection to a set.
-//
-// Allowed game scenes: any.
-func (s *Expression) ToSet() (*Expression, error) {
+// AggregateCall builds a call to Aggregate without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Expression) AggregateCall(f *Expression) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_ToSet",
+		Procedure: "Expression_static_Aggregate",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	argBytes, err = encode.Marshal(f)
 	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeAggregate decodes the result of a call built with AggregateCall.
+func (s *Expression) DecodeAggregate(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -1821,15 +6522,24 @@ func (s *Expression) ToSet() (*Expression, error) {
 	return &vv, nil
 }
 
-// Get - access an element in a tuple, list or dictionary.
+// AggregateWithSeed - applies an accumulator function over a sequence, with a
+// given seed.
 //
 // Allowed game scenes: any.
-func (s *Expression) Get(index *Expression) (*Expression, error) {
+func (s *Expression) AggregateWithSeed(seed *Expression, f *Expression) (*Expression, error) {
+	return s.AggregateWithSeedContext(context.Background(), seed, f)
+}
+
+// AggregateWithSeedContext - applies an accumulator function over a sequence,
+// with a given seed.
+//
+// Allowed game scenes: any.
+func (s *Expression) AggregateWithSeedContext(ctx context.Context, seed *Expression, f *Expression) (*Expression, error) {
 	var err error
 	var argBytes []byte
 	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_Get",
+		Procedure: "Expression_static_AggregateWithSeed",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1840,7 +6550,7 @@ func (s *Expression) Get(index *Expression) (*Expression, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(index)
+	argBytes, err = encode.Marshal(seed)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -1848,7 +6558,15 @@ func (s *Expression) Get(index *Expression) (*Expression, error) {
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	argBytes, err = encode.Marshal(f)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x2),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -1860,61 +6578,98 @@ func (s *Expression) Get(index *Expression) (*Expression, error) {
 	return &vv, nil
 }
 
-// Count - number of elements in a collection.
+// AggregateWithSeedAsync - applies an accumulator function over a sequence,
+// with a given seed.
 //
 // Allowed game scenes: any.
-func (s *Expression) Count() (*Expression, error) {
+func (s *Expression) AggregateWithSeedAsync(seed *Expression, f *Expression) (*krpcgo.Future[*Expression], error) {
 	var err error
 	var argBytes []byte
-	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_Count",
+		Procedure: "Expression_static_AggregateWithSeed",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	argBytes, err = encode.Marshal(seed)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(f)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	vv.Client = s.Client
-	return &vv, nil
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x2),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
 }
 
-// Sum - sum all elements of a collection.
-//
-// Allowed game scenes: any.
-func (s *Expression) Sum() (*Expression, error) {
+// AggregateWithSeedCall builds a call to AggregateWithSeed without making it,
+// such as to make several calls with CallMultiple, add a stream with AddStream
+// or use it in an expression.
+func (s *Expression) AggregateWithSeedCall(seed *Expression, f *Expression) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_Sum",
+		Procedure: "Expression_static_AggregateWithSeed",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	argBytes, err = encode.Marshal(seed)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(f)
 	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x2),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeAggregateWithSeed decodes the result of a call built with
+// AggregateWithSeedCall.
+func (s *Expression) DecodeAggregateWithSeed(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -1922,15 +6677,22 @@ func (s *Expression) Sum() (*Expression, error) {
 	return &vv, nil
 }
 
-// Max - maximum of all elements in a collection.
+// Concat - concatenate two sequences.
 //
 // Allowed game scenes: any.
-func (s *Expression) Max() (*Expression, error) {
+func (s *Expression) Concat(arg2 *Expression) (*Expression, error) {
+	return s.ConcatContext(context.Background(), arg2)
+}
+
+// ConcatContext - concatenate two sequences.
+//
+// Allowed game scenes: any.
+func (s *Expression) ConcatContext(ctx context.Context, arg2 *Expression) (*Expression, error) {
 	var err error
 	var argBytes []byte
 	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_Max",
+		Procedure: "Expression_static_Concat",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1941,7 +6703,15 @@ func (s *Expression) Max() (*Expression, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	argBytes, err = encode.Marshal(arg2)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -1953,61 +6723,80 @@ func (s *Expression) Max() (*Expression, error) {
 	return &vv, nil
 }
 
-// Min - minimum of all elements in a collection.
+// ConcatAsync - concatenate two sequences.
 //
 // Allowed game scenes: any.
-func (s *Expression) Min() (*Expression, error) {
+func (s *Expression) ConcatAsync(arg2 *Expression) (*krpcgo.Future[*Expression], error) {
 	var err error
 	var argBytes []byte
-	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_Min",
+		Procedure: "Expression_static_Concat",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
+	argBytes, err = encode.Marshal(arg2)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	vv.Client = s.Client
-	return &vv, nil
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
 }
 
-// Average - minimum of all elements in a collection.
-//
-// Allowed game scenes: any.
-func (s *Expression) Average() (*Expression, error) {
+// ConcatCall builds a call to Concat without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Expression) ConcatCall(arg2 *Expression) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_Average",
+		Procedure: "Expression_static_Concat",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	argBytes, err = encode.Marshal(arg2)
 	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeConcat decodes the result of a call built with ConcatCall.
+func (s *Expression) DecodeConcat(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -2015,15 +6804,22 @@ func (s *Expression) Average() (*Expression, error) {
 	return &vv, nil
 }
 
-// Select - run a function on every element in the collection.
+// OrderBy - order a collection using a key function.
 //
 // Allowed game scenes: any.
-func (s *Expression) Select(f *Expression) (*Expression, error) {
+func (s *Expression) OrderBy(key *Expression) (*Expression, error) {
+	return s.OrderByContext(context.Background(), key)
+}
+
+// OrderByContext - order a collection using a key function.
+//
+// Allowed game scenes: any.
+func (s *Expression) OrderByContext(ctx context.Context, key *Expression) (*Expression, error) {
 	var err error
 	var argBytes []byte
 	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_Select",
+		Procedure: "Expression_static_OrderBy",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2034,7 +6830,7 @@ func (s *Expression) Select(f *Expression) (*Expression, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(f)
+	argBytes, err = encode.Marshal(key)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -2042,7 +6838,7 @@ func (s *Expression) Select(f *Expression) (*Expression, error) {
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -2054,77 +6850,80 @@ func (s *Expression) Select(f *Expression) (*Expression, error) {
 	return &vv, nil
 }
 
-// Where - run a function on every element in the collection.
+// OrderByAsync - order a collection using a key function.
 //
 // Allowed game scenes: any.
-func (s *Expression) Where(f *Expression) (*Expression, error) {
+func (s *Expression) OrderByAsync(key *Expression) (*krpcgo.Future[*Expression], error) {
 	var err error
 	var argBytes []byte
-	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_Where",
+		Procedure: "Expression_static_OrderBy",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(f)
+	argBytes, err = encode.Marshal(key)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	vv.Client = s.Client
-	return &vv, nil
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
 }
 
-// Contains - determine if a collection contains a value.
-//
-// Allowed game scenes: any.
-func (s *Expression) Contains(value *Expression) (*Expression, error) {
+// OrderByCall builds a call to OrderBy without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Expression) OrderByCall(key *Expression) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_Contains",
+		Procedure: "Expression_static_OrderBy",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
+	argBytes, err = encode.Marshal(key)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
+	return request, nil
+}
+
+// DecodeOrderBy decodes the result of a call built with OrderByCall.
+func (s *Expression) DecodeOrderBy(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -2132,15 +6931,24 @@ func (s *Expression) Contains(value *Expression) (*Expression, error) {
 	return &vv, nil
 }
 
-// Aggregate - applies an accumulator function over a sequence.
+// All - determine whether all items in a collection satisfy a boolean
+// predicate.
 //
 // Allowed game scenes: any.
-func (s *Expression) Aggregate(f *Expression) (*Expression, error) {
+func (s *Expression) All(predicate *Expression) (*Expression, error) {
+	return s.AllContext(context.Background(), predicate)
+}
+
+// AllContext - determine whether all items in a collection satisfy a boolean
+// predicate.
+//
+// Allowed game scenes: any.
+func (s *Expression) AllContext(ctx context.Context, predicate *Expression) (*Expression, error) {
 	var err error
 	var argBytes []byte
 	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_Aggregate",
+		Procedure: "Expression_static_All",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2151,7 +6959,7 @@ func (s *Expression) Aggregate(f *Expression) (*Expression, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(f)
+	argBytes, err = encode.Marshal(predicate)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -2159,7 +6967,7 @@ func (s *Expression) Aggregate(f *Expression) (*Expression, error) {
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -2171,86 +6979,80 @@ func (s *Expression) Aggregate(f *Expression) (*Expression, error) {
 	return &vv, nil
 }
 
-// AggregateWithSeed - applies an accumulator function over a sequence, with a
-// given seed.
+// AllAsync - determine whether all items in a collection satisfy a boolean
+// predicate.
 //
 // Allowed game scenes: any.
-func (s *Expression) AggregateWithSeed(seed *Expression, f *Expression) (*Expression, error) {
+func (s *Expression) AllAsync(predicate *Expression) (*krpcgo.Future[*Expression], error) {
 	var err error
 	var argBytes []byte
-	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_AggregateWithSeed",
+		Procedure: "Expression_static_All",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(seed)
+	argBytes, err = encode.Marshal(predicate)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(f)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x2),
-		Value:    argBytes,
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	vv.Client = s.Client
-	return &vv, nil
+	return future, nil
 }
 
-// Concat - concatenate two sequences.
-//
-// Allowed game scenes: any.
-func (s *Expression) Concat(arg2 *Expression) (*Expression, error) {
+// AllCall builds a call to All without making it, such as to make several calls
+// with CallMultiple, add a stream with AddStream or use it in an expression.
+func (s *Expression) AllCall(predicate *Expression) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_Concat",
+		Procedure: "Expression_static_All",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(arg2)
+	argBytes, err = encode.Marshal(predicate)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
+	return request, nil
+}
+
+// DecodeAll decodes the result of a call built with AllCall.
+func (s *Expression) DecodeAll(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -2258,15 +7060,24 @@ func (s *Expression) Concat(arg2 *Expression) (*Expression, error) {
 	return &vv, nil
 }
 
-// OrderBy - order a collection using a key function.
+// Any - determine whether any item in a collection satisfies a boolean
+// predicate.
 //
 // Allowed game scenes: any.
-func (s *Expression) OrderBy(key *Expression) (*Expression, error) {
+func (s *Expression) Any(predicate *Expression) (*Expression, error) {
+	return s.AnyContext(context.Background(), predicate)
+}
+
+// AnyContext - determine whether any item in a collection satisfies a boolean
+// predicate.
+//
+// Allowed game scenes: any.
+func (s *Expression) AnyContext(ctx context.Context, predicate *Expression) (*Expression, error) {
 	var err error
 	var argBytes []byte
 	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_OrderBy",
+		Procedure: "Expression_static_Any",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2277,7 +7088,7 @@ func (s *Expression) OrderBy(key *Expression) (*Expression, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(key)
+	argBytes, err = encode.Marshal(predicate)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -2285,7 +7096,7 @@ func (s *Expression) OrderBy(key *Expression) (*Expression, error) {
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -2297,21 +7108,20 @@ func (s *Expression) OrderBy(key *Expression) (*Expression, error) {
 	return &vv, nil
 }
 
-// All - determine whether all items in a collection satisfy a boolean
+// AnyAsync - determine whether any item in a collection satisfies a boolean
 // predicate.
 //
 // Allowed game scenes: any.
-func (s *Expression) All(predicate *Expression) (*Expression, error) {
+func (s *Expression) AnyAsync(predicate *Expression) (*krpcgo.Future[*Expression], error) {
 	var err error
 	var argBytes []byte
-	var vv Expression
 	request := &types.ProcedureCall{
-		Procedure: "Expression_static_All",
+		Procedure: "Expression_static_Any",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
@@ -2319,39 +7129,36 @@ func (s *Expression) All(predicate *Expression) (*Expression, error) {
 	})
 	argBytes, err = encode.Marshal(predicate)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	vv.Client = s.Client
-	return &vv, nil
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Expression, error) {
+		var vv Expression
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
 }
 
-// Any - determine whether any item in a collection satisfies a boolean
-// predicate.
-//
-// Allowed game scenes: any.
-func (s *Expression) Any(predicate *Expression) (*Expression, error) {
+// AnyCall builds a call to Any without making it, such as to make several calls
+// with CallMultiple, add a stream with AddStream or use it in an expression.
+func (s *Expression) AnyCall(predicate *Expression) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv Expression
 	request := &types.ProcedureCall{
 		Procedure: "Expression_static_Any",
 		Service:   "KRPC",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
@@ -2359,17 +7166,22 @@ func (s *Expression) Any(predicate *Expression) (*Expression, error) {
 	})
 	argBytes, err = encode.Marshal(predicate)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
+	return request, nil
+}
+
+// DecodeAny decodes the result of a call built with AnyCall.
+func (s *Expression) DecodeAny(result *types.ProcedureResult) (*Expression, error) {
+	var vv Expression
+	if err := krpcgo.ResultError(result); err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -2381,13 +7193,20 @@ func (s *Expression) Any(predicate *Expression) (*Expression, error) {
 //
 // Allowed game scenes: any.
 func (s *Type) Double() (*Type, error) {
+	return s.DoubleContext(context.Background())
+}
+
+// DoubleContext - double type.
+//
+// Allowed game scenes: any.
+func (s *Type) DoubleContext(ctx context.Context) (*Type, error) {
 	var err error
 	var vv Type
 	request := &types.ProcedureCall{
 		Procedure: "Type_static_Double",
 		Service:   "KRPC",
 	}
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -2399,17 +7218,69 @@ func (s *Type) Double() (*Type, error) {
 	return &vv, nil
 }
 
+// DoubleAsync - double type.
+//
+// Allowed game scenes: any.
+func (s *Type) DoubleAsync() (*krpcgo.Future[*Type], error) {
+	request := &types.ProcedureCall{
+		Procedure: "Type_static_Double",
+		Service:   "KRPC",
+	}
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Type, error) {
+		var vv Type
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// DoubleCall builds a call to Double without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Type) DoubleCall() (*types.ProcedureCall, error) {
+	request := &types.ProcedureCall{
+		Procedure: "Type_static_Double",
+		Service:   "KRPC",
+	}
+	return request, nil
+}
+
+// DecodeDouble decodes the result of a call built with DoubleCall.
+func (s *Type) DecodeDouble(result *types.ProcedureResult) (*Type, error) {
+	var vv Type
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
 // Float - float type.
 //
 // Allowed game scenes: any.
 func (s *Type) Float() (*Type, error) {
+	return s.FloatContext(context.Background())
+}
+
+// FloatContext - float type.
+//
+// Allowed game scenes: any.
+func (s *Type) FloatContext(ctx context.Context) (*Type, error) {
 	var err error
 	var vv Type
 	request := &types.ProcedureCall{
 		Procedure: "Type_static_Float",
 		Service:   "KRPC",
 	}
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -2421,17 +7292,69 @@ func (s *Type) Float() (*Type, error) {
 	return &vv, nil
 }
 
+// FloatAsync - float type.
+//
+// Allowed game scenes: any.
+func (s *Type) FloatAsync() (*krpcgo.Future[*Type], error) {
+	request := &types.ProcedureCall{
+		Procedure: "Type_static_Float",
+		Service:   "KRPC",
+	}
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Type, error) {
+		var vv Type
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// FloatCall builds a call to Float without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Type) FloatCall() (*types.ProcedureCall, error) {
+	request := &types.ProcedureCall{
+		Procedure: "Type_static_Float",
+		Service:   "KRPC",
+	}
+	return request, nil
+}
+
+// DecodeFloat decodes the result of a call built with FloatCall.
+func (s *Type) DecodeFloat(result *types.ProcedureResult) (*Type, error) {
+	var vv Type
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
 // Int - int type.
 //
 // Allowed game scenes: any.
 func (s *Type) Int() (*Type, error) {
+	return s.IntContext(context.Background())
+}
+
+// IntContext - int type.
+//
+// Allowed game scenes: any.
+func (s *Type) IntContext(ctx context.Context) (*Type, error) {
 	var err error
 	var vv Type
 	request := &types.ProcedureCall{
 		Procedure: "Type_static_Int",
 		Service:   "KRPC",
 	}
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -2443,17 +7366,68 @@ func (s *Type) Int() (*Type, error) {
 	return &vv, nil
 }
 
+// IntAsync - int type.
+//
+// Allowed game scenes: any.
+func (s *Type) IntAsync() (*krpcgo.Future[*Type], error) {
+	request := &types.ProcedureCall{
+		Procedure: "Type_static_Int",
+		Service:   "KRPC",
+	}
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Type, error) {
+		var vv Type
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// IntCall builds a call to Int without making it, such as to make several calls
+// with CallMultiple, add a stream with AddStream or use it in an expression.
+func (s *Type) IntCall() (*types.ProcedureCall, error) {
+	request := &types.ProcedureCall{
+		Procedure: "Type_static_Int",
+		Service:   "KRPC",
+	}
+	return request, nil
+}
+
+// DecodeInt decodes the result of a call built with IntCall.
+func (s *Type) DecodeInt(result *types.ProcedureResult) (*Type, error) {
+	var vv Type
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
 // Bool - bool type.
 //
 // Allowed game scenes: any.
 func (s *Type) Bool() (*Type, error) {
+	return s.BoolContext(context.Background())
+}
+
+// BoolContext - bool type.
+//
+// Allowed game scenes: any.
+func (s *Type) BoolContext(ctx context.Context) (*Type, error) {
 	var err error
 	var vv Type
 	request := &types.ProcedureCall{
 		Procedure: "Type_static_Bool",
 		Service:   "KRPC",
 	}
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -2465,17 +7439,69 @@ func (s *Type) Bool() (*Type, error) {
 	return &vv, nil
 }
 
+// BoolAsync - bool type.
+//
+// Allowed game scenes: any.
+func (s *Type) BoolAsync() (*krpcgo.Future[*Type], error) {
+	request := &types.ProcedureCall{
+		Procedure: "Type_static_Bool",
+		Service:   "KRPC",
+	}
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Type, error) {
+		var vv Type
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// BoolCall builds a call to Bool without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Type) BoolCall() (*types.ProcedureCall, error) {
+	request := &types.ProcedureCall{
+		Procedure: "Type_static_Bool",
+		Service:   "KRPC",
+	}
+	return request, nil
+}
+
+// DecodeBool decodes the result of a call built with BoolCall.
+func (s *Type) DecodeBool(result *types.ProcedureResult) (*Type, error) {
+	var vv Type
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
 // String - string type.
 //
 // Allowed game scenes: any.
 func (s *Type) String() (*Type, error) {
+	return s.StringContext(context.Background())
+}
+
+// StringContext - string type.
+//
+// Allowed game scenes: any.
+func (s *Type) StringContext(ctx context.Context) (*Type, error) {
 	var err error
 	var vv Type
 	request := &types.ProcedureCall{
 		Procedure: "Type_static_String",
 		Service:   "KRPC",
 	}
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -2486,3 +7512,341 @@ func (s *Type) String() (*Type, error) {
 	vv.Client = s.Client
 	return &vv, nil
 }
+
+// StringAsync - string type.
+//
+// Allowed game scenes: any.
+func (s *Type) StringAsync() (*krpcgo.Future[*Type], error) {
+	request := &types.ProcedureCall{
+		Procedure: "Type_static_String",
+		Service:   "KRPC",
+	}
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Type, error) {
+		var vv Type
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// StringCall builds a call to String without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Type) StringCall() (*types.ProcedureCall, error) {
+	request := &types.ProcedureCall{
+		Procedure: "Type_static_String",
+		Service:   "KRPC",
+	}
+	return request, nil
+}
+
+// DecodeString decodes the result of a call built with StringCall.
+func (s *Type) DecodeString(result *types.ProcedureResult) (*Type, error) {
+	var vv Type
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+func init() {
+	krpcgo.RegisterReturnTypes("KRPC", map[string]*types.Type{
+		"AddEvent":  &types.Type{Code: types.Type_EVENT},
+		"AddStream": &types.Type{Code: types.Type_STREAM},
+		"Expression_static_Add": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_Aggregate": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_AggregateWithSeed": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_All": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_And": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_Any": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_Average": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_Call": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_Cast": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_Concat": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_ConstantBool": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_ConstantDouble": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_ConstantFloat": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_ConstantInt": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_ConstantString": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_Contains": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_Count": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_CreateDictionary": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_CreateList": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_CreateSet": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_CreateTuple": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_Divide": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_Equal": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_ExclusiveOr": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_Function": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_Get": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_GreaterThan": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_GreaterThanOrEqual": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_Invoke": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_LeftShift": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_LessThan": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_LessThanOrEqual": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_Max": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_Min": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_Modulo": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_Multiply": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_Not": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_NotEqual": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_Or": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_OrderBy": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_Parameter": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_Power": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_RightShift": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_Select": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_Subtract": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_Sum": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_ToList": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_ToSet": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"Expression_static_Where": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Expression",
+			Service: "KRPC",
+		},
+		"GetClientID":   &types.Type{Code: types.Type_BYTES},
+		"GetClientName": &types.Type{Code: types.Type_STRING},
+		"GetServices":   &types.Type{Code: types.Type_SERVICES},
+		"GetStatus":     &types.Type{Code: types.Type_STATUS},
+		"Type_static_Bool": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Type",
+			Service: "KRPC",
+		},
+		"Type_static_Double": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Type",
+			Service: "KRPC",
+		},
+		"Type_static_Float": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Type",
+			Service: "KRPC",
+		},
+		"Type_static_Int": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Type",
+			Service: "KRPC",
+		},
+		"Type_static_String": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Type",
+			Service: "KRPC",
+		},
+		"get_Clients": &types.Type{
+			Code: types.Type_LIST,
+			Types: []*types.Type{&types.Type{
+				Code:  types.Type_TUPLE,
+				Types: []*types.Type{&types.Type{Code: types.Type_BYTES}, &types.Type{Code: types.Type_STRING}, &types.Type{Code: types.Type_STRING}},
+			}},
+		},
+		"get_CurrentGameScene": &types.Type{
+			Code:    types.Type_ENUMERATION,
+			Name:    "GameScene",
+			Service: "KRPC",
+		},
+		"get_Paused": &types.Type{Code: types.Type_BOOL},
+	})
+}
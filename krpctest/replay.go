@@ -0,0 +1,169 @@
+package krpctest
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/atburke/krpc-go/lib/record"
+	"github.com/atburke/krpc-go/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/ztrue/tracerr"
+)
+
+// replay serves the responses in a recording.
+type replay struct {
+	// requests are the recorded requests, in order.
+	requests []*types.Request
+	// responses are the recorded responses, in order.
+	responses []record.Entry
+	// updates are the stream updates recorded after each response. The
+	// first element holds the updates recorded before the first response.
+	updates [][]record.Entry
+	// received is the number of requests received so far.
+	received int
+	// err is the first difference from the recording.
+	err error
+}
+
+func newReplay(entries []record.Entry) *replay {
+	r := &replay{updates: [][]record.Entry{nil}}
+	for _, e := range entries {
+		switch {
+		case e.Request != nil:
+			r.requests = append(r.requests, e.Request)
+		case e.Response != nil:
+			r.responses = append(r.responses, e)
+			r.updates = append(r.updates, nil)
+		case e.StreamUpdate != nil:
+			last := len(r.updates) - 1
+			r.updates[last] = append(r.updates[last], e)
+		}
+	}
+	return r
+}
+
+// next gets the response to the next request, along with the stream updates
+// to send before and after it.
+func (r *replay) next(req *types.Request) (resp record.Entry, before, after []record.Entry) {
+	i := r.received
+	r.received++
+	if i >= len(r.responses) {
+		r.fail(tracerr.Errorf("Received request %v, but the recording only has %v responses", i+1, len(r.responses)))
+		return record.Entry{Response: &types.Response{
+			Error: &types.Error{Description: "No more recorded responses"},
+		}}, nil, nil
+	}
+	if i >= len(r.requests) || !proto.Equal(req, r.requests[i]) {
+		r.fail(tracerr.Errorf("Request %v differs from the recording: %v", i+1, req))
+	}
+	if i == 0 {
+		before = r.updates[0]
+	}
+	return r.responses[i], before, r.updates[i+1]
+}
+
+func (r *replay) fail(err error) {
+	if r.err == nil {
+		r.err = err
+	}
+}
+
+// Replay makes the server answer requests with the responses in a recording
+// made with a record.Writer, in the recorded order, instead of using the
+// handlers. The stream updates recorded before each response are pushed
+// before it is sent. The recorded responses are served even if the requests
+// differ from the recording; see ReplayErr.
+func (s *Server) Replay(entries []record.Entry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.replay = newReplay(entries)
+}
+
+// ReplayFile reads a recording and replays it. See Replay.
+func (s *Server) ReplayFile(r io.Reader) error {
+	entries, err := record.Read(r)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	s.Replay(entries)
+	return nil
+}
+
+// ReplayErr gets the first difference between the requests received and the
+// recording being replayed, if any.
+func (s *Server) ReplayErr() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.replay == nil {
+		return nil
+	}
+	return s.replay.err
+}
+
+// RequireReplayed fails the test if the requests received differ from the
+// recording being replayed, or if not every recorded response was served.
+func (s *Server) RequireReplayed(tb testing.TB) {
+	tb.Helper()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.replay == nil {
+		tb.Fatalf("No recording is being replayed")
+	}
+	if s.replay.err != nil {
+		tb.Fatalf("Replay failed: %v", s.replay.err)
+	}
+	if s.replay.received < len(s.replay.responses) {
+		tb.Fatalf("Only %v of %v recorded requests were received", s.replay.received, len(s.replay.responses))
+	}
+}
+
+// replayRequest answers a request from the recording being replayed, and
+// pushes the stream updates recorded around the response. Updates that
+// followed the response are pushed with the delays between them that were
+// recorded, so that clients see them as they did originally.
+func (s *Server) replayRequest(conn msgConn, req *types.Request) error {
+	s.mu.Lock()
+	resp, before, after := s.replay.next(req)
+	s.mu.Unlock()
+
+	if err := s.pushUpdates(before); err != nil {
+		return tracerr.Wrap(err)
+	}
+	out, err := proto.Marshal(resp.Response)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	if err := conn.WriteMessage(out); err != nil {
+		return tracerr.Wrap(err)
+	}
+
+	last := resp.Time
+	for _, update := range after {
+		time.Sleep(update.Time.Sub(last))
+		last = update.Time
+		if err := s.pushUpdates([]record.Entry{update}); err != nil {
+			return tracerr.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// pushUpdates sends recorded stream updates to all stream connections.
+func (s *Server) pushUpdates(updates []record.Entry) error {
+	for _, update := range updates {
+		out, err := proto.Marshal(update.StreamUpdate)
+		if err != nil {
+			return tracerr.Wrap(err)
+		}
+		s.mu.Lock()
+		for _, conn := range s.streamConns {
+			if err := conn.WriteMessage(out); err != nil {
+				s.mu.Unlock()
+				return tracerr.Wrap(err)
+			}
+		}
+		s.mu.Unlock()
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package krpctest
+
+import (
+	"io"
+	"sync"
+
+	"github.com/atburke/krpc-go/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/ztrue/tracerr"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Field numbers of the messages in MultiplexedResponse.
+const (
+	multiplexedResponse     = 1
+	multiplexedStreamUpdate = 2
+)
+
+// ServeSerial serves kRPC's SerialIO protocol over rw, such as the master
+// end of a pty, in the background. rw is closed when the connection fails
+// after the handshake, or when the server is closed.
+func (s *Server) ServeSerial(rw io.ReadWriteCloser) {
+	s.mu.Lock()
+	s.conns = append(s.conns, rw)
+	s.mu.Unlock()
+	go s.serveSerial(&serialConn{rw: rw})
+}
+
+func (s *Server) serveSerial(conn *serialConn) {
+	in, err := receive(conn.rw)
+	if err != nil {
+		return
+	}
+	var resp types.ConnectionResponse
+	var req types.MultiplexedRequest
+	if err := proto.Unmarshal(in, &req); err != nil || req.ConnectionRequest == nil {
+		resp.Status = types.ConnectionResponse_MALFORMED_MESSAGE
+		resp.Message = "Expected a connection request"
+	} else if req.ConnectionRequest.Type != types.ConnectionRequest_RPC {
+		resp.Status = types.ConnectionResponse_WRONG_TYPE
+		resp.Message = "Connection request has the wrong type"
+	} else {
+		resp.ClientIdentifier, resp.Status, resp.Message = s.admit(types.ConnectionRequest_RPC, nil)
+	}
+
+	// The connection response isn't multiplexed. Keep the port open after
+	// rejecting the connection, since closing a pty discards any data the
+	// other end hasn't read yet.
+	out, err := proto.Marshal(&resp)
+	if err != nil {
+		return
+	}
+	if err := send(conn.rw, out); err != nil || resp.Status != types.ConnectionResponse_OK {
+		return
+	}
+
+	s.mu.Lock()
+	s.streamConns = append(s.streamConns, &serialChannel{conn, multiplexedStreamUpdate})
+	s.mu.Unlock()
+	s.serveRequests(&serialChannel{conn, multiplexedResponse}, resp.ClientIdentifier)
+	conn.rw.Close()
+}
+
+// serialConn is a SerialIO connection, which carries RPCs and stream updates
+// over a single byte stream.
+type serialConn struct {
+	rw io.ReadWriteCloser
+	// wmu is held while writing a message.
+	wmu sync.Mutex
+}
+
+// serialChannel sends messages as one of the fields of MultiplexedResponse.
+type serialChannel struct {
+	*serialConn
+	num protowire.Number
+}
+
+// WriteMessage sends a message wrapped in a MultiplexedResponse.
+func (c *serialChannel) WriteMessage(data []byte) error {
+	out := protowire.AppendTag(nil, c.num, protowire.BytesType)
+	out = protowire.AppendBytes(out, data)
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	return tracerr.Wrap(send(c.rw, out))
+}
+
+// ReadMessage receives the request in a MultiplexedRequest.
+func (c *serialChannel) ReadMessage() ([]byte, error) {
+	in, err := receive(c.rw)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	var req types.MultiplexedRequest
+	if err := proto.Unmarshal(in, &req); err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	if req.Request == nil {
+		return nil, tracerr.Errorf("Expected a request")
+	}
+	out, err := proto.Marshal(req.Request)
+	return out, tracerr.Wrap(err)
+}
+
+// Close closes the connection.
+func (c *serialChannel) Close() error {
+	return tracerr.Wrap(c.rw.Close())
+}
@@ -0,0 +1,651 @@
+// Package krpctest provides a fake kRPC server for testing code that uses
+// krpc-go without a running game.
+package krpctest
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/atburke/krpc-go/internal/websocket"
+	"github.com/atburke/krpc-go/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/ztrue/tracerr"
+)
+
+// DefaultVersion is the version reported by KRPC.GetStatus unless set with
+// SetVersion.
+const DefaultVersion = "0.5.4"
+
+// ErrNoResponse can be returned by a handler to never respond to a request,
+// as if the server were stuck.
+var ErrNoResponse = errors.New("no response")
+
+// Handler handles a procedure call and returns the encoded return value. If
+// it returns a *types.Error, the error is sent to the client as is. Other
+// errors are sent as a *types.Error with the error's message as the
+// description. Handlers may be called concurrently.
+type Handler func(call *types.ProcedureCall) ([]byte, error)
+
+// Server is a fake kRPC server. It handles the connection handshakes and
+// the KRPC service's GetStatus, AddStream, RemoveStream, StartStream,
+// SetStreamRate and AddEvent procedures, and passes all other calls to the
+// registered handlers. Events are added as streams, which tests push values
+// to like any other stream.
+type Server struct {
+	rpc, stream net.Listener
+
+	mu                sync.Mutex
+	closed            bool
+	version           string
+	handshakeStatuses map[types.ConnectionRequest_Type]types.ConnectionResponse_Status
+	handlers          map[string]Handler
+	defaultHandler    Handler
+	clientIDs         map[string]bool
+	conns             []io.Closer
+	streamConns       []msgConn
+	webSocket         bool
+	calls             []*types.ProcedureCall
+	numRequests       int
+	nextStreamID      uint64
+	streams           map[uint64]*types.ProcedureCall
+	replay            *replay
+}
+
+// NewServer starts a server listening on random local ports. The server is
+// closed when the test finishes.
+func NewServer(tb testing.TB) *Server {
+	tb.Helper()
+	return newServer(tb, false)
+}
+
+// NewWebSocketServer starts a server that uses kRPC's WebSocket protocol
+// instead of TCP. See NewServer.
+func NewWebSocketServer(tb testing.TB) *Server {
+	tb.Helper()
+	return newServer(tb, true)
+}
+
+func newServer(tb testing.TB, webSocket bool) *Server {
+	tb.Helper()
+	rpc, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		tb.Fatalf("Failed to listen: %v", err)
+	}
+	stream, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		rpc.Close()
+		tb.Fatalf("Failed to listen: %v", err)
+	}
+	s := &Server{
+		rpc:               rpc,
+		stream:            stream,
+		webSocket:         webSocket,
+		version:           DefaultVersion,
+		handshakeStatuses: make(map[types.ConnectionRequest_Type]types.ConnectionResponse_Status),
+		handlers:          make(map[string]Handler),
+		clientIDs:         make(map[string]bool),
+		streams:           make(map[uint64]*types.ProcedureCall),
+	}
+	tb.Cleanup(s.Close)
+	go s.accept(rpc, s.serveRPC)
+	go s.accept(stream, s.serveStream)
+	return s
+}
+
+// Host gets the host the server listens on.
+func (s *Server) Host() string {
+	host, _, _ := net.SplitHostPort(s.rpc.Addr().String())
+	return host
+}
+
+// RPCPort gets the port of the RPC server.
+func (s *Server) RPCPort() string {
+	_, port, _ := net.SplitHostPort(s.rpc.Addr().String())
+	return port
+}
+
+// StreamPort gets the port of the stream server.
+func (s *Server) StreamPort() string {
+	_, port, _ := net.SplitHostPort(s.stream.Addr().String())
+	return port
+}
+
+// Pipe connects a client to the server in memory with net.Pipe. It returns
+// the client's ends of the RPC and stream connections.
+func (s *Server) Pipe() (rpc, stream net.Conn) {
+	rpcClient, rpcServer := net.Pipe()
+	streamClient, streamServer := net.Pipe()
+	s.mu.Lock()
+	s.conns = append(s.conns, rpcServer, streamServer)
+	s.mu.Unlock()
+	go s.serveRPC(rpcServer)
+	go s.serveStream(streamServer)
+	return rpcClient, streamClient
+}
+
+// Close stops the server and closes all connections.
+func (s *Server) Close() {
+	s.mu.Lock()
+	s.closed = true
+	s.mu.Unlock()
+	s.rpc.Close()
+	s.stream.Close()
+	s.DropConnections()
+}
+
+// DropConnections closes all open connections, as if the server restarted.
+// Streams are removed, since they belong to the closed connections.
+func (s *Server) DropConnections() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, conn := range s.conns {
+		conn.Close()
+	}
+	s.conns = nil
+	s.streamConns = nil
+	s.streams = make(map[uint64]*types.ProcedureCall)
+}
+
+// SetVersion sets the version reported by KRPC.GetStatus.
+func (s *Server) SetVersion(version string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.version = version
+}
+
+// SetHandshakeStatus sets the status sent in response to connection requests
+// of the given type, so that connections can be rejected.
+func (s *Server) SetHandshakeStatus(typ types.ConnectionRequest_Type, status types.ConnectionResponse_Status) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handshakeStatuses[typ] = status
+}
+
+// Handle registers the handler for a procedure. Procedures of the KRPC
+// service handled by the server can be overridden.
+func (s *Server) Handle(service, procedure string, handler Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[service+"."+procedure] = handler
+}
+
+// HandleError registers a handler that always fails with err.
+func (s *Server) HandleError(service, procedure string, err *types.Error) {
+	s.Handle(service, procedure, func(*types.ProcedureCall) ([]byte, error) {
+		return nil, err
+	})
+}
+
+// HandleDefault registers the handler for calls that no other handler
+// handles. Without it, such calls fail.
+func (s *Server) HandleDefault(handler Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.defaultHandler = handler
+}
+
+// Calls gets every call received, in order.
+func (s *Server) Calls() []*types.ProcedureCall {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*types.ProcedureCall(nil), s.calls...)
+}
+
+// CallsTo gets the calls received for a procedure, in order.
+func (s *Server) CallsTo(service, procedure string) []*types.ProcedureCall {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var calls []*types.ProcedureCall
+	for _, call := range s.calls {
+		if call.Service == service && call.Procedure == procedure {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// RequireCalled fails the test if a procedure hasn't been called, and
+// returns the latest call otherwise.
+func (s *Server) RequireCalled(tb testing.TB, service, procedure string) *types.ProcedureCall {
+	tb.Helper()
+	calls := s.CallsTo(service, procedure)
+	if len(calls) == 0 {
+		tb.Fatalf("%v.%v was not called", service, procedure)
+	}
+	return calls[len(calls)-1]
+}
+
+// RequireNotCalled fails the test if a procedure has been called.
+func (s *Server) RequireNotCalled(tb testing.TB, service, procedure string) {
+	tb.Helper()
+	if calls := s.CallsTo(service, procedure); len(calls) > 0 {
+		tb.Fatalf("%v.%v was called %v time(s)", service, procedure, len(calls))
+	}
+}
+
+// Requests gets the number of requests received. A request can contain
+// several calls.
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.numRequests
+}
+
+// StreamIDs gets the IDs of the streams that have been added, in order.
+func (s *Server) StreamIDs() []uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ids := make([]uint64, 0, len(s.streams))
+	for id := range s.streams {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// StreamID gets the ID of a stream that has been added for a procedure.
+func (s *Server) StreamID(service, procedure string) (uint64, bool) {
+	for _, id := range s.StreamIDs() {
+		call := s.StreamCall(id)
+		if call != nil && call.Service == service && call.Procedure == procedure {
+			return id, true
+		}
+	}
+	return 0, false
+}
+
+// StreamCall gets the call a stream was added for, or nil if there is no
+// such stream.
+func (s *Server) StreamCall(id uint64) *types.ProcedureCall {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.streams[id]
+}
+
+// Push sends a new value for a stream to all stream connections.
+func (s *Server) Push(id uint64, value []byte) error {
+	return s.pushResult(id, &types.ProcedureResult{Value: value})
+}
+
+// PushError sends an error for a stream to all stream connections.
+func (s *Server) PushError(id uint64, err *types.Error) error {
+	return s.pushResult(id, &types.ProcedureResult{Error: err})
+}
+
+func (s *Server) pushResult(id uint64, result *types.ProcedureResult) error {
+	out, err := proto.Marshal(&types.StreamUpdate{
+		Results: []*types.StreamResult{{Id: id, Result: result}},
+	})
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	// Write without holding the lock, so that a client that's slow to read its
+	// updates doesn't hold up the rest of the server.
+	s.mu.Lock()
+	conns := append([]msgConn(nil), s.streamConns...)
+	s.mu.Unlock()
+	for _, conn := range conns {
+		if err := conn.WriteMessage(out); err != nil {
+			return tracerr.Wrap(err)
+		}
+	}
+	return nil
+}
+
+func (s *Server) accept(l net.Listener, serve func(net.Conn)) {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		if s.closed {
+			s.mu.Unlock()
+			conn.Close()
+			return
+		}
+		s.conns = append(s.conns, conn)
+		s.mu.Unlock()
+		go serve(conn)
+	}
+}
+
+// handshake performs the handshake for a new connection of the given type.
+// It returns the connection to use for messages and the client identifier if
+// the connection was accepted.
+func (s *Server) handshake(conn net.Conn, typ types.ConnectionRequest_Type) (msgConn, []byte, error) {
+	if s.webSocket {
+		var clientID []byte
+		ws, err := websocket.Accept(conn, func(req *http.Request) error {
+			var requestID []byte
+			if typ == types.ConnectionRequest_STREAM {
+				var err error
+				requestID, err = base64.StdEncoding.DecodeString(req.URL.Query().Get("id"))
+				if err != nil {
+					return tracerr.Wrap(err)
+				}
+			}
+			var status types.ConnectionResponse_Status
+			var msg string
+			clientID, status, msg = s.admit(typ, requestID)
+			if status != types.ConnectionResponse_OK {
+				return tracerr.Errorf("%v", msg)
+			}
+			return nil
+		})
+		return ws, clientID, tracerr.Wrap(err)
+	}
+
+	framed := &framedConn{conn}
+	in, err := framed.ReadMessage()
+	if err != nil {
+		return nil, nil, tracerr.Wrap(err)
+	}
+	var resp types.ConnectionResponse
+	var req types.ConnectionRequest
+	if err := proto.Unmarshal(in, &req); err != nil {
+		resp.Status = types.ConnectionResponse_MALFORMED_MESSAGE
+		resp.Message = err.Error()
+	} else if req.Type != typ {
+		resp.Status = types.ConnectionResponse_WRONG_TYPE
+		resp.Message = "Connection request has the wrong type"
+	} else {
+		resp.ClientIdentifier, resp.Status, resp.Message = s.admit(typ, req.ClientIdentifier)
+	}
+
+	out, err := proto.Marshal(&resp)
+	if err != nil {
+		return nil, nil, tracerr.Wrap(err)
+	}
+	if err := framed.WriteMessage(out); err != nil {
+		return nil, nil, tracerr.Wrap(err)
+	}
+	if resp.Status != types.ConnectionResponse_OK {
+		return nil, nil, tracerr.Errorf("%v", resp.Message)
+	}
+	return framed, resp.ClientIdentifier, nil
+}
+
+// admit decides whether to accept a connection of the given type. Stream
+// connections must present the identifier of an RPC connection. It returns
+// the client identifier of the connection.
+func (s *Server) admit(typ types.ConnectionRequest_Type, clientID []byte) ([]byte, types.ConnectionResponse_Status, string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if status := s.handshakeStatuses[typ]; status != types.ConnectionResponse_OK {
+		return nil, status, "Connection rejected"
+	}
+	if typ == types.ConnectionRequest_STREAM {
+		if !s.clientIDs[string(clientID)] {
+			return nil, types.ConnectionResponse_MALFORMED_MESSAGE, "Unknown client identifier"
+		}
+		return clientID, types.ConnectionResponse_OK, ""
+	}
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, types.ConnectionResponse_MALFORMED_MESSAGE, err.Error()
+	}
+	s.clientIDs[string(id)] = true
+	return id, types.ConnectionResponse_OK, ""
+}
+
+func (s *Server) serveRPC(conn net.Conn) {
+	defer conn.Close()
+	mc, clientID, err := s.handshake(conn, types.ConnectionRequest_RPC)
+	if err != nil {
+		return
+	}
+	s.serveRequests(mc, clientID)
+}
+
+// serveRequests responds to the requests sent over an RPC connection until
+// the connection fails.
+func (s *Server) serveRequests(mc msgConn, clientID []byte) {
+	for {
+		in, err := mc.ReadMessage()
+		if err != nil {
+			return
+		}
+		var req types.Request
+		if err := proto.Unmarshal(in, &req); err != nil {
+			return
+		}
+		s.mu.Lock()
+		s.numRequests++
+		s.calls = append(s.calls, req.Calls...)
+		replaying := s.replay != nil
+		s.mu.Unlock()
+		if replaying {
+			if err := s.replayRequest(mc, &req); err != nil {
+				return
+			}
+			continue
+		}
+
+		resp, err := s.respond(clientID, &req)
+		if errors.Is(err, ErrNoResponse) {
+			continue
+		}
+		if err != nil {
+			return
+		}
+		out, err := proto.Marshal(resp)
+		if err != nil {
+			return
+		}
+		if err := mc.WriteMessage(out); err != nil {
+			return
+		}
+	}
+}
+
+// respond gets the response to a request from the handlers. It returns
+// ErrNoResponse if no response should be sent.
+func (s *Server) respond(clientID []byte, req *types.Request) (*types.Response, error) {
+	var resp types.Response
+	for _, call := range req.Calls {
+		result, err := s.call(clientID, call)
+		if err != nil {
+			return nil, err
+		}
+		resp.Results = append(resp.Results, result)
+	}
+	return &resp, nil
+}
+
+func (s *Server) serveStream(conn net.Conn) {
+	mc, _, err := s.handshake(conn, types.ConnectionRequest_STREAM)
+	if err != nil {
+		conn.Close()
+		return
+	}
+	s.mu.Lock()
+	s.streamConns = append(s.streamConns, mc)
+	s.mu.Unlock()
+}
+
+// call performs a procedure call. The only error it returns is
+// ErrNoResponse; other errors are put in the result.
+func (s *Server) call(clientID []byte, call *types.ProcedureCall) (*types.ProcedureResult, error) {
+	s.mu.Lock()
+	handler := s.handlers[call.Service+"."+call.Procedure]
+	if handler == nil && call.Service == "KRPC" {
+		handler = s.builtins(clientID)[call.Procedure]
+	}
+	if handler == nil {
+		handler = s.defaultHandler
+	}
+	s.mu.Unlock()
+
+	if handler == nil {
+		return &types.ProcedureResult{Error: &types.Error{
+			Service:     call.Service,
+			Name:        "RPCException",
+			Description: "Procedure not found: " + call.Service + "." + call.Procedure,
+		}}, nil
+	}
+	value, err := handler(call)
+	if errors.Is(err, ErrNoResponse) {
+		return nil, err
+	}
+	if err != nil {
+		var serverErr *types.Error
+		if !errors.As(err, &serverErr) {
+			serverErr = &types.Error{Service: call.Service, Description: err.Error()}
+		}
+		return &types.ProcedureResult{Error: serverErr}, nil
+	}
+	return &types.ProcedureResult{Value: value}, nil
+}
+
+// builtins gets the handlers for the KRPC procedures implemented by the
+// server, for a client with the given identifier.
+func (s *Server) builtins(clientID []byte) map[string]Handler {
+	return map[string]Handler{
+		"GetClientID": func(*types.ProcedureCall) ([]byte, error) {
+			return append(proto.EncodeVarint(uint64(len(clientID))), clientID...), nil
+		},
+		"GetStatus":     s.getStatus,
+		"AddStream":     s.addStream,
+		"RemoveStream":  s.removeStream,
+		"StartStream":   s.checkStream,
+		"SetStreamRate": s.checkStream,
+		"AddEvent":      s.addEvent,
+	}
+}
+
+func (s *Server) getStatus(*types.ProcedureCall) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return proto.Marshal(&types.Status{Version: s.version})
+}
+
+func (s *Server) addStream(call *types.ProcedureCall) ([]byte, error) {
+	if len(call.Arguments) == 0 {
+		return nil, tracerr.Errorf("Missing procedure call argument")
+	}
+	var streamCall types.ProcedureCall
+	if err := proto.Unmarshal(call.Arguments[0].Value, &streamCall); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// Like kRPC, identical calls share a stream.
+	for id, existing := range s.streams {
+		if proto.Equal(existing, &streamCall) {
+			return proto.Marshal(&types.Stream{Id: id})
+		}
+	}
+	s.nextStreamID++
+	id := s.nextStreamID
+	s.streams[id] = &streamCall
+	return proto.Marshal(&types.Stream{Id: id})
+}
+
+func (s *Server) removeStream(call *types.ProcedureCall) ([]byte, error) {
+	id, err := streamIDArg(call)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.streams, id)
+	return nil, nil
+}
+
+func (s *Server) addEvent(call *types.ProcedureCall) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextStreamID++
+	id := s.nextStreamID
+	s.streams[id] = call
+	return proto.Marshal(&types.Event{Stream: &types.Stream{Id: id}})
+}
+
+// checkStream handles procedures that change a stream, which the server
+// doesn't simulate, by checking that the stream exists.
+func (s *Server) checkStream(call *types.ProcedureCall) ([]byte, error) {
+	id, err := streamIDArg(call)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.streams[id]; !ok {
+		return nil, tracerr.Errorf("No stream with ID %v", id)
+	}
+	return nil, nil
+}
+
+// streamIDArg gets the stream ID passed as the first argument of a call.
+func streamIDArg(call *types.ProcedureCall) (uint64, error) {
+	if len(call.Arguments) == 0 {
+		return 0, tracerr.Errorf("Missing stream ID argument")
+	}
+	id, n := proto.DecodeVarint(call.Arguments[0].Value)
+	if n == 0 {
+		return 0, tracerr.Errorf("Invalid stream ID")
+	}
+	return id, nil
+}
+
+// msgConn is a connection that carries whole messages.
+type msgConn interface {
+	WriteMessage(data []byte) error
+	ReadMessage() ([]byte, error)
+	Close() error
+}
+
+// framedConn carries messages over a byte stream, each prefixed with its
+// varint-encoded length.
+type framedConn struct {
+	net.Conn
+}
+
+// WriteMessage sends a message.
+func (c *framedConn) WriteMessage(data []byte) error {
+	return tracerr.Wrap(send(c.Conn, data))
+}
+
+// ReadMessage receives a message.
+func (c *framedConn) ReadMessage() ([]byte, error) {
+	data, err := receive(c.Conn)
+	return data, tracerr.Wrap(err)
+}
+
+// send writes a length-prefixed message.
+func send(w io.Writer, data []byte) error {
+	_, err := w.Write(append(proto.EncodeVarint(uint64(len(data))), data...))
+	return err
+}
+
+// receive reads a length-prefixed message.
+func receive(r io.Reader) ([]byte, error) {
+	var header []byte
+	b := make([]byte, 1)
+	for {
+		if _, err := io.ReadFull(r, b); err != nil {
+			return nil, err
+		}
+		header = append(header, b[0])
+		if b[0] < 0x80 {
+			break
+		}
+		if len(header) >= 10 {
+			return nil, tracerr.Errorf("Message length is too long")
+		}
+	}
+	length, _ := proto.DecodeVarint(header)
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
@@ -0,0 +1,199 @@
+package krpctest_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	krpcgo "github.com/atburke/krpc-go"
+	"github.com/atburke/krpc-go/krpc"
+	"github.com/atburke/krpc-go/krpctest"
+	"github.com/atburke/krpc-go/lib/encode"
+	"github.com/atburke/krpc-go/lib/record"
+	"github.com/atburke/krpc-go/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer(t *testing.T) {
+	server := krpctest.NewServer(t)
+	server.Handle("KRPC", "get_Paused", func(call *types.ProcedureCall) ([]byte, error) {
+		return encode.Marshal(true)
+	})
+	server.HandleError("KRPC", "set_Paused", &types.Error{
+		Service:     "KRPC",
+		Name:        "InvalidOperationException",
+		Description: "Can't pause right now",
+	})
+
+	client := krpcgo.NewKRPCClient(krpcgo.KRPCClientConfig{
+		Host:       server.Host(),
+		RPCPort:    server.RPCPort(),
+		StreamPort: server.StreamPort(),
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, client.Connect(ctx))
+	defer client.Close()
+	k := krpc.New(client)
+
+	status, err := k.GetStatus()
+	require.NoError(t, err)
+	require.Equal(t, krpctest.DefaultVersion, status.Version)
+
+	paused, err := k.Paused()
+	require.NoError(t, err)
+	require.True(t, paused)
+
+	err = k.SetPaused(false)
+	var invalidOp *krpc.ErrInvalidOperation
+	require.True(t, errors.As(err, &invalidOp))
+	require.True(t, errors.As(err, &krpc.ErrInvalidOperation{}))
+	call := server.RequireCalled(t, "KRPC", "set_Paused")
+	var value bool
+	require.NoError(t, encode.Unmarshal(call.Arguments[0].Value, &value))
+	require.False(t, value)
+
+	_, err = k.CurrentGameScene()
+	require.Error(t, err)
+
+	stream, err := k.PausedStream()
+	require.NoError(t, err)
+	id, ok := server.StreamID("KRPC", "get_Paused")
+	require.True(t, ok)
+	require.Eventually(t, func() bool {
+		out, err := encode.Marshal(true)
+		require.NoError(t, err)
+		require.NoError(t, server.Push(id, out))
+		select {
+		case paused := <-stream.C:
+			return paused
+		case <-time.After(10 * time.Millisecond):
+			return false
+		}
+	}, time.Second, 10*time.Millisecond)
+
+	stream.Close()
+	require.Eventually(t, func() bool {
+		return len(server.StreamIDs()) == 0
+	}, time.Second, 10*time.Millisecond)
+	server.RequireCalled(t, "KRPC", "RemoveStream")
+}
+
+func TestReplay(t *testing.T) {
+	// run is a controller that waits for the game to be paused.
+	run := func(server *krpctest.Server, recorder krpcgo.Recorder) {
+		client := krpcgo.NewKRPCClient(krpcgo.KRPCClientConfig{
+			Host:       server.Host(),
+			RPCPort:    server.RPCPort(),
+			StreamPort: server.StreamPort(),
+			Recorder:   recorder,
+		})
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		require.NoError(t, client.Connect(ctx))
+		defer client.Close()
+		k := krpc.New(client)
+
+		paused, err := k.Paused()
+		require.NoError(t, err)
+		require.False(t, paused)
+		stream, err := k.PausedStream()
+		require.NoError(t, err)
+		defer stream.Close()
+		for !paused {
+			select {
+			case paused = <-stream.C:
+			case <-time.After(time.Second):
+				require.Fail(t, "game was never paused")
+			}
+		}
+	}
+
+	// Record a run against a server that pauses the game a little later.
+	server := krpctest.NewServer(t)
+	server.Handle("KRPC", "get_Paused", func(call *types.ProcedureCall) ([]byte, error) {
+		return encode.Marshal(false)
+	})
+	go func() {
+		id, ok := server.StreamID("KRPC", "get_Paused")
+		for !ok {
+			time.Sleep(time.Millisecond)
+			id, ok = server.StreamID("KRPC", "get_Paused")
+		}
+		for _, paused := range []bool{false, false, true} {
+			time.Sleep(10 * time.Millisecond)
+			out, _ := encode.Marshal(paused)
+			server.Push(id, out)
+		}
+	}()
+	var buf bytes.Buffer
+	recorder := record.NewWriter(&buf)
+	run(server, recorder)
+	require.NoError(t, recorder.Err())
+
+	// Replay it without any handlers.
+	replay := krpctest.NewServer(t)
+	require.NoError(t, replay.ReplayFile(&buf))
+	run(replay, nil)
+	replay.RequireReplayed(t)
+	require.NotEmpty(t, replay.CallsTo("KRPC", "RemoveStream"))
+}
+
+// handshake connects a raw connection to the server, returning the client
+// identifier it's given.
+func handshake(t *testing.T, conn net.Conn, req *types.ConnectionRequest) []byte {
+	t.Helper()
+	out, err := proto.Marshal(req)
+	require.NoError(t, err)
+	_, err = conn.Write(append(proto.EncodeVarint(uint64(len(out))), out...))
+	require.NoError(t, err)
+	r := bufio.NewReader(conn)
+	length, err := binary.ReadUvarint(r)
+	require.NoError(t, err)
+	in := make([]byte, length)
+	_, err = io.ReadFull(r, in)
+	require.NoError(t, err)
+	var resp types.ConnectionResponse
+	require.NoError(t, proto.Unmarshal(in, &resp))
+	require.Equal(t, types.ConnectionResponse_OK, resp.Status)
+	return resp.ClientIdentifier
+}
+
+func TestPushToSlowClient(t *testing.T) {
+	server := krpctest.NewServer(t)
+	rpcConn, streamConn := server.Pipe()
+	clientID := handshake(t, rpcConn, &types.ConnectionRequest{Type: types.ConnectionRequest_RPC})
+	handshake(t, streamConn, &types.ConnectionRequest{
+		Type:             types.ConnectionRequest_STREAM,
+		ClientIdentifier: clientID,
+	})
+
+	// Read the start of an update, then stop reading, so the push is left
+	// part way through writing it.
+	buf := make([]byte, 1)
+	require.Eventually(t, func() bool {
+		go server.Push(1, []byte("value"))
+		if err := streamConn.SetReadDeadline(time.Now().Add(10 * time.Millisecond)); err != nil {
+			return false
+		}
+		_, err := streamConn.Read(buf)
+		return err == nil
+	}, time.Second, time.Millisecond)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		server.Calls()
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Server held up by a client that isn't reading updates")
+	}
+}
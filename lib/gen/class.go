@@ -28,7 +28,7 @@ func GenerateClass(f *jen.File, class *types.Class) error {
 	f.Comment(fmt.Sprintf("%v creates a new %v.", constructorName, className))
 	f.Func().Id(constructorName).Params(
 		jen.Id("id").Uint64(),
-		jen.Id("client").Op("*").Qual(krpcPkg, "KRPCClient"),
+		jen.Id("client").Qual(krpcPkg, "Caller"),
 	).Op("*").Id(className).Block(
 		jen.Id("c").Op(":=").Op("&").Id(className).Values(jen.Dict{
 			jen.Id("BaseClass"): jen.Qual(servicePkg, "BaseClass").Values(jen.Dict{
@@ -9,8 +9,10 @@ import (
 	"github.com/ztrue/tracerr"
 )
 
-// GenerateException generates an error for a given exception definition.
-func GenerateException(f *jen.File, exception *types.Exception) error {
+// GenerateException generates an error for a given exception definition, and
+// registers it so that the client returns it when the service throws the
+// exception.
+func GenerateException(f *jen.File, serviceName string, exception *types.Exception) error {
 	// Names are given in the format XYZException. We want the more go-like
 	// ErrXYZ.
 	exceptionName := "Err" + exception.Name[:len(exception.Name)-len("exception")]
@@ -44,5 +46,19 @@ func GenerateException(f *jen.File, exception *types.Exception) error {
 		jen.Return(jen.Err().Dot("msg")),
 	)
 
+	// Register the error with the client.
+	f.Line()
+	f.Func().Id("init").Params().Block(
+		jen.Qual(krpcPkg, "RegisterException").Call(
+			jen.Lit(serviceName),
+			jen.Lit(exception.Name),
+			jen.Func().Params(jen.Id("msg").String()).Error().Block(
+				jen.Return(jen.Op("&").Id(exceptionName).Values(jen.Dict{
+					jen.Id("msg"): jen.Id("msg"),
+				})),
+			),
+		),
+	)
+
 	return nil
 }
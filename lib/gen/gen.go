@@ -25,9 +25,14 @@ func WrapDocComment(s string) string {
 }
 
 // GenerateService generates a service.
-func GenerateService(f *jen.File, service *types.Service) error {
+func GenerateService(f *jen.File, service *types.Service, opts ...GenerateOption) error {
+	var cfg GenerateConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
 	for _, exception := range service.Exceptions {
-		if err := GenerateException(f, exception); err != nil {
+		if err := GenerateException(f, service.Name, exception); err != nil {
 			return tracerr.Wrap(err)
 		}
 	}
@@ -49,12 +54,12 @@ func GenerateService(f *jen.File, service *types.Service) error {
 
 	f.Comment(WrapDocComment(serviceDocs))
 	f.Type().Id(service.Name).Struct(
-		jen.Id("Client").Op("*").Qual(krpcPkg, "KRPCClient"),
+		jen.Id("Client").Qual(krpcPkg, "Caller"),
 	)
 
 	f.Comment(fmt.Sprintf("New creates a new %v.", service.Name))
 	f.Func().Id("New").Params(
-		jen.Id("client").Op("*").Qual(krpcPkg, "KRPCClient"),
+		jen.Id("client").Qual(krpcPkg, "Caller"),
 	).Op("*").Id(service.Name).Block(
 		jen.Return(jen.Op("&").Id(service.Name).Values(jen.Dict{
 			jen.Id("Client"): jen.Id("client"),
@@ -62,9 +67,12 @@ func GenerateService(f *jen.File, service *types.Service) error {
 	)
 
 	for _, procedure := range service.Procedures {
-		if err := GenerateProcedure(f, service.Name, procedure); err != nil {
+		if err := GenerateProcedure(f, service.Name, procedure, opts...); err != nil {
 			return tracerr.Wrap(err)
 		}
 	}
+	if cfg.CallBuilders {
+		GenerateReturnTypes(f, service.Name, service.Procedures)
+	}
 	return nil
 }
@@ -6,7 +6,6 @@ package gentest
 import (
 	krpcgo "github.com/atburke/krpc-go"
 	encode "github.com/atburke/krpc-go/lib/encode"
-	krpc "github.com/atburke/krpc-go/krpc"
 	types "github.com/atburke/krpc-go/types"
 	tracerr "github.com/ztrue/tracerr"
 )
@@ -52,7 +51,7 @@ func (s *MyService) MyProcedure(param1 uint64, param2 string) (bool, error) {
 // MyProcedureStream - test procedure generation.
 //
 // Allowed game scenes: FLIGHT.
-func (s *MyService) MyProcedureStream(param1 uint64, param2 string) (*krpcgo.Stream[bool], error) {
+func (s *MyService) MyProcedureStream(param1 uint64, param2 string, opts ...krpcgo.StreamOption) (*krpcgo.Stream[bool], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
@@ -75,19 +74,14 @@ func (s *MyService) MyProcedureStream(param1 uint64, param2 string) (*krpcgo.Str
 		Position: uint32(0x1),
 		Value: argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte)bool {
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (bool, error) {
 		var value bool
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
 	return stream, nil
 }
@@ -135,3 +129,292 @@ func (s *MyClass) SetMyProperty(param1 types.Tuple2[string, uint64]) error {
 	return nil
 }
 `
+
+const testClassGetterWithContext = `
+package gentest
+
+import (
+	"context"
+	encode "github.com/atburke/krpc-go/lib/encode"
+	types "github.com/atburke/krpc-go/types"
+	tracerr "github.com/ztrue/tracerr"
+)
+
+// MyProperty - test context variant generation.
+//
+// Allowed game scenes: any.
+func (s *MyClass) MyProperty() (*MyClass, error) {
+	return s.MyPropertyContext(context.Background())
+}
+
+// MyPropertyContext - test context variant generation.
+//
+// Allowed game scenes: any.
+func (s *MyClass) MyPropertyContext(ctx context.Context) (*MyClass, error) {
+	var err error
+	var argBytes []byte
+	var vv MyClass
+	request := &types.ProcedureCall{
+		Procedure: "MyClass_get_MyProperty",
+		Service: "MyService",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value: argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+`
+
+const testProcedureWithAsync = `
+package gentest
+
+import (
+	krpcgo "github.com/atburke/krpc-go"
+	encode "github.com/atburke/krpc-go/lib/encode"
+	types "github.com/atburke/krpc-go/types"
+	tracerr "github.com/ztrue/tracerr"
+)
+
+// MyProcedure - test async variant generation of <see
+// cref="M:MyService.MyProcedure" />.
+//
+// Allowed game scenes: any.
+func (s *MyService) MyProcedure(param1 float64) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "MyProcedure",
+		Service: "MyService",
+	}
+	argBytes, err = encode.Marshal(param1)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value: argBytes,
+	})
+	_, err = s.Client.Call(request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// MyProcedureAsync - test async variant generation of <see
+// cref="M:MyService.MyProcedure" />.
+//
+// Allowed game scenes: any.
+func (s *MyService) MyProcedureAsync(param1 float64) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "MyProcedure",
+		Service: "MyService",
+	}
+	argBytes, err = encode.Marshal(param1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value: argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+`
+
+const testEventProcedure = `
+package gentest
+
+import (
+	krpcgo "github.com/atburke/krpc-go"
+	encode "github.com/atburke/krpc-go/lib/encode"
+	types "github.com/atburke/krpc-go/types"
+	tracerr "github.com/ztrue/tracerr"
+)
+
+// MyEvent - test event generation.
+//
+// Allowed game scenes: any.
+func (s *MyService) MyEvent() (*krpcgo.Event, error) {
+	var err error
+	var vv types.Event
+	request := &types.ProcedureCall{
+		Procedure: "MyEvent",
+		Service: "MyService",
+	}
+	result, err := s.Client.Call(request)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	return krpcgo.NewEvent(s.Client, &vv)
+}
+`
+
+const testEventProcedureWithContext = `
+package gentest
+
+import (
+	"context"
+	krpcgo "github.com/atburke/krpc-go"
+	encode "github.com/atburke/krpc-go/lib/encode"
+	types "github.com/atburke/krpc-go/types"
+	tracerr "github.com/ztrue/tracerr"
+)
+
+// MyEvent - test event generation, like <see cref="M:MyService.MyEvent" />.
+//
+// Allowed game scenes: any.
+func (s *MyService) MyEvent() (*krpcgo.Event, error) {
+	return s.MyEventContext(context.Background())
+}
+
+// MyEventContext - test event generation, like <see cref="M:MyService.MyEvent"
+// />.
+//
+// Allowed game scenes: any.
+func (s *MyService) MyEventContext(ctx context.Context) (*krpcgo.Event, error) {
+	var err error
+	var vv types.Event
+	request := &types.ProcedureCall{
+		Procedure: "MyEvent",
+		Service:   "MyService",
+	}
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	return krpcgo.NewEventContext(ctx, s.Client, &vv)
+}
+`
+
+const testProcedureWithCallBuilder = `
+package gentest
+
+import (
+	krpcgo "github.com/atburke/krpc-go"
+	encode "github.com/atburke/krpc-go/lib/encode"
+	types "github.com/atburke/krpc-go/types"
+	tracerr "github.com/ztrue/tracerr"
+)
+
+// MyProcedure - test call builder generation.
+//
+// Allowed game scenes: any.
+func (s *MyService) MyProcedure(param1 float64) (int32, error) {
+	var err error
+	var argBytes []byte
+	var vv int32
+	request := &types.ProcedureCall{
+		Procedure: "MyProcedure",
+		Service: "MyService",
+	}
+	argBytes, err = encode.Marshal(param1)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value: argBytes,
+	})
+	result, err := s.Client.Call(request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// MyProcedureStream - test call builder generation.
+//
+// Allowed game scenes: any.
+func (s *MyService) MyProcedureStream(param1 float64, opts ...krpcgo.StreamOption) (*krpcgo.Stream[int32], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "MyProcedure",
+		Service: "MyService",
+	}
+	argBytes, err = encode.Marshal(param1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value: argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (int32, error) {
+		var value int32
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// MyProcedureCall builds a call to MyProcedure without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *MyService) MyProcedureCall(param1 float64) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "MyProcedure",
+		Service: "MyService",
+	}
+	argBytes, err = encode.Marshal(param1)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value: argBytes,
+	})
+	return request, nil
+}
+
+// DecodeMyProcedure decodes the result of a call built with MyProcedureCall.
+func (s *MyService) DecodeMyProcedure(result *types.ProcedureResult) (int32, error) {
+	var vv int32
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+`
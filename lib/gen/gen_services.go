@@ -48,7 +48,7 @@ func main() {
 		f.Comment(genWarning)
 		f.Line()
 
-		if err := gen.GenerateService(f, service); err != nil {
+		if err := gen.GenerateService(f, service, gen.WithContextVariants, gen.WithAsyncVariants, gen.WithCallBuilders); err != nil {
 			log.Fatal(err)
 		}
 		dest := fmt.Sprintf("%v/%v.gen.go", serviceName, serviceName)
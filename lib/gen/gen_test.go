@@ -14,6 +14,7 @@ func TestGenerateProcedure(t *testing.T) {
 	tests := []struct {
 		name        string
 		procedure   *types.Procedure
+		opts        []GenerateOption
 		expectedOut string
 	}{
 		{
@@ -77,6 +78,90 @@ func TestGenerateProcedure(t *testing.T) {
 			},
 			expectedOut: testClassSetter,
 		},
+		{
+			name: "class getter with context variant",
+			procedure: &types.Procedure{
+				Name:          "MyClass_get_MyProperty",
+				Documentation: "<summary>Test context variant generation.</summary>",
+				Parameters: []*types.Parameter{
+					{
+						Name: "this",
+						Type: &types.Type{
+							Code:    types.Type_CLASS,
+							Service: "MyService",
+							Name:    "MyClass",
+						},
+					},
+				},
+				ReturnType: &types.Type{
+					Code:    types.Type_CLASS,
+					Service: "MyService",
+					Name:    "MyClass",
+				},
+			},
+			opts:        []GenerateOption{WithContextVariants},
+			expectedOut: testClassGetterWithContext,
+		},
+		{
+			name: "procedure with async variant",
+			procedure: &types.Procedure{
+				Name:          "MyProcedure",
+				Documentation: `<summary>Test async variant generation of <see cref="M:MyService.MyProcedure" />.</summary>`,
+				Parameters: []*types.Parameter{
+					{
+						Name: "param1",
+						Type: &types.Type{
+							Code: types.Type_DOUBLE,
+						},
+					},
+				},
+			},
+			opts:        []GenerateOption{WithAsyncVariants},
+			expectedOut: testProcedureWithAsync,
+		},
+		{
+			name: "procedure returning an event",
+			procedure: &types.Procedure{
+				Name:          "MyEvent",
+				Documentation: "<summary>Test event generation.</summary>",
+				ReturnType: &types.Type{
+					Code: types.Type_EVENT,
+				},
+			},
+			expectedOut: testEventProcedure,
+		},
+		{
+			name: "procedure returning an event with context variant",
+			procedure: &types.Procedure{
+				Name:          "MyEvent",
+				Documentation: `<summary>Test event generation, like <see cref="M:MyService.MyEvent" />.</summary>`,
+				ReturnType: &types.Type{
+					Code: types.Type_EVENT,
+				},
+			},
+			opts:        []GenerateOption{WithContextVariants},
+			expectedOut: testEventProcedureWithContext,
+		},
+		{
+			name: "procedure with call builder",
+			procedure: &types.Procedure{
+				Name:          "MyProcedure",
+				Documentation: "<summary>Test call builder generation.</summary>",
+				Parameters: []*types.Parameter{
+					{
+						Name: "param1",
+						Type: &types.Type{
+							Code: types.Type_DOUBLE,
+						},
+					},
+				},
+				ReturnType: &types.Type{
+					Code: types.Type_SINT32,
+				},
+			},
+			opts:        []GenerateOption{WithCallBuilders},
+			expectedOut: testProcedureWithCallBuilder,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -84,7 +169,7 @@ func TestGenerateProcedure(t *testing.T) {
 			require.NoError(t, err)
 
 			f := jen.NewFile("gentest")
-			require.NoError(t, GenerateProcedure(f, "MyService", tc.procedure))
+			require.NoError(t, GenerateProcedure(f, "MyService", tc.procedure, tc.opts...))
 
 			var out bytes.Buffer
 			require.NoError(t, f.Render(&out))
@@ -107,7 +192,7 @@ type Test struct {
 }
 
 // NewTest creates a new Test.
-func NewTest(id uint64, client *krpcgo.KRPCClient) *Test {
+func NewTest(id uint64, client krpcgo.Caller) *Test {
 	c := &Test{BaseClass: service.BaseClass{Client: client}}
 	c.SetID(id)
 	return c
@@ -191,6 +276,7 @@ func TestGenerateEnum(t *testing.T) {
 const testException = `
 package gentest
 
+import krpcgo "github.com/atburke/krpc-go"
 
 // ErrTest - the exception generating code is being tested.
 type ErrTest struct {
@@ -206,6 +292,12 @@ func NewErrTest(msg string) *ErrTest {
 func (err ErrTest) Error() string {
 	return err.msg
 }
+
+func init() {
+	krpcgo.RegisterException("Test", "TestException", func(msg string) error {
+		return &ErrTest{msg: msg}
+	})
+}
 `
 
 func TestGenerateException(t *testing.T) {
@@ -217,7 +309,41 @@ func TestGenerateException(t *testing.T) {
 		Documentation: "<summary>The exception generating code is being tested.</summary>",
 	}
 	f := jen.NewFile("gentest")
-	require.NoError(t, GenerateException(f, exception))
+	require.NoError(t, GenerateException(f, "Test", exception))
+
+	var out bytes.Buffer
+	require.NoError(t, f.Render(&out))
+	require.Equal(t, string(expectedOut), out.String())
+}
+
+const testReturnTypes = `package gentest
+
+import (
+	krpcgo "github.com/atburke/krpc-go"
+	types "github.com/atburke/krpc-go/types"
+)
+
+func init() {
+	krpcgo.RegisterReturnTypes("Test", map[string]*types.Type{
+		"GetName":  &types.Type{Code: types.Type_STRING},
+		"get_Size": &types.Type{Code: types.Type_SINT32},
+	})
+}
+`
+
+func TestGenerateReturnTypes(t *testing.T) {
+	expectedOut, err := format.Source([]byte(testReturnTypes))
+	require.NoError(t, err)
+
+	procedures := []*types.Procedure{
+		{Name: "get_Size", ReturnType: &types.Type{Code: types.Type_SINT32}},
+		{Name: "GetName", ReturnType: &types.Type{Code: types.Type_STRING}},
+		{Name: "set_Size", Parameters: []*types.Parameter{
+			{Name: "value", Type: &types.Type{Code: types.Type_SINT32}},
+		}},
+	}
+	f := jen.NewFile("gentest")
+	GenerateReturnTypes(f, "Test", procedures)
 
 	var out bytes.Buffer
 	require.NoError(t, f.Render(&out))
@@ -3,6 +3,7 @@ package gen
 import "strings"
 
 const (
+	contextPkg = "context"
 	typesPkg   = "github.com/atburke/krpc-go/types"
 	krpcPkg    = "github.com/atburke/krpc-go"
 	servicePkg = "github.com/atburke/krpc-go/lib/service"
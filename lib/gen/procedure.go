@@ -10,30 +10,96 @@ import (
 	"github.com/ztrue/tracerr"
 )
 
+// GenerateConfig holds options for generating procedures.
+type GenerateConfig struct {
+	// ContextVariants generates a variant of each procedure that takes a
+	// context.Context.
+	ContextVariants bool
+	// AsyncVariants generates a variant of each procedure that returns a
+	// future instead of waiting for the result.
+	AsyncVariants bool
+	// CallBuilders generates a function for each procedure that builds its
+	// call without making it, and one that decodes the call's result. Their
+	// return types are registered with krpcgo.RegisterReturnTypes by
+	// GenerateService.
+	CallBuilders bool
+}
+
+// GenerateOption is an option for generating procedures.
+type GenerateOption func(*GenerateConfig)
+
+// WithContextVariants generates a variant of each procedure, suffixed with
+// "Context", that takes a context.Context as its first parameter. The plain
+// procedure then calls its variant with context.Background().
+func WithContextVariants(cfg *GenerateConfig) {
+	cfg.ContextVariants = true
+}
+
+// WithAsyncVariants generates a variant of each procedure, suffixed with
+// "Async", that returns a krpcgo.Future for the result.
+func WithAsyncVariants(cfg *GenerateConfig) {
+	cfg.AsyncVariants = true
+}
+
+// WithCallBuilders generates a function for each procedure, suffixed with
+// "Call", that builds its call as a *types.ProcedureCall without making it,
+// and for procedures with a return value, one prefixed with "Decode" that
+// decodes the call's result.
+func WithCallBuilders(cfg *GenerateConfig) {
+	cfg.CallBuilders = true
+}
+
 // GenerateProcedure generates a procedure function from a given procedure definition.
-func GenerateProcedure(f *jen.File, serviceName string, procedure *types.Procedure) error {
+func GenerateProcedure(f *jen.File, serviceName string, procedure *types.Procedure, opts ...GenerateOption) error {
+	var cfg GenerateConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
 	var err error
 	switch procedureType := GetProcedureType(procedure.Name); procedureType {
 	case Procedure:
-		err = generateProcedure(f, serviceName, procedure)
+		err = generateProcedure(f, serviceName, procedure, cfg)
 	case ServiceGetter:
-		err = generateServiceGetter(f, serviceName, procedure)
+		err = generateServiceGetter(f, serviceName, procedure, cfg)
 	case ServiceSetter:
-		err = generateServiceSetter(f, serviceName, procedure)
+		err = generateServiceSetter(f, serviceName, procedure, cfg)
 	case ClassMethod:
-		err = generateClassMethod(f, serviceName, procedure)
+		err = generateClassMethod(f, serviceName, procedure, cfg)
 	case StaticClassMethod:
-		err = generateStaticClassMethod(f, serviceName, procedure)
+		err = generateStaticClassMethod(f, serviceName, procedure, cfg)
 	case ClassGetter:
-		err = generateClassGetter(f, serviceName, procedure)
+		err = generateClassGetter(f, serviceName, procedure, cfg)
 	case ClassSetter:
-		err = generateClassSetter(f, serviceName, procedure)
+		err = generateClassSetter(f, serviceName, procedure, cfg)
 	default:
 		return tracerr.Errorf("Unknown procedure type: %v", procedureType)
 	}
 	return tracerr.Wrap(err)
 }
 
+// GenerateReturnTypes generates a single init function that registers the
+// return types of a service's procedures, so that calls built for them can be
+// typed.
+func GenerateReturnTypes(f *jen.File, serviceName string, procedures []*types.Procedure) {
+	table := jen.Dict{}
+	for _, procedure := range procedures {
+		if GetGoType(procedure.ReturnType) == nil {
+			continue
+		}
+		table[jen.Lit(procedure.Name)] = typeValue(procedure.ReturnType)
+	}
+	if len(table) == 0 {
+		return
+	}
+	f.Func().Id("init").Params().Block(
+		jen.Qual(krpcPkg, "RegisterReturnTypes").Call(
+			jen.Lit(serviceName),
+			jen.Map(jen.String()).Op("*").Qual(typesPkg, "Type").Values(table),
+		),
+	)
+}
+
 // formatGameScences formats a list of allowed game scenes as a string.
 func formatGameScenes(gameScenes []types.Procedure_GameScene) string {
 	var scenes []string
@@ -49,11 +115,48 @@ func formatGameScenes(gameScenes []types.Procedure_GameScene) string {
 	return fmt.Sprintf("Allowed game scenes: %v.", sceneString)
 }
 
-// generateProcedureBody generates the function body for a procedure.
-func generateProcedureBody(serviceName string, procedure *types.Procedure) (funcBody []jen.Code, params []jen.Code, returnType *jen.Statement) {
+// generateArguments generates the code to marshal a procedure's parameters
+// into the arguments of the request. For class methods, the first parameter
+// is the receiver, s.
+func generateArguments(procedure *types.Procedure, errCheck jen.Code) []jen.Code {
+	var code []jen.Code
+	_, err := GetClassName(procedure.Name)
+	isClass := err == nil
+	for i, param := range procedure.Parameters {
+		param.Name = utils.SanitizeIdentifier(param.Name)
+		// If this is any kind of class method, use the class itself as the first param
+		if i == 0 && isClass {
+			param.Name = "s"
+		}
+
+		code = append(code,
+			jen.List(jen.Id("argBytes"), jen.Err()).Op("=").Qual(encodePkg, "Marshal").Call(
+				jen.Id(param.Name),
+			),
+			errCheck,
+			jen.Id("request").Dot("Arguments").Op("=").Append(
+				jen.Id("request").Dot("Arguments"),
+				jen.Op("&").Qual(typesPkg, "Argument").Values(jen.Dict{
+					jen.Id("Position"): jen.Lit(uint32(i)),
+					jen.Id("Value"):    jen.Id("argBytes"),
+				}),
+			),
+		)
+	}
+	return code
+}
+
+// generateProcedureBody generates the function body for a procedure. If
+// withContext is set, the procedure is called with a ctx parameter.
+func generateProcedureBody(serviceName string, procedure *types.Procedure, withContext bool) (funcBody []jen.Code, params []jen.Code, args []jen.Code, returnType *jen.Statement) {
 	pkg := getServicePackage(serviceName)
 	returnType = GetGoType(procedure.ReturnType, WithPackage(pkg))
 	retVarType := GetGoType(procedure.ReturnType, WithPackage(pkg), NoPointerForClass)
+	isEvent := isEventType(procedure.ReturnType)
+	if isEvent {
+		// Events are decoded as a message and then opened.
+		retVarType = jen.Qual(typesPkg, "Event")
+	}
 
 	// Define some variables
 	funcBody = []jen.Code{
@@ -90,7 +193,11 @@ func generateProcedureBody(serviceName string, procedure *types.Procedure) (func
 		} else {
 			returnVar = jen.Id("vv")
 		}
-		errReturn = []jen.Code{returnVar, jen.Qual(tracerrPkg, "Wrap").Call(jen.Err())}
+		if isEvent {
+			errReturn = []jen.Code{jen.Nil(), jen.Qual(tracerrPkg, "Wrap").Call(jen.Err())}
+		} else {
+			errReturn = []jen.Code{returnVar, jen.Qual(tracerrPkg, "Wrap").Call(jen.Err())}
+		}
 	} else {
 		errReturn = []jen.Code{jen.Qual(tracerrPkg, "Wrap").Call(jen.Err())}
 	}
@@ -99,34 +206,24 @@ func generateProcedureBody(serviceName string, procedure *types.Procedure) (func
 	)
 
 	// Marshal arguments
+	funcBody = append(funcBody, generateArguments(procedure, errCheck)...)
 	_, err := GetClassName(procedure.Name)
 	isClass := err == nil
 	for i, param := range procedure.Parameters {
-		param.Name = utils.SanitizeIdentifier(param.Name)
-		// If this is any kind of class method, use the class itself as the first param
+		// If this is any kind of class method, the class itself is the first param
 		if i == 0 && isClass {
-			param.Name = "s"
-		} else {
-			paramType := GetGoType(param.Type, WithPackage(pkg))
-			params = append(params, jen.Id(param.Name).Add(paramType))
+			continue
 		}
-
-		funcBody = append(funcBody,
-			jen.List(jen.Id("argBytes"), jen.Err()).Op("=").Qual(encodePkg, "Marshal").Call(
-				jen.Id(param.Name),
-			),
-			errCheck,
-			jen.Id("request").Dot("Arguments").Op("=").Append(
-				jen.Id("request").Dot("Arguments"),
-				jen.Op("&").Qual(typesPkg, "Argument").Values(jen.Dict{
-					jen.Id("Position"): jen.Lit(uint32(i)),
-					jen.Id("Value"):    jen.Id("argBytes"),
-				}),
-			),
-		)
+		paramType := GetGoType(param.Type, WithPackage(pkg))
+		params = append(params, jen.Id(param.Name).Add(paramType))
+		args = append(args, jen.Id(param.Name))
 	}
 
 	// Call the procedure
+	call := jen.Id("s").Dot("Client").Dot("Call").Call(jen.Id("request"))
+	if withContext {
+		call = jen.Id("s").Dot("Client").Dot("CallContext").Call(jen.Id("ctx"), jen.Id("request"))
+	}
 	var lhs *jen.Statement
 	if returnType != nil {
 		lhs = jen.List(
@@ -138,9 +235,7 @@ func generateProcedureBody(serviceName string, procedure *types.Procedure) (func
 		).Op("=")
 	}
 	funcBody = append(funcBody,
-		lhs.Id("s").Dot("Client").Dot("Call").Call(
-			jen.Id("request"),
-		),
+		lhs.Add(call),
 		errCheck,
 	)
 
@@ -155,9 +250,17 @@ func generateProcedureBody(serviceName string, procedure *types.Procedure) (func
 				jen.Id("vv").Dot("Client").Op("=").Id("s").Dot("Client"),
 			)
 		}
-		funcBody = append(funcBody,
-			jen.Return(returnVar, jen.Nil()),
-		)
+		if isEvent {
+			var ctx jen.Code
+			if withContext {
+				ctx = jen.Id("ctx")
+			}
+			funcBody = append(funcBody, jen.Return(newEvent(ctx)))
+		} else {
+			funcBody = append(funcBody,
+				jen.Return(returnVar, jen.Nil()),
+			)
+		}
 	} else {
 		funcBody = append(funcBody,
 			jen.Return(jen.Nil()),
@@ -169,8 +272,8 @@ func generateProcedureBody(serviceName string, procedure *types.Procedure) (func
 }
 
 // generateBaseProcedure generates a procedure function using extra info about the call signature.
-func generateBaseProcedure(f *jen.File, procName, procDocs, receiver, serviceName string, procedure *types.Procedure) {
-	funcBody, params, returnType := generateProcedureBody(serviceName, procedure)
+func generateBaseProcedure(f *jen.File, procName, procDocs, receiver, serviceName string, procedure *types.Procedure, cfg GenerateConfig) {
+	funcBody, params, args, returnType := generateProcedureBody(serviceName, procedure, cfg.ContextVariants)
 
 	var retType jen.Code
 	if returnType != nil {
@@ -178,34 +281,100 @@ func generateBaseProcedure(f *jen.File, procName, procDocs, receiver, serviceNam
 	} else {
 		retType = jen.Error()
 	}
-	// Define the procedure function
-	f.Comment(WrapDocComment(procDocs))
-	f.Func().Params(
-		jen.Id("s").Op("*").Id(receiver),
-	).Id(procName).Params(params...).Add(retType).Block(funcBody...)
+
+	if cfg.ContextVariants {
+		// The plain procedure defers to the context variant.
+		contextFuncName := procName + "Context"
+		f.Comment(WrapDocComment(procDocs))
+		f.Func().Params(
+			jen.Id("s").Op("*").Id(receiver),
+		).Id(procName).Params(params...).Add(retType).Block(
+			jen.Return(jen.Id("s").Dot(contextFuncName).Call(
+				append([]jen.Code{jen.Qual(contextPkg, "Background").Call()}, args...)...,
+			)),
+		)
+
+		f.Comment(WrapDocComment(variantDocs(procDocs, procName, contextFuncName)))
+		f.Func().Params(
+			jen.Id("s").Op("*").Id(receiver),
+		).Id(contextFuncName).Params(
+			append([]jen.Code{jen.Id("ctx").Qual(contextPkg, "Context")}, params...)...,
+		).Add(retType).Block(funcBody...)
+	} else {
+		// Define the procedure function
+		f.Comment(WrapDocComment(procDocs))
+		f.Func().Params(
+			jen.Id("s").Op("*").Id(receiver),
+		).Id(procName).Params(params...).Add(retType).Block(funcBody...)
+	}
+
+	if cfg.AsyncVariants {
+		funcBody, asyncRetType := generateAsyncBody(serviceName, procedure)
+		asyncFuncName := procName + "Async"
+		f.Comment(WrapDocComment(variantDocs(procDocs, procName, asyncFuncName)))
+		f.Func().Params(
+			jen.Id("s").Op("*").Id(receiver),
+		).Id(asyncFuncName).Params(params...).Add(jen.Parens(jen.List(asyncRetType, jen.Error()))).Block(funcBody...)
+	}
 
 	// If this procedure has a return value, also generate a stream definition
 	// Note: not streaming classes for simplicity, may change later
 	if returnType != nil && !isPointerType(procedure.ReturnType.Code) {
 		funcBody, streamRetType := generateStreamBody(serviceName, procedure)
 		streamFuncName := procName + "Stream"
-		f.Comment(WrapDocComment(strings.ReplaceAll(procDocs, procName, streamFuncName)))
+		// Streams take options for how their updates are buffered.
+		streamParams := append(params[:len(params):len(params)], jen.Id("opts").Op("...").Qual(krpcPkg, "StreamOption"))
+		f.Comment(WrapDocComment(variantDocs(procDocs, procName, streamFuncName)))
 		f.Func().Params(
 			jen.Id("s").Op("*").Id(receiver),
-		).Id(streamFuncName).Params(params...).Add(jen.Parens(jen.List(streamRetType, jen.Error()))).Block(funcBody...)
+		).Id(streamFuncName).Params(streamParams...).Add(jen.Parens(jen.List(streamRetType, jen.Error()))).Block(funcBody...)
 	}
-}
 
-func generateStreamBody(serviceName string, procedure *types.Procedure) (funcBody []jen.Code, returnType *jen.Statement) {
-	internalReturnType := GetGoType(procedure.ReturnType, WithPackage(getServicePackage(serviceName)))
-	returnType = jen.Op("*").Qual(krpcPkg, "Stream").Types(internalReturnType)
+	if cfg.CallBuilders {
+		callFuncName := procName + "Call"
+		f.Comment(WrapDocComment(fmt.Sprintf(
+			"%v builds a call to %v without making it, such as to make several calls with CallMultiple, add a stream with AddStream or use it in an expression.",
+			callFuncName, procName,
+		)))
+		f.Func().Params(
+			jen.Id("s").Op("*").Id(receiver),
+		).Id(callFuncName).Params(params...).Add(jen.Parens(jen.List(
+			jen.Op("*").Qual(typesPkg, "ProcedureCall"), jen.Error(),
+		))).Block(generateCallBody(serviceName, procedure)...)
+
+		if returnType != nil {
+			decodeFuncName := "Decode" + procName
+			decodeBody, valueType := generateDecodeBody(serviceName, procedure, true)
+			f.Comment(WrapDocComment(fmt.Sprintf(
+				"%v decodes the result of a call built with %v.",
+				decodeFuncName, callFuncName,
+			)))
+			f.Func().Params(
+				jen.Id("s").Op("*").Id(receiver),
+			).Id(decodeFuncName).Params(
+				jen.Id("result").Op("*").Qual(typesPkg, "ProcedureResult"),
+			).Add(jen.Parens(jen.List(valueType, jen.Error()))).Block(decodeBody...)
+		}
+	}
+}
 
-	funcBody = []jen.Code{
-		jen.Var().Err().Error(),
+// variantDocs renames the procedure at the start of its docs for a variant of
+// it. Other mentions of the name, such as references to a class of the same
+// name, are left as is.
+func variantDocs(procDocs, procName, variantName string) string {
+	if !strings.HasPrefix(procDocs, procName+" -") {
+		return procDocs
 	}
+	return variantName + strings.TrimPrefix(procDocs, procName)
+}
 
+// generateCallBody generates the function body for building a procedure
+// call.
+func generateCallBody(serviceName string, procedure *types.Procedure) (funcBody []jen.Code) {
+	// Errors can only come from marshaling arguments
 	if len(procedure.Parameters) > 0 {
 		funcBody = append(funcBody,
+			jen.Var().Err().Error(),
 			jen.Var().Id("argBytes").Index().Byte(),
 		)
 	}
@@ -218,88 +387,206 @@ func generateStreamBody(serviceName string, procedure *types.Procedure) (funcBod
 	)
 
 	// Shorthand for if err != nil {...
-	errReturn := []jen.Code{jen.Nil(), jen.Qual(tracerrPkg, "Wrap").Call(jen.Err())}
 	errCheck := jen.If(jen.Err().Op("!=").Nil()).Block(
-		jen.Return(errReturn...),
+		jen.Return(jen.Nil(), jen.Qual(tracerrPkg, "Wrap").Call(jen.Err())),
 	)
 
 	// Marshal arguments
-	_, err := GetClassName(procedure.Name)
-	isClass := err == nil
-	for i, param := range procedure.Parameters {
-		param.Name = utils.SanitizeIdentifier(param.Name)
-		// If this is any kind of class method, use the class itself as the first param
-		if i == 0 && isClass {
-			param.Name = "s"
+	funcBody = append(funcBody, generateArguments(procedure, errCheck)...)
+
+	funcBody = append(funcBody,
+		jen.Return(jen.Id("request"), jen.Nil()),
+	)
+	return
+}
+
+// isEventType checks if a type is an event, which procedures return as a
+// krpcgo.Event.
+func isEventType(t *types.Type) bool {
+	return t != nil && t.Code == types.Type_EVENT
+}
+
+// newEvent creates a krpcgo.Event from the decoded event message vv, starting
+// it with ctx if it isn't nil.
+func newEvent(ctx jen.Code) *jen.Statement {
+	if ctx == nil {
+		return jen.Qual(krpcPkg, "NewEvent").Call(jen.Id("s").Dot("Client"), jen.Op("&").Id("vv"))
+	}
+	return jen.Qual(krpcPkg, "NewEventContext").Call(ctx, jen.Id("s").Dot("Client"), jen.Op("&").Id("vv"))
+}
+
+// generateAsyncBody generates the function body for an asynchronous
+// procedure call. Procedures without a return value produce a future of
+// struct{}.
+func generateAsyncBody(serviceName string, procedure *types.Procedure) (funcBody []jen.Code, returnType *jen.Statement) {
+	decodeBody, valueType := generateDecodeBody(serviceName, procedure, false)
+	if valueType == nil {
+		valueType = jen.Struct()
+		decodeBody = []jen.Code{
+			jen.Return(jen.Struct().Values(), jen.Nil()),
 		}
+	}
+	returnType = jen.Op("*").Qual(krpcPkg, "Future").Types(valueType)
 
+	// Errors can only come from marshaling arguments
+	if len(procedure.Parameters) > 0 {
 		funcBody = append(funcBody,
-			jen.List(jen.Id("argBytes"), jen.Err()).Op("=").Qual(encodePkg, "Marshal").Call(
-				jen.Id(param.Name),
+			jen.Var().Err().Error(),
+			jen.Var().Id("argBytes").Index().Byte(),
+		)
+	}
+
+	funcBody = append(funcBody,
+		jen.Id("request").Op(":=").Op("&").Qual(typesPkg, "ProcedureCall").Values(jen.Dict{
+			jen.Id("Service"):   jen.Lit(serviceName),
+			jen.Id("Procedure"): jen.Lit(procedure.Name),
+		}),
+	)
+
+	// Shorthand for if err != nil {...
+	errCheck := jen.If(jen.Err().Op("!=").Nil()).Block(
+		jen.Return(jen.Nil(), jen.Qual(tracerrPkg, "Wrap").Call(jen.Err())),
+	)
+
+	// Marshal arguments
+	funcBody = append(funcBody, generateArguments(procedure, errCheck)...)
+
+	funcBody = append(funcBody,
+		jen.Id("future").Op(":=").Qual(krpcPkg, "MapFuture").Call(
+			jen.Id("s").Dot("Client").Dot("CallAsync").Call(jen.Id("request")),
+			jen.Func().Params(
+				jen.Id("result").Op("*").Qual(typesPkg, "ProcedureResult"),
+			).Parens(jen.List(valueType, jen.Error())).Block(decodeBody...),
+		),
+		jen.Return(jen.Id("future"), jen.Nil()),
+	)
+
+	return
+}
+
+// generateDecodeBody generates the code to decode the value of a procedure
+// result. Procedures without a return value have no value type. If
+// checkResult is set, the result is checked for a server error first.
+func generateDecodeBody(serviceName string, procedure *types.Procedure, checkResult bool) (decodeBody []jen.Code, valueType *jen.Statement) {
+	pkg := getServicePackage(serviceName)
+	valueType = GetGoType(procedure.ReturnType, WithPackage(pkg))
+	valueVarType := GetGoType(procedure.ReturnType, WithPackage(pkg), NoPointerForClass)
+
+	// Shorthand for checking the result for a server error
+	resultCheck := func(errReturn jen.Code) []jen.Code {
+		if !checkResult {
+			return nil
+		}
+		return []jen.Code{
+			jen.If(
+				jen.Err().Op(":=").Qual(krpcPkg, "ResultError").Call(jen.Id("result")),
+				jen.Err().Op("!=").Nil(),
+			).Block(
+				jen.Return(errReturn, jen.Qual(tracerrPkg, "Wrap").Call(jen.Err())),
 			),
-			errCheck,
-			jen.Id("request").Dot("Arguments").Op("=").Append(
-				jen.Id("request").Dot("Arguments"),
-				jen.Op("&").Qual(typesPkg, "Argument").Values(jen.Dict{
-					jen.Id("Position"): jen.Lit(uint32(i)),
-					jen.Id("Value"):    jen.Id("argBytes"),
-				}),
+		}
+	}
+
+	if isEventType(procedure.ReturnType) {
+		decodeBody = append(resultCheck(jen.Nil()),
+			jen.Var().Id("vv").Qual(typesPkg, "Event"),
+			jen.Err().Op(":=").Qual(encodePkg, "Unmarshal").Call(jen.Id("result").Dot("Value"), jen.Op("&").Id("vv")),
+			jen.If(jen.Err().Op("!=").Nil()).Block(
+				jen.Return(jen.Nil(), jen.Qual(tracerrPkg, "Wrap").Call(jen.Err())),
+			),
+			jen.Return(newEvent(nil)),
+		)
+	} else if valueType != nil {
+		var valueVar *jen.Statement
+		if isPointerType(procedure.ReturnType.Code) {
+			valueVar = jen.Op("&").Id("vv")
+		} else {
+			valueVar = jen.Id("vv")
+		}
+		decodeBody = []jen.Code{
+			jen.Var().Id("vv").Add(valueVarType),
+		}
+		decodeBody = append(decodeBody, resultCheck(valueVar)...)
+		decodeBody = append(decodeBody,
+			jen.Err().Op(":=").Qual(encodePkg, "Unmarshal").Call(jen.Id("result").Dot("Value"), jen.Op("&").Id("vv")),
+			jen.If(jen.Err().Op("!=").Nil()).Block(
+				jen.Return(valueVar, jen.Qual(tracerrPkg, "Wrap").Call(jen.Err())),
 			),
 		)
+		if procedure.ReturnType.Code == types.Type_CLASS {
+			decodeBody = append(decodeBody,
+				jen.Id("vv").Dot("Client").Op("=").Id("s").Dot("Client"),
+			)
+		}
+		decodeBody = append(decodeBody, jen.Return(valueVar, jen.Nil()))
 	}
+	return
+}
 
-	var krpcConstructor *jen.Statement
-	if serviceName == "KRPC" {
-		krpcConstructor = jen.Id("New")
-	} else {
-		krpcConstructor = jen.Qual(getServicePackage("KRPC"), "New")
+func generateStreamBody(serviceName string, procedure *types.Procedure) (funcBody []jen.Code, returnType *jen.Statement) {
+	internalReturnType := GetGoType(procedure.ReturnType, WithPackage(getServicePackage(serviceName)))
+	returnType = jen.Op("*").Qual(krpcPkg, "Stream").Types(internalReturnType)
+
+	funcBody = []jen.Code{
+		jen.Var().Err().Error(),
+	}
+
+	if len(procedure.Parameters) > 0 {
+		funcBody = append(funcBody,
+			jen.Var().Id("argBytes").Index().Byte(),
+		)
 	}
 
 	funcBody = append(funcBody,
-		jen.Id("krpc").Op(":=").Add(krpcConstructor).Call(jen.Id("s").Dot("Client")),
+		jen.Id("request").Op(":=").Op("&").Qual(typesPkg, "ProcedureCall").Values(jen.Dict{
+			jen.Id("Service"):   jen.Lit(serviceName),
+			jen.Id("Procedure"): jen.Lit(procedure.Name),
+		}),
+	)
 
+	// Shorthand for if err != nil {...
+	errReturn := []jen.Code{jen.Nil(), jen.Qual(tracerrPkg, "Wrap").Call(jen.Err())}
+	errCheck := jen.If(jen.Err().Op("!=").Nil()).Block(
+		jen.Return(errReturn...),
+	)
+
+	// Marshal arguments
+	funcBody = append(funcBody, generateArguments(procedure, errCheck)...)
+
+	funcBody = append(funcBody,
 		// Start the stream
-		jen.List(jen.Id("st"), jen.Err()).Op(":=").Id("krpc").Dot("AddStream").Call(
-			jen.Id("request"), jen.Lit(true),
+		jen.List(jen.Id("rawStream"), jen.Err()).Op(":=").Id("s").Dot("Client").Dot("AddStream").Call(
+			jen.Id("request"), jen.Id("opts").Op("..."),
 		),
 		errCheck,
-
-		jen.Id("rawStream").Op(":=").Id("s").Dot("Client").Dot("GetStream").Call(
-			jen.Id("st").Dot("Id"),
-		),
-
-		jen.Id("stream").Op(":=").Qual(krpcPkg, "MapStream").Call(
+		// Decode errors end the stream.
+		jen.Id("stream").Op(":=").Qual(krpcPkg, "MapStreamErr").Call(
 			jen.Id("rawStream"),
-			jen.Func().Params(jen.Id("b").Index().Byte()).Add(internalReturnType).Block(
+			jen.Func().Params(jen.Id("b").Index().Byte()).Params(internalReturnType, jen.Error()).Block(
 				jen.Var().Id("value").Add(internalReturnType),
-				jen.Qual(encodePkg, "Unmarshal").Call(jen.Id("b"), jen.Op("&").Id("value")),
-				jen.Return(jen.Id("value")),
+				jen.Err().Op(":=").Qual(encodePkg, "Unmarshal").Call(jen.Id("b"), jen.Op("&").Id("value")),
+				jen.Return(jen.Id("value"), jen.Qual(tracerrPkg, "Wrap").Call(jen.Err())),
 			),
 		),
-		jen.Id("stream").Dot("AddCloser").Call(jen.Func().Params().Error().Block(
-			jen.Return(jen.Qual(tracerrPkg, "Wrap").Call(
-				jen.Id("krpc").Dot("RemoveStream").Call(jen.Id("st").Dot("Id")),
-			)),
-		)),
 		jen.Return(jen.Id("stream"), jen.Nil()),
 	)
 
 	return
 }
 
-func generateProcedure(f *jen.File, serviceName string, procedure *types.Procedure) error {
+func generateProcedure(f *jen.File, serviceName string, procedure *types.Procedure, cfg GenerateConfig) error {
 	procName := procedure.Name
 	procDocs, err := utils.ParseXMLDocumentation(procedure.Documentation, procName+" - ")
 	if err != nil {
 		return tracerr.Wrap(err)
 	}
 	procDocs = fmt.Sprintf("%v\n\n%v", procDocs, formatGameScenes(procedure.GameScenes))
-	generateBaseProcedure(f, procName, procDocs, serviceName, serviceName, procedure)
+	generateBaseProcedure(f, procName, procDocs, serviceName, serviceName, procedure, cfg)
 
 	return nil
 }
 
-func generateServiceGetter(f *jen.File, serviceName string, procedure *types.Procedure) error {
+func generateServiceGetter(f *jen.File, serviceName string, procedure *types.Procedure, cfg GenerateConfig) error {
 	propName, err := GetPropertyName(procedure.Name)
 	if err != nil {
 		return tracerr.Wrap(err)
@@ -310,12 +597,12 @@ func generateServiceGetter(f *jen.File, serviceName string, procedure *types.Pro
 		return tracerr.Wrap(err)
 	}
 	procDocs = fmt.Sprintf("%v\n\n%v", procDocs, formatGameScenes(procedure.GameScenes))
-	generateBaseProcedure(f, procName, procDocs, serviceName, serviceName, procedure)
+	generateBaseProcedure(f, procName, procDocs, serviceName, serviceName, procedure, cfg)
 
 	return nil
 }
 
-func generateServiceSetter(f *jen.File, serviceName string, procedure *types.Procedure) error {
+func generateServiceSetter(f *jen.File, serviceName string, procedure *types.Procedure, cfg GenerateConfig) error {
 	propName, err := GetPropertyName(procedure.Name)
 	if err != nil {
 		return tracerr.Wrap(err)
@@ -326,12 +613,12 @@ func generateServiceSetter(f *jen.File, serviceName string, procedure *types.Pro
 		return tracerr.Wrap(err)
 	}
 	procDocs = fmt.Sprintf("%v\n\n%v", procDocs, formatGameScenes(procedure.GameScenes))
-	generateBaseProcedure(f, procName, procDocs, serviceName, serviceName, procedure)
+	generateBaseProcedure(f, procName, procDocs, serviceName, serviceName, procedure, cfg)
 
 	return nil
 }
 
-func generateClassMethod(f *jen.File, serviceName string, procedure *types.Procedure) error {
+func generateClassMethod(f *jen.File, serviceName string, procedure *types.Procedure, cfg GenerateConfig) error {
 	className, err := GetClassName(procedure.Name)
 	if err != nil {
 		return tracerr.Wrap(err)
@@ -342,16 +629,16 @@ func generateClassMethod(f *jen.File, serviceName string, procedure *types.Proce
 		return tracerr.Wrap(err)
 	}
 	procDocs = fmt.Sprintf("%v\n\n%v", procDocs, formatGameScenes(procedure.GameScenes))
-	generateBaseProcedure(f, procName, procDocs, className, serviceName, procedure)
+	generateBaseProcedure(f, procName, procDocs, className, serviceName, procedure, cfg)
 
 	return nil
 }
 
-func generateStaticClassMethod(f *jen.File, serviceName string, procedure *types.Procedure) error {
-	return tracerr.Wrap(generateClassMethod(f, serviceName, procedure))
+func generateStaticClassMethod(f *jen.File, serviceName string, procedure *types.Procedure, cfg GenerateConfig) error {
+	return tracerr.Wrap(generateClassMethod(f, serviceName, procedure, cfg))
 }
 
-func generateClassGetter(f *jen.File, serviceName string, procedure *types.Procedure) error {
+func generateClassGetter(f *jen.File, serviceName string, procedure *types.Procedure, cfg GenerateConfig) error {
 	className, err := GetClassName(procedure.Name)
 	if err != nil {
 		return tracerr.Wrap(err)
@@ -366,12 +653,12 @@ func generateClassGetter(f *jen.File, serviceName string, procedure *types.Proce
 		return tracerr.Wrap(err)
 	}
 	procDocs = fmt.Sprintf("%v\n\n%v", procDocs, formatGameScenes(procedure.GameScenes))
-	generateBaseProcedure(f, procName, procDocs, className, serviceName, procedure)
+	generateBaseProcedure(f, procName, procDocs, className, serviceName, procedure, cfg)
 
 	return nil
 }
 
-func generateClassSetter(f *jen.File, serviceName string, procedure *types.Procedure) error {
+func generateClassSetter(f *jen.File, serviceName string, procedure *types.Procedure, cfg GenerateConfig) error {
 	className, err := GetClassName(procedure.Name)
 	if err != nil {
 		return tracerr.Wrap(err)
@@ -386,7 +673,7 @@ func generateClassSetter(f *jen.File, serviceName string, procedure *types.Proce
 		return tracerr.Wrap(err)
 	}
 	procDocs = fmt.Sprintf("%v\n\n%v", procDocs, formatGameScenes(procedure.GameScenes))
-	generateBaseProcedure(f, procName, procDocs, className, serviceName, procedure)
+	generateBaseProcedure(f, procName, procDocs, className, serviceName, procedure, cfg)
 
 	return nil
 }
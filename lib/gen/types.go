@@ -139,6 +139,7 @@ var pointerTypes = map[types.Type_TypeCode]struct{}{
 	types.Type_PROCEDURE_CALL: {},
 	types.Type_STREAM:         {},
 	types.Type_STATUS:         {},
+	types.Type_EVENT:          {},
 }
 
 func isPointerType(code types.Type_TypeCode) bool {
@@ -167,6 +168,8 @@ func GetGoType(t *types.Type, opts ...GetGoTypeOption) *jen.Statement {
 		return getClassType(jen.Qual(typesPkg, "Status"), cfg.UsePointer)
 	case types.Type_SERVICES:
 		return getClassType(jen.Qual(typesPkg, "Services"), cfg.UsePointer)
+	case types.Type_EVENT:
+		return getClassType(jen.Qual(krpcPkg, "Event"), cfg.UsePointer)
 
 	// Class or enum.
 	case types.Type_CLASS:
@@ -230,3 +233,24 @@ func GetGoType(t *types.Type, opts ...GetGoTypeOption) *jen.Statement {
 	// Type is None or unrecognized.
 	return nil
 }
+
+// typeValue generates a *types.Type literal equal to t.
+func typeValue(t *types.Type) *jen.Statement {
+	fields := jen.Dict{
+		jen.Id("Code"): jen.Qual(typesPkg, "Type_"+t.Code.String()),
+	}
+	if t.Service != "" {
+		fields[jen.Id("Service")] = jen.Lit(t.Service)
+	}
+	if t.Name != "" {
+		fields[jen.Id("Name")] = jen.Lit(t.Name)
+	}
+	if len(t.Types) > 0 {
+		var subtypes []jen.Code
+		for _, subtype := range t.Types {
+			subtypes = append(subtypes, typeValue(subtype))
+		}
+		fields[jen.Id("Types")] = jen.Index().Op("*").Qual(typesPkg, "Type").Values(subtypes...)
+	}
+	return jen.Op("&").Qual(typesPkg, "Type").Values(fields)
+}
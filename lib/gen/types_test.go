@@ -241,3 +241,40 @@ func TestGetGoType(t *testing.T) {
 		})
 	}
 }
+
+func TestTypeValue(t *testing.T) {
+	typ := &types.Type{
+		Code: types.Type_TUPLE,
+		Types: []*types.Type{
+			{
+				Code:    types.Type_CLASS,
+				Service: "MyService",
+				Name:    "MyClass",
+			},
+			{
+				Code: types.Type_DOUBLE,
+			},
+		},
+	}
+	expectedOut, err := format.Source([]byte(`
+	package gentest
+
+	import types "github.com/atburke/krpc-go/types"
+
+	var test = &types.Type{
+		Code: types.Type_TUPLE,
+		Types: []*types.Type{&types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "MyClass",
+			Service: "MyService",
+		}, &types.Type{Code: types.Type_DOUBLE}},
+	}
+	`))
+	require.NoError(t, err)
+
+	f := jen.NewFile("gentest")
+	f.Var().Id("test").Op("=").Add(typeValue(typ))
+	var out bytes.Buffer
+	require.NoError(t, f.Render(&out))
+	require.Equal(t, string(expectedOut), out.String())
+}
@@ -0,0 +1,157 @@
+// Package record records the traffic between a kRPC client and server, so
+// that it can be replayed later with krpctest.
+package record
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/atburke/krpc-go/types"
+	"github.com/ztrue/tracerr"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Entry is a single recorded message. Exactly one of Request, Response and
+// StreamUpdate is set.
+type Entry struct {
+	// Time is when the message was sent or received.
+	Time time.Time
+	// Request is a request sent to the RPC server.
+	Request *types.Request
+	// Response is a response received from the RPC server.
+	Response *types.Response
+	// StreamUpdate is an update received from the stream server.
+	StreamUpdate *types.StreamUpdate
+}
+
+// jsonEntry is the JSON form of an entry. Messages are encoded with protojson.
+type jsonEntry struct {
+	Time         time.Time       `json:"time"`
+	Request      json.RawMessage `json:"request,omitempty"`
+	Response     json.RawMessage `json:"response,omitempty"`
+	StreamUpdate json.RawMessage `json:"stream_update,omitempty"`
+}
+
+// MarshalJSON encodes the entry as JSON.
+func (e Entry) MarshalJSON() ([]byte, error) {
+	je := jsonEntry{Time: e.Time}
+	var err error
+	switch {
+	case e.Request != nil:
+		je.Request, err = protojson.Marshal(e.Request)
+	case e.Response != nil:
+		je.Response, err = protojson.Marshal(e.Response)
+	case e.StreamUpdate != nil:
+		je.StreamUpdate, err = protojson.Marshal(e.StreamUpdate)
+	default:
+		return nil, tracerr.Errorf("Entry has no message")
+	}
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	return json.Marshal(je)
+}
+
+// UnmarshalJSON decodes the entry from JSON.
+func (e *Entry) UnmarshalJSON(b []byte) error {
+	var je jsonEntry
+	if err := json.Unmarshal(b, &je); err != nil {
+		return tracerr.Wrap(err)
+	}
+	*e = Entry{Time: je.Time}
+	var raw []byte
+	var m proto.Message
+	switch {
+	case je.Request != nil:
+		e.Request = &types.Request{}
+		raw, m = je.Request, e.Request
+	case je.Response != nil:
+		e.Response = &types.Response{}
+		raw, m = je.Response, e.Response
+	case je.StreamUpdate != nil:
+		e.StreamUpdate = &types.StreamUpdate{}
+		raw, m = je.StreamUpdate, e.StreamUpdate
+	default:
+		return tracerr.Errorf("Entry has no message")
+	}
+	return tracerr.Wrap(protojson.Unmarshal(raw, m))
+}
+
+// Writer writes a recording as JSON lines, one entry per line. It can be
+// used as the recorder of a client.
+type Writer struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+	err error
+	// now gets the current time.
+	now func() time.Time
+}
+
+// NewWriter creates a new Writer.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{
+		enc: json.NewEncoder(w),
+		now: time.Now,
+	}
+}
+
+// RecordRequest records a request sent to the RPC server.
+func (w *Writer) RecordRequest(req *types.Request) {
+	w.write(Entry{Request: req})
+}
+
+// RecordResponse records a response received from the RPC server.
+func (w *Writer) RecordResponse(resp *types.Response) {
+	w.write(Entry{Response: resp})
+}
+
+// RecordStreamUpdate records an update received from the stream server.
+func (w *Writer) RecordStreamUpdate(update *types.StreamUpdate) {
+	w.write(Entry{StreamUpdate: update})
+}
+
+// Err gets the first error encountered while writing, if any. Once writing
+// fails, nothing more is written.
+func (w *Writer) Err() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.err
+}
+
+func (w *Writer) write(e Entry) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.err != nil {
+		return
+	}
+	e.Time = w.now()
+	if err := w.enc.Encode(e); err != nil {
+		w.err = tracerr.Wrap(err)
+	}
+}
+
+// Read reads a recording written by a Writer.
+func Read(r io.Reader) ([]Entry, error) {
+	var entries []Entry
+	scanner := bufio.NewScanner(r)
+	// Responses can be large, e.g. the result of KRPC.GetServices.
+	scanner.Buffer(nil, 64*1024*1024)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var e Entry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return nil, tracerr.Wrap(err)
+		}
+		entries = append(entries, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	return entries, nil
+}
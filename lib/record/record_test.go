@@ -0,0 +1,46 @@
+package record
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/atburke/krpc-go/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestWriteRead(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := start
+	w.now = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+
+	req := &types.Request{Calls: []*types.ProcedureCall{{
+		Service:   "SpaceCenter",
+		Procedure: "get_ActiveVessel",
+	}}}
+	resp := &types.Response{Results: []*types.ProcedureResult{{Value: []byte{1}}}}
+	update := &types.StreamUpdate{Results: []*types.StreamResult{{
+		Id:     3,
+		Result: &types.ProcedureResult{Error: &types.Error{Service: "KRPC", Name: "Oops"}},
+	}}}
+	w.RecordRequest(req)
+	w.RecordResponse(resp)
+	w.RecordStreamUpdate(update)
+	require.NoError(t, w.Err())
+
+	entries, err := Read(&buf)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	require.True(t, proto.Equal(req, entries[0].Request))
+	require.True(t, proto.Equal(resp, entries[1].Response))
+	require.True(t, proto.Equal(update, entries[2].StreamUpdate))
+	for i, e := range entries {
+		require.True(t, start.Add(time.Duration(i+1)*time.Second).Equal(e.Time))
+	}
+}
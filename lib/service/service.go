@@ -22,8 +22,8 @@ type Class interface {
 type BaseClass struct {
 	// ID is the struct's id.
 	id uint64
-	// Client is a kRPC client.
-	Client *krpcgo.KRPCClient
+	// Client makes the calls to the server.
+	Client krpcgo.Caller
 }
 
 // ID gets the instance's ID.
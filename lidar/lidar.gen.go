@@ -4,8 +4,8 @@
 package lidar
 
 import (
+	"context"
 	krpcgo "github.com/atburke/krpc-go"
-	krpc "github.com/atburke/krpc-go/krpc"
 	encode "github.com/atburke/krpc-go/lib/encode"
 	service "github.com/atburke/krpc-go/lib/service"
 	spacecenter "github.com/atburke/krpc-go/spacecenter"
@@ -21,7 +21,7 @@ type Laser struct {
 }
 
 // NewLaser creates a new Laser.
-func NewLaser(id uint64, client *krpcgo.KRPCClient) *Laser {
+func NewLaser(id uint64, client krpcgo.Caller) *Laser {
 	c := &Laser{BaseClass: service.BaseClass{Client: client}}
 	c.SetID(id)
 	return c
@@ -29,11 +29,11 @@ func NewLaser(id uint64, client *krpcgo.KRPCClient) *Laser {
 
 // LiDAR - laserDist Service
 type LiDAR struct {
-	Client *krpcgo.KRPCClient
+	Client krpcgo.Caller
 }
 
 // New creates a new LiDAR.
-func New(client *krpcgo.KRPCClient) *LiDAR {
+func New(client krpcgo.Caller) *LiDAR {
 	return &LiDAR{Client: client}
 }
 
@@ -41,6 +41,13 @@ func New(client *krpcgo.KRPCClient) *LiDAR {
 //
 // Allowed game scenes: any.
 func (s *LiDAR) Laser(part *spacecenter.Part) (*Laser, error) {
+	return s.LaserContext(context.Background(), part)
+}
+
+// LaserContext - get a LaserDist part
+//
+// Allowed game scenes: any.
+func (s *LiDAR) LaserContext(ctx context.Context, part *spacecenter.Part) (*Laser, error) {
 	var err error
 	var argBytes []byte
 	var vv Laser
@@ -56,7 +63,7 @@ func (s *LiDAR) Laser(part *spacecenter.Part) (*Laser, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -68,17 +75,89 @@ func (s *LiDAR) Laser(part *spacecenter.Part) (*Laser, error) {
 	return &vv, nil
 }
 
+// LaserAsync - get a LaserDist part
+//
+// Allowed game scenes: any.
+func (s *LiDAR) LaserAsync(part *spacecenter.Part) (*krpcgo.Future[*Laser], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Laser",
+		Service:   "LiDAR",
+	}
+	argBytes, err = encode.Marshal(part)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Laser, error) {
+		var vv Laser
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// LaserCall builds a call to Laser without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *LiDAR) LaserCall(part *spacecenter.Part) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Laser",
+		Service:   "LiDAR",
+	}
+	argBytes, err = encode.Marshal(part)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeLaser decodes the result of a call built with LaserCall.
+func (s *LiDAR) DecodeLaser(result *types.ProcedureResult) (*Laser, error) {
+	var vv Laser
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
 // Available - check if the LaserDist API is avaiable
 //
 // Allowed game scenes: any.
 func (s *LiDAR) Available() (bool, error) {
+	return s.AvailableContext(context.Background())
+}
+
+// AvailableContext - check if the LaserDist API is avaiable
+//
+// Allowed game scenes: any.
+func (s *LiDAR) AvailableContext(ctx context.Context) (bool, error) {
 	var err error
 	var vv bool
 	request := &types.ProcedureCall{
 		Procedure: "get_Available",
 		Service:   "LiDAR",
 	}
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -89,36 +168,81 @@ func (s *LiDAR) Available() (bool, error) {
 	return vv, nil
 }
 
+// AvailableAsync - check if the LaserDist API is avaiable
+//
+// Allowed game scenes: any.
+func (s *LiDAR) AvailableAsync() (*krpcgo.Future[bool], error) {
+	request := &types.ProcedureCall{
+		Procedure: "get_Available",
+		Service:   "LiDAR",
+	}
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (bool, error) {
+		var vv bool
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
 // AvailableStream - check if the LaserDist API is avaiable
 //
 // Allowed game scenes: any.
-func (s *LiDAR) AvailableStream() (*krpcgo.Stream[bool], error) {
+func (s *LiDAR) AvailableStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[bool], error) {
 	var err error
 	request := &types.ProcedureCall{
 		Procedure: "get_Available",
 		Service:   "LiDAR",
 	}
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) bool {
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (bool, error) {
 		var value bool
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
 	return stream, nil
 }
 
+// AvailableCall builds a call to Available without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *LiDAR) AvailableCall() (*types.ProcedureCall, error) {
+	request := &types.ProcedureCall{
+		Procedure: "get_Available",
+		Service:   "LiDAR",
+	}
+	return request, nil
+}
+
+// DecodeAvailable decodes the result of a call built with AvailableCall.
+func (s *LiDAR) DecodeAvailable(result *types.ProcedureResult) (bool, error) {
+	var vv bool
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
 // Part - get the part containing this LiDAR.
 //
 // Allowed game scenes: any.
 func (s *Laser) Part() (*spacecenter.Part, error) {
+	return s.PartContext(context.Background())
+}
+
+// PartContext - get the part containing this LiDAR.
+//
+// Allowed game scenes: any.
+func (s *Laser) PartContext(ctx context.Context) (*spacecenter.Part, error) {
 	var err error
 	var argBytes []byte
 	var vv spacecenter.Part
@@ -134,7 +258,7 @@ func (s *Laser) Part() (*spacecenter.Part, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -146,10 +270,82 @@ func (s *Laser) Part() (*spacecenter.Part, error) {
 	return &vv, nil
 }
 
+// PartAsync - get the part containing this LiDAR.
+//
+// Allowed game scenes: any.
+func (s *Laser) PartAsync() (*krpcgo.Future[*spacecenter.Part], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Laser_get_Part",
+		Service:   "LiDAR",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*spacecenter.Part, error) {
+		var vv spacecenter.Part
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// PartCall builds a call to Part without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Laser) PartCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Laser_get_Part",
+		Service:   "LiDAR",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodePart decodes the result of a call built with PartCall.
+func (s *Laser) DecodePart(result *types.ProcedureResult) (*spacecenter.Part, error) {
+	var vv spacecenter.Part
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
 // Cloud - get the pointcloud.
 //
 // Allowed game scenes: any.
 func (s *Laser) Cloud() ([]float64, error) {
+	return s.CloudContext(context.Background())
+}
+
+// CloudContext - get the pointcloud.
+//
+// Allowed game scenes: any.
+func (s *Laser) CloudContext(ctx context.Context) ([]float64, error) {
 	var err error
 	var argBytes []byte
 	var vv []float64
@@ -165,7 +361,7 @@ func (s *Laser) Cloud() ([]float64, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -176,10 +372,39 @@ func (s *Laser) Cloud() ([]float64, error) {
 	return vv, nil
 }
 
+// CloudAsync - get the pointcloud.
+//
+// Allowed game scenes: any.
+func (s *Laser) CloudAsync() (*krpcgo.Future[[]float64], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Laser_get_Cloud",
+		Service:   "LiDAR",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) ([]float64, error) {
+		var vv []float64
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
 // CloudStream - get the pointcloud.
 //
 // Allowed game scenes: any.
-func (s *Laser) CloudStream() (*krpcgo.Stream[[]float64], error) {
+func (s *Laser) CloudStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[[]float64], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
@@ -194,19 +419,67 @@ func (s *Laser) CloudStream() (*krpcgo.Stream[[]float64], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) []float64 {
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) ([]float64, error) {
 		var value []float64
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
 	return stream, nil
 }
+
+// CloudCall builds a call to Cloud without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Laser) CloudCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Laser_get_Cloud",
+		Service:   "LiDAR",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeCloud decodes the result of a call built with CloudCall.
+func (s *Laser) DecodeCloud(result *types.ProcedureResult) ([]float64, error) {
+	var vv []float64
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+func init() {
+	krpcgo.RegisterReturnTypes("LiDAR", map[string]*types.Type{
+		"Laser": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Laser",
+			Service: "LiDAR",
+		},
+		"Laser_get_Cloud": &types.Type{
+			Code:  types.Type_LIST,
+			Types: []*types.Type{&types.Type{Code: types.Type_DOUBLE}},
+		},
+		"Laser_get_Part": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Part",
+			Service: "SpaceCenter",
+		},
+		"get_Available": &types.Type{Code: types.Type_BOOL},
+	})
+}
@@ -0,0 +1,50 @@
+//go:build go1.21
+
+package krpcgo
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/atburke/krpc-go/types"
+	"github.com/ztrue/tracerr"
+)
+
+// LoggingInterceptor logs every batch of calls to logger, with the names of
+// the procedures called and how long the calls took. Successful calls are
+// logged at debug level, and failed calls at error level. Calls that returned
+// an error from the server are logged at warning level.
+func LoggingInterceptor(logger *slog.Logger) Interceptor {
+	return func(ctx context.Context, calls []*types.ProcedureCall, invoker Invoker) ([]*types.ProcedureResult, error) {
+		start := time.Now()
+		results, err := invoker(ctx, calls)
+		procedures := make([]string, len(calls))
+		for i, call := range calls {
+			procedures[i] = procedureName(call)
+		}
+		attrs := []slog.Attr{
+			slog.Any("procedures", procedures),
+			slog.Duration("duration", time.Since(start)),
+		}
+
+		level := slog.LevelDebug
+		if err != nil {
+			level = slog.LevelError
+			attrs = append(attrs, slog.String("error", err.Error()))
+		} else {
+			var failed []string
+			for i, result := range results {
+				if result.Error != nil && i < len(procedures) {
+					failed = append(failed, procedures[i])
+				}
+			}
+			if len(failed) > 0 {
+				level = slog.LevelWarn
+				attrs = append(attrs, slog.Any("failed", failed))
+			}
+		}
+		logger.LogAttrs(ctx, level, "kRPC call", attrs...)
+		return results, tracerr.Wrap(err)
+	}
+}
@@ -0,0 +1,36 @@
+//go:build go1.21
+
+package krpcgo
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/atburke/krpc-go/krpctest"
+	"github.com/atburke/krpc-go/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoggingInterceptor(t *testing.T) {
+	server := krpctest.NewServer(t)
+	server.HandleDefault(func(call *types.ProcedureCall) ([]byte, error) {
+		return nil, nil
+	})
+	server.HandleError("Test", "Fail", &types.Error{Description: "Failed"})
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client := connect(t, server, KRPCClientConfig{
+		Interceptors: []Interceptor{LoggingInterceptor(logger)},
+	})
+
+	_, err := client.Call(&types.ProcedureCall{Service: "Test", Procedure: "Echo"})
+	require.NoError(t, err)
+	require.Contains(t, buf.String(), `level=DEBUG msg="kRPC call" procedures=[Test.Echo]`)
+
+	buf.Reset()
+	_, err = client.Call(&types.ProcedureCall{Service: "Test", Procedure: "Fail"})
+	require.Error(t, err)
+	require.Contains(t, buf.String(), `level=WARN msg="kRPC call" procedures=[Test.Fail]`)
+	require.Contains(t, buf.String(), `failed=[Test.Fail]`)
+}
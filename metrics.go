@@ -0,0 +1,343 @@
+package krpcgo
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/atburke/krpc-go/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/ztrue/tracerr"
+)
+
+var (
+	// latencyBuckets are the upper bounds of the call latency histogram
+	// buckets, in seconds.
+	latencyBuckets = []float64{0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+	// batchSizeBuckets are the upper bounds of the batch size histogram
+	// buckets.
+	batchSizeBuckets = []float64{1, 2, 4, 8, 16, 32, 64, 128}
+)
+
+// histogram counts observations in buckets.
+type histogram struct {
+	bounds []float64
+	// counts are the number of observations in each bucket. Unlike in the
+	// exposition format, they aren't cumulative.
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+func newHistogram(bounds []float64) *histogram {
+	return &histogram{
+		bounds: bounds,
+		counts: make([]uint64, len(bounds)),
+	}
+}
+
+// observe adds an observation.
+func (h *histogram) observe(v float64) {
+	if i := sort.SearchFloat64s(h.bounds, v); i < len(h.bounds) {
+		h.counts[i]++
+	}
+	h.sum += v
+	h.count++
+}
+
+// procedureMetrics are the metrics for calls to a procedure.
+type procedureMetrics struct {
+	calls   uint64
+	errors  uint64
+	latency *histogram
+}
+
+// callMetrics are the metrics for the calls made by a client.
+type callMetrics struct {
+	mu         sync.Mutex
+	procedures map[string]*procedureMetrics
+	batchSizes *histogram
+}
+
+// observe records a batch of calls that took the given time. If err is set,
+// every call failed; otherwise, the results say which calls failed.
+func (m *callMetrics) observe(calls []*types.ProcedureCall, results []*types.ProcedureResult, err error, latency time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.procedures == nil {
+		m.procedures = make(map[string]*procedureMetrics)
+		m.batchSizes = newHistogram(batchSizeBuckets)
+	}
+	m.batchSizes.observe(float64(len(calls)))
+	for i, call := range calls {
+		name := procedureName(call)
+		pm, ok := m.procedures[name]
+		if !ok {
+			pm = &procedureMetrics{latency: newHistogram(latencyBuckets)}
+			m.procedures[name] = pm
+		}
+		pm.calls++
+		if err != nil || (i < len(results) && results[i].Error != nil) {
+			pm.errors++
+		}
+		pm.latency.observe(latency.Seconds())
+	}
+}
+
+// streamMetrics are the metrics for the updates to a stream.
+type streamMetrics struct {
+	updates    uint64
+	errors     uint64
+	dropped    uint64
+	lastUpdate time.Time
+}
+
+// MetricsHandler serves the client's metrics in the Prometheus text
+// exposition format. See WriteMetrics.
+func (c *KRPCClient) MetricsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		c.WriteMetrics(r.Context(), w)
+	})
+}
+
+// WriteMetrics writes the client's metrics in the Prometheus text exposition
+// format: the number of calls, errors and latency of each procedure, the
+// sizes of the requests sent, the number of updates received and dropped for
+// each stream, and the server's own statistics from KRPC.GetStatus. The
+// server's statistics are left out if the client isn't connected, GetStatus
+// fails or ctx ends first.
+func (c *KRPCClient) WriteMetrics(ctx context.Context, w io.Writer) error {
+	status, statusErr := c.serverStatus(ctx)
+
+	mw := &metricsWriter{w: w}
+	c.metrics.write(mw)
+	if c.StreamClient != nil {
+		c.StreamClient.writeMetrics(mw)
+	}
+
+	up := 0.0
+	if statusErr == nil {
+		up = 1
+	}
+	mw.header("krpc_server_up", "gauge", "Whether the server's statistics could be fetched.")
+	mw.sample("krpc_server_up", nil, up)
+	if statusErr == nil {
+		writeServerMetrics(mw, status)
+	}
+	return tracerr.Wrap(mw.err)
+}
+
+// serverStatus gets the server's status. The call is sent straight to the
+// server, so it isn't batched, intercepted or counted in the metrics. ctx only
+// bounds how long to wait for it: giving up on a call part way through would
+// break the connection. Scrapes made while the call is in flight share it. It
+// fails with ErrNotConnected, without waiting, if the client isn't connected.
+func (c *KRPCClient) serverStatus(ctx context.Context) (*types.Status, error) {
+	if !c.connected() {
+		return nil, tracerr.Wrap(ErrNotConnected)
+	}
+	c.statusMu.Lock()
+	status := c.status
+	if status == nil {
+		status = RunAsync(func() (*types.Status, error) {
+			defer func() {
+				c.statusMu.Lock()
+				c.status = nil
+				c.statusMu.Unlock()
+			}()
+			results, err := c.sendCalls(context.Background(), []*types.ProcedureCall{{
+				Service:   "KRPC",
+				Procedure: "GetStatus",
+			}})
+			if err != nil {
+				return nil, tracerr.Wrap(err)
+			}
+			result, err := firstResult(results)
+			if err != nil {
+				return nil, tracerr.Wrap(err)
+			}
+			var status types.Status
+			if err := proto.Unmarshal(result.Value, &status); err != nil {
+				return nil, tracerr.Wrap(err)
+			}
+			return &status, nil
+		})
+		c.status = status
+	}
+	c.statusMu.Unlock()
+	return status.Wait(ctx)
+}
+
+// write writes the call metrics.
+func (m *callMetrics) write(mw *metricsWriter) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	names := make([]string, 0, len(m.procedures))
+	for name := range m.procedures {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	mw.header("krpc_client_calls_total", "counter", "Procedure calls made.")
+	for _, name := range names {
+		mw.sample("krpc_client_calls_total", []string{"procedure", name}, float64(m.procedures[name].calls))
+	}
+	mw.header("krpc_client_call_errors_total", "counter", "Procedure calls that failed.")
+	for _, name := range names {
+		mw.sample("krpc_client_call_errors_total", []string{"procedure", name}, float64(m.procedures[name].errors))
+	}
+	mw.header("krpc_client_call_duration_seconds", "histogram", "Time taken to get the results of procedure calls.")
+	for _, name := range names {
+		mw.histogram("krpc_client_call_duration_seconds", []string{"procedure", name}, m.procedures[name].latency)
+	}
+	mw.header("krpc_client_request_calls", "histogram", "Number of procedure calls in each request.")
+	if m.batchSizes != nil {
+		mw.histogram("krpc_client_request_calls", nil, m.batchSizes)
+	}
+}
+
+// writeMetrics writes the stream metrics.
+func (s *StreamClient) writeMetrics(mw *metricsWriter) {
+	s.RLock()
+	sms := make([]*streamManager, 0, len(s.streams))
+	for _, sm := range s.streams {
+		sms = append(sms, sm)
+	}
+	s.RUnlock()
+
+	type stream struct {
+		id     uint64
+		labels []string
+		streamMetrics
+	}
+	streams := make([]stream, len(sms))
+	for i, sm := range sms {
+		var procedure string
+		if call := sm.getCall(); call != nil {
+			procedure = procedureName(call)
+		}
+		id := sm.getID()
+		streams[i] = stream{
+			id:            id,
+			labels:        []string{"stream", strconv.FormatUint(id, 10), "procedure", procedure},
+			streamMetrics: sm.getMetrics(),
+		}
+	}
+	sort.Slice(streams, func(i, j int) bool {
+		return streams[i].id < streams[j].id
+	})
+
+	mw.header("krpc_client_stream_updates_total", "counter", "Stream updates received.")
+	for _, st := range streams {
+		mw.sample("krpc_client_stream_updates_total", st.labels, float64(st.updates))
+	}
+	mw.header("krpc_client_stream_errors_total", "counter", "Stream updates received with an error.")
+	for _, st := range streams {
+		mw.sample("krpc_client_stream_errors_total", st.labels, float64(st.errors))
+	}
+	mw.header("krpc_client_stream_dropped_total", "counter", "Stream updates dropped because they weren't received in time.")
+	for _, st := range streams {
+		mw.sample("krpc_client_stream_dropped_total", st.labels, float64(st.dropped))
+	}
+	mw.header("krpc_client_stream_last_update_timestamp_seconds", "gauge", "Time of the last stream update.")
+	for _, st := range streams {
+		if !st.lastUpdate.IsZero() {
+			mw.sample("krpc_client_stream_last_update_timestamp_seconds", st.labels, float64(st.lastUpdate.UnixNano())/1e9)
+		}
+	}
+}
+
+// writeServerMetrics writes the server's statistics.
+func writeServerMetrics(mw *metricsWriter, status *types.Status) {
+	mw.header("krpc_server_info", "gauge", "Information about the server.")
+	mw.sample("krpc_server_info", []string{"version", status.Version}, 1)
+
+	for _, m := range []struct {
+		name, typ, help string
+		value           float64
+	}{
+		{"krpc_server_bytes_read_total", "counter", "Bytes read by the server.", float64(status.BytesRead)},
+		{"krpc_server_bytes_written_total", "counter", "Bytes written by the server.", float64(status.BytesWritten)},
+		{"krpc_server_bytes_read_rate", "gauge", "Bytes read by the server per second.", float64(status.BytesReadRate)},
+		{"krpc_server_bytes_written_rate", "gauge", "Bytes written by the server per second.", float64(status.BytesWrittenRate)},
+		{"krpc_server_rpcs_executed_total", "counter", "RPCs executed by the server.", float64(status.RpcsExecuted)},
+		{"krpc_server_rpc_rate", "gauge", "RPCs executed by the server per second.", float64(status.RpcRate)},
+		{"krpc_server_time_per_rpc_update_seconds", "gauge", "Time taken by the last RPC update.", float64(status.TimePerRpcUpdate)},
+		{"krpc_server_poll_time_per_rpc_update_seconds", "gauge", "Time taken polling for new RPCs in the last RPC update.", float64(status.PollTimePerRpcUpdate)},
+		{"krpc_server_exec_time_per_rpc_update_seconds", "gauge", "Time taken executing RPCs in the last RPC update.", float64(status.ExecTimePerRpcUpdate)},
+		{"krpc_server_stream_rpcs", "gauge", "Streams open on the server.", float64(status.StreamRpcs)},
+		{"krpc_server_stream_rpcs_executed_total", "counter", "Stream RPCs executed by the server.", float64(status.StreamRpcsExecuted)},
+		{"krpc_server_stream_rpc_rate", "gauge", "Stream RPCs executed by the server per second.", float64(status.StreamRpcRate)},
+		{"krpc_server_time_per_stream_update_seconds", "gauge", "Time taken by the last stream update.", float64(status.TimePerStreamUpdate)},
+	} {
+		mw.header(m.name, m.typ, m.help)
+		mw.sample(m.name, nil, m.value)
+	}
+}
+
+// metricsWriter writes metrics in the Prometheus text exposition format. It
+// stops writing after the first error.
+type metricsWriter struct {
+	w   io.Writer
+	err error
+}
+
+// header writes the help and type of a metric.
+func (mw *metricsWriter) header(name, typ, help string) {
+	mw.printf("# HELP %v %v\n# TYPE %v %v\n", name, help, name, typ)
+}
+
+// sample writes a sample. labels are label names and values, alternating.
+func (mw *metricsWriter) sample(name string, labels []string, value float64) {
+	mw.printf("%v%v %v\n", name, formatLabels(labels), formatValue(value))
+}
+
+// histogram writes the samples of a histogram.
+func (mw *metricsWriter) histogram(name string, labels []string, h *histogram) {
+	var cumulative uint64
+	for i, bound := range h.bounds {
+		cumulative += h.counts[i]
+		mw.sample(name+"_bucket", append(labels[:len(labels):len(labels)], "le", formatValue(bound)), float64(cumulative))
+	}
+	mw.sample(name+"_bucket", append(labels[:len(labels):len(labels)], "le", "+Inf"), float64(h.count))
+	mw.sample(name+"_sum", labels, h.sum)
+	mw.sample(name+"_count", labels, float64(h.count))
+}
+
+func (mw *metricsWriter) printf(format string, args ...interface{}) {
+	if mw.err == nil {
+		_, mw.err = fmt.Fprintf(mw.w, format, args...)
+	}
+}
+
+// labelEscaper escapes label values.
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// formatLabels formats label names and values, alternating, as a label set.
+func formatLabels(labels []string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	pairs := make([]string, 0, len(labels)/2)
+	for i := 0; i+1 < len(labels); i += 2 {
+		pairs = append(pairs, fmt.Sprintf(`%v="%v"`, labels[i], labelEscaper.Replace(labels[i+1])))
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+// formatValue formats a sample value.
+func formatValue(v float64) string {
+	if math.IsInf(v, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
@@ -0,0 +1,97 @@
+package krpcgo
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/atburke/krpc-go/krpctest"
+	"github.com/atburke/krpc-go/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsHandler(t *testing.T) {
+	server := krpctest.NewServer(t)
+	server.HandleDefault(func(call *types.ProcedureCall) ([]byte, error) {
+		return nil, nil
+	})
+	server.HandleError("Test", "Fail", &types.Error{Description: "Failed"})
+	client := connect(t, server, KRPCClientConfig{})
+
+	for i := 0; i < 2; i++ {
+		_, err := client.Call(&types.ProcedureCall{Service: "Test", Procedure: "Echo"})
+		require.NoError(t, err)
+	}
+	_, err := client.Call(&types.ProcedureCall{Service: "Test", Procedure: "Fail"})
+	require.Error(t, err)
+
+	stream, err := client.AddStream(&types.ProcedureCall{Service: "Test", Procedure: "Value"})
+	require.NoError(t, err)
+	defer stream.Close()
+	require.Eventually(t, func() bool {
+		require.NoError(t, server.Push(stream.ID, []byte("value")))
+		select {
+		case <-stream.C:
+			return true
+		case <-time.After(10 * time.Millisecond):
+			return false
+		}
+	}, time.Second, 10*time.Millisecond)
+
+	w := httptest.NewRecorder()
+	client.MetricsHandler().ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	body := w.Body.String()
+	require.Contains(t, w.Header().Get("Content-Type"), "text/plain")
+	require.Contains(t, body, "# TYPE krpc_client_calls_total counter\n")
+	require.Contains(t, body, `krpc_client_calls_total{procedure="Test.Echo"} 2`+"\n")
+	require.Contains(t, body, `krpc_client_call_errors_total{procedure="Test.Echo"} 0`+"\n")
+	require.Contains(t, body, `krpc_client_call_errors_total{procedure="Test.Fail"} 1`+"\n")
+	require.Contains(t, body, `krpc_client_call_duration_seconds_bucket{procedure="Test.Echo",le="+Inf"} 2`+"\n")
+	require.Contains(t, body, `krpc_client_call_duration_seconds_count{procedure="Test.Echo"} 2`+"\n")
+	require.Contains(t, body, `krpc_client_request_calls_bucket{le="1"}`)
+	require.Regexp(t, `krpc_client_stream_updates_total\{stream="\d+",procedure="Test.Value"\} [1-9]`, body)
+	require.Contains(t, body, "krpc_server_up 1\n")
+	require.Contains(t, body, `krpc_server_info{version="`+krpctest.DefaultVersion+`"} 1`+"\n")
+	require.Contains(t, body, "# TYPE krpc_server_rpc_rate gauge\n")
+}
+
+func TestMetricsScrapeTimeout(t *testing.T) {
+	server := krpctest.NewServer(t)
+	release := make(chan struct{})
+	server.Handle("KRPC", "GetStatus", func(*types.ProcedureCall) ([]byte, error) {
+		<-release
+		return proto.Marshal(&types.Status{Version: krpctest.DefaultVersion})
+	})
+	server.HandleDefault(func(call *types.ProcedureCall) ([]byte, error) {
+		return []byte(call.Procedure), nil
+	})
+	client := connect(t, server, KRPCClientConfig{SkipVersionCheck: true})
+
+	// A scrape that gives up leaves the connection alone.
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	var body strings.Builder
+	require.NoError(t, client.WriteMetrics(ctx, &body))
+	require.Contains(t, body.String(), "krpc_server_up 0\n")
+	close(release)
+	result, err := client.Call(&types.ProcedureCall{Service: "Test", Procedure: "Echo"})
+	require.NoError(t, err)
+	require.Equal(t, "Echo", string(result.Value))
+
+	// GetStatus isn't counted as one of the client's calls.
+	body.Reset()
+	require.NoError(t, client.WriteMetrics(context.Background(), &body))
+	require.Contains(t, body.String(), "krpc_server_up 1\n")
+	require.NotContains(t, body.String(), "KRPC.GetStatus")
+}
+
+func TestMetricsNotConnected(t *testing.T) {
+	client := NewKRPCClient(KRPCClientConfig{})
+	var body strings.Builder
+	require.NoError(t, client.WriteMetrics(context.Background(), &body))
+	require.Contains(t, body.String(), "krpc_server_up 0\n")
+	require.NotContains(t, body.String(), "krpc_server_info")
+}
@@ -0,0 +1,115 @@
+package krpcgo
+
+// OverflowPolicy decides what happens to a stream update when the stream's
+// buffer is full because its updates aren't being received from C quickly
+// enough.
+type OverflowPolicy int
+
+const (
+	// DropNewest drops the new update, keeping the buffered ones.
+	DropNewest OverflowPolicy = iota
+	// DropOldest drops the oldest buffered update to make room for the new
+	// one.
+	DropOldest
+	// Block waits until the update can be buffered or the stream is closed.
+	// Updates to every stream of the client are held up in the meantime, so
+	// only use it for streams that are received from without delay.
+	Block
+	// Conflate replaces the buffered update with the new one, so that C only
+	// ever holds the most recent update. The buffer size is always 1.
+	Conflate
+)
+
+// StreamConfig configures how a stream buffers its updates.
+type StreamConfig struct {
+	// BufferSize is the number of updates that can wait to be received from
+	// C. Defaults to 0, or 1 for DropOldest.
+	BufferSize int
+	// Overflow decides what happens to an update when the buffer is full.
+	// Defaults to DropNewest.
+	Overflow OverflowPolicy
+	// Rate is the number of updates per second sent by the server. Streams
+	// for the same procedure call share a rate on the server. Defaults to 0,
+	// which is as fast as possible.
+	Rate float32
+	// Unstarted adds the stream to the server without starting it, until it's
+	// started with Start or StartAll.
+	Unstarted bool
+}
+
+// SetDefaults sets the config defaults.
+func (c *StreamConfig) SetDefaults() {
+	if c.BufferSize < 0 {
+		c.BufferSize = 0
+	}
+	switch c.Overflow {
+	case DropOldest:
+		if c.BufferSize == 0 {
+			c.BufferSize = 1
+		}
+	case Conflate:
+		c.BufferSize = 1
+	}
+}
+
+// StreamOption sets an option for a new stream.
+type StreamOption func(*StreamConfig)
+
+// WithBuffer buffers up to size updates until they're received.
+func WithBuffer(size int) StreamOption {
+	return func(cfg *StreamConfig) {
+		cfg.BufferSize = size
+	}
+}
+
+// WithOverflow sets what happens to updates when the buffer is full.
+func WithOverflow(policy OverflowPolicy) StreamOption {
+	return func(cfg *StreamConfig) {
+		cfg.Overflow = policy
+	}
+}
+
+// newStreamConfig creates a stream config from options.
+func newStreamConfig(opts []StreamOption) StreamConfig {
+	var cfg StreamConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	cfg.SetDefaults()
+	return cfg
+}
+
+// send passes a value on to C according to the stream's overflow policy, and
+// returns the number of values dropped.
+func (s *Stream[T]) send(value T) uint64 {
+	var dropped uint64
+	switch s.overflow {
+	case Block:
+		select {
+		case s.C <- value:
+		case <-s.done:
+		}
+	case DropOldest, Conflate:
+		for {
+			select {
+			case s.C <- value:
+				s.dropped.Add(dropped)
+				return dropped
+			default:
+			}
+			select {
+			case <-s.C:
+				dropped++
+			default:
+			}
+		}
+	default:
+		select {
+		case s.C <- value:
+		default:
+			dropped = 1
+		}
+	}
+	s.dropped.Add(dropped)
+	return dropped
+}
@@ -0,0 +1,169 @@
+package krpcgo
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/atburke/krpc-go/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/ztrue/tracerr"
+)
+
+// ErrNotConnected is returned by calls made before the client has connected,
+// and for the server's status in the metrics while the client isn't connected.
+var ErrNotConnected = errors.New("client not connected")
+
+// pipeline matches responses on an RPC connection to requests that have been
+// sent without waiting for earlier responses. The kRPC server answers requests
+// in the order it receives them.
+type pipeline struct {
+	mu      sync.Mutex
+	pending []*Future[[]byte]
+	// err is set once the connection fails.
+	err error
+}
+
+// add adds a future for the response to the next request.
+func (p *pipeline) add() *Future[[]byte] {
+	f := newFuture[[]byte]()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.err != nil {
+		f.resolve(nil, p.err)
+		return f
+	}
+	p.pending = append(p.pending, f)
+	return f
+}
+
+// next gets the future for the oldest unanswered request.
+func (p *pipeline) next() (*Future[[]byte], error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.pending) == 0 {
+		return nil, tracerr.Errorf("Received a response without a pending request")
+	}
+	f := p.pending[0]
+	p.pending = p.pending[1:]
+	return f, nil
+}
+
+// fail fails all pending and future requests.
+func (p *pipeline) fail(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.err = err
+	for _, f := range p.pending {
+		f.resolve(nil, err)
+	}
+	p.pending = nil
+}
+
+// sendPipelined sends a request without waiting for the response, which is
+// delivered to the returned future by the response reader. Callers must hold
+// the lock.
+func (c *KRPCClient) sendPipelined(out []byte) *Future[[]byte] {
+	if c.pipeline == nil {
+		return failedFuture[[]byte](tracerr.Wrap(ErrNotConnected))
+	}
+	// Queue the future and record the request first so that the response
+	// can't arrive before them.
+	f := c.pipeline.add()
+	c.recordRequest(out)
+	if err := c.Send(out); err != nil {
+		// The response reader fails the pending requests once it notices
+		// that the connection is closed.
+		c.conn.Close()
+	}
+	return f
+}
+
+// readResponses reads responses from an RPC connection and delivers them to
+// pipelined requests in the order the requests were sent.
+func (c *KRPCClient) readResponses(conn messageConn, p *pipeline) {
+	for {
+		in, err := conn.ReadMessage()
+		if err == nil {
+			c.recordResponse(in)
+			var f *Future[[]byte]
+			f, err = p.next()
+			if err == nil {
+				f.resolve(in, nil)
+				continue
+			}
+			conn.Close()
+		}
+
+		p.fail(err)
+		if c.Reconnect != nil {
+			c.connectionLost(func() bool { return c.conn == conn })
+		}
+		return
+	}
+}
+
+// CallAsync performs a remote procedure call without waiting for the result.
+// If batching is enabled, the call is sent with other calls made around the
+// same time. Otherwise, if the client is pipelined and has no interceptors,
+// the request is sent before CallAsync returns, and counted in the metrics
+// once its response arrives; otherwise, the call is made in the background.
+func (c *KRPCClient) CallAsync(call *types.ProcedureCall) *Future[*types.ProcedureResult] {
+	return c.CallAsyncContext(context.Background(), call)
+}
+
+// CallAsyncContext performs a remote procedure call without waiting for the
+// result, like CallAsync. The future fails with ctx's error if ctx ends before
+// the result arrives, including while waiting to send the request.
+func (c *KRPCClient) CallAsyncContext(ctx context.Context, call *types.ProcedureCall) *Future[*types.ProcedureResult] {
+	if c.batcher != nil {
+		f := c.batcher.add(call)
+		if ctx.Done() == nil {
+			return f
+		}
+		return RunAsync(func() (*types.ProcedureResult, error) {
+			return f.Wait(ctx)
+		})
+	}
+	if !c.Pipelined || len(c.Interceptors) > 0 {
+		return RunAsync(func() (*types.ProcedureResult, error) {
+			return c.CallContext(ctx, call)
+		})
+	}
+
+	calls := []*types.ProcedureCall{call}
+	out, err := proto.Marshal(&types.Request{
+		Calls: calls,
+	})
+	if err != nil {
+		return failedFuture[*types.ProcedureResult](tracerr.Wrap(err))
+	}
+	if err := ctx.Err(); err != nil {
+		return failedFuture[*types.ProcedureResult](tracerr.Wrap(err))
+	}
+	start := time.Now()
+	select {
+	case c.mu <- struct{}{}:
+	case <-ctx.Done():
+		return failedFuture[*types.ProcedureResult](tracerr.Wrap(ctx.Err()))
+	}
+	response := c.sendPipelined(out)
+	<-c.mu
+
+	f := newFuture[*types.ProcedureResult]()
+	go func() {
+		in, err := response.Wait(ctx)
+		var results []*types.ProcedureResult
+		if err == nil {
+			results, err = decodeResponse(in)
+		}
+		c.metrics.observe(calls, results, err, time.Since(start))
+		if err != nil {
+			f.resolve(nil, tracerr.Wrap(err))
+			return
+		}
+		f.resolve(firstResult(results))
+	}()
+	return f
+}
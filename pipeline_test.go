@@ -0,0 +1,116 @@
+package krpcgo
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/atburke/krpc-go/krpctest"
+	"github.com/atburke/krpc-go/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallAsync(t *testing.T) {
+	for _, pipelined := range []bool{false, true} {
+		pipelined := pipelined
+		t.Run(fmt.Sprintf("pipelined=%v", pipelined), func(t *testing.T) {
+			server := krpctest.NewServer(t)
+			server.HandleDefault(func(call *types.ProcedureCall) ([]byte, error) {
+				return []byte(call.Procedure), nil
+			})
+			server.HandleError("Test", "Fail", &types.Error{Service: "Test", Name: "Failure"})
+			client := connect(t, server, KRPCClientConfig{RPCOnly: true, Pipelined: pipelined})
+
+			var futures []*Future[*types.ProcedureResult]
+			for i := 0; i < 10; i++ {
+				futures = append(futures, client.CallAsync(&types.ProcedureCall{
+					Service:   "Test",
+					Procedure: fmt.Sprint(i),
+				}))
+			}
+			failed := client.CallAsync(&types.ProcedureCall{Service: "Test", Procedure: "Fail"})
+			for i, f := range futures {
+				result, err := f.Wait(context.Background())
+				require.NoError(t, err)
+				require.Equal(t, fmt.Sprint(i), string(result.Value))
+			}
+			_, err := failed.Wait(context.Background())
+			var serverErr *types.Error
+			require.ErrorAs(t, err, &serverErr)
+			require.Equal(t, "Failure", serverErr.Name)
+
+			// Async calls are counted like any other call.
+			client.metrics.mu.Lock()
+			defer client.metrics.mu.Unlock()
+			require.Equal(t, uint64(1), client.metrics.procedures["Test.0"].calls)
+			require.Equal(t, uint64(1), client.metrics.procedures["Test.Fail"].errors)
+		})
+	}
+}
+
+func TestCallAsyncNotConnected(t *testing.T) {
+	client := NewKRPCClient(KRPCClientConfig{RPCOnly: true, Pipelined: true})
+	_, err := client.CallAsync(&types.ProcedureCall{Service: "Test", Procedure: "Echo"}).Wait(context.Background())
+	require.ErrorIs(t, err, ErrNotConnected)
+}
+
+func TestCallAsyncContext(t *testing.T) {
+	server := krpctest.NewServer(t)
+	client := connect(t, server, KRPCClientConfig{RPCOnly: true, Pipelined: true})
+
+	// Hold the lock, as if another call were being sent.
+	client.mu <- struct{}{}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	f := client.CallAsyncContext(ctx, &types.ProcedureCall{Service: "Test", Procedure: "Echo"})
+	_, err := f.Wait(context.Background())
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	<-client.mu
+	server.RequireNotCalled(t, "Test", "Echo")
+}
+
+func TestPipelinedCallContext(t *testing.T) {
+	server := krpctest.NewServer(t)
+	server.HandleDefault(func(call *types.ProcedureCall) ([]byte, error) {
+		if call.Procedure == "Slow" {
+			time.Sleep(100 * time.Millisecond)
+		}
+		return []byte(call.Procedure), nil
+	})
+	client := connect(t, server, KRPCClientConfig{RPCOnly: true, Pipelined: true})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := client.CallContext(ctx, &types.ProcedureCall{Service: "Test", Procedure: "Slow"})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// The abandoned response is discarded rather than closing the connection.
+	result, err := client.Call(&types.ProcedureCall{Service: "Test", Procedure: "Echo"})
+	require.NoError(t, err)
+	require.Equal(t, "Echo", string(result.Value))
+}
+
+func TestMapFuture(t *testing.T) {
+	src := newFuture[int]()
+	dst := MapFuture(src, func(i int) (string, error) {
+		return fmt.Sprint(i * 2), nil
+	})
+	select {
+	case <-dst.Done():
+		require.Fail(t, "future completed early")
+	default:
+	}
+	src.resolve(21, nil)
+	value, err := dst.Wait(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "42", value)
+
+	failed := newFuture[int]()
+	failed.resolve(0, fmt.Errorf("oops"))
+	_, err = MapFuture(failed, func(i int) (string, error) {
+		require.Fail(t, "should not be called")
+		return "", nil
+	}).Wait(context.Background())
+	require.EqualError(t, err, "oops")
+}
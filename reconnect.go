@@ -0,0 +1,250 @@
+package krpcgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/atburke/krpc-go/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/ztrue/tracerr"
+)
+
+// ReconnectPolicy controls how a client reconnects to the server after losing
+// its connection.
+type ReconnectPolicy struct {
+	// MaxAttempts is the maximum number of consecutive reconnection attempts
+	// before giving up. Zero means no limit.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first attempt. Defaults to 1s.
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum delay between attempts. The delay doubles
+	// after each failed attempt until it reaches MaxBackoff. Defaults to 30s.
+	MaxBackoff time.Duration
+}
+
+// SetDefaults sets the policy defaults.
+func (p *ReconnectPolicy) SetDefaults() {
+	if p.InitialBackoff == 0 {
+		p.InitialBackoff = time.Second
+	}
+	if p.MaxBackoff == 0 {
+		p.MaxBackoff = 30 * time.Second
+	}
+	if p.MaxBackoff < p.InitialBackoff {
+		p.MaxBackoff = p.InitialBackoff
+	}
+}
+
+// isClosed checks if the client has been closed.
+func (c *KRPCClient) isClosed() bool {
+	select {
+	case <-c.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+// connected checks if the client has a connection to send calls on: it has
+// connected, and isn't closed or reconnecting.
+func (c *KRPCClient) connected() bool {
+	if c.isClosed() || c.reconnecting.Load() {
+		return false
+	}
+	c.connMu.Lock()
+	defer c.connMu.Unlock()
+	return c.conn != nil
+}
+
+// shouldReconnect checks if the client should try to reconnect after a
+// connection failure. Callers must hold the lock.
+func (c *KRPCClient) shouldReconnect() bool {
+	return c.Reconnect != nil && c.reconnectErr == nil && !c.isClosed()
+}
+
+// streamConnectionLost is called by the stream client when its connection
+// fails. It returns nil once the client has reconnected.
+func (c *KRPCClient) streamConnectionLost(conn messageConn) error {
+	return c.connectionLost(func() bool {
+		return c.StreamClient.getConn() == conn
+	})
+}
+
+// lockStreams takes refMu, if the client has streams, so that no streams are
+// added to or removed from the server while they're added again after
+// reconnecting. It must be taken before the lock. It returns a function that
+// releases it.
+func (c *KRPCClient) lockStreams() func() {
+	if c.StreamClient == nil {
+		return func() {}
+	}
+	c.refMu.Lock()
+	return c.refMu.Unlock
+}
+
+// tryLockStreams is like lockStreams, but fails rather than waiting if refMu
+// is held, such as by a call that adds a stream.
+func (c *KRPCClient) tryLockStreams() (func(), bool) {
+	if c.StreamClient == nil {
+		return func() {}, true
+	}
+	if !c.refMu.TryLock() {
+		return nil, false
+	}
+	return c.refMu.Unlock, true
+}
+
+// connectionLost reconnects after a connection fails, unless isCurrent
+// reports that the failed connection has already been replaced. It returns
+// nil once the client has reconnected.
+func (c *KRPCClient) connectionLost(isCurrent func() bool) error {
+	unlockStreams := c.lockStreams()
+	select {
+	case c.mu <- struct{}{}:
+	case <-c.ctx.Done():
+		unlockStreams()
+		return tracerr.Wrap(c.ctx.Err())
+	}
+	if !c.shouldReconnect() {
+		<-c.mu
+		unlockStreams()
+		if c.isClosed() {
+			return tracerr.Wrap(ErrClosed)
+		}
+		if c.reconnectErr != nil {
+			return tracerr.Wrap(c.reconnectErr)
+		}
+		return tracerr.Errorf("Connection lost")
+	}
+	if !isCurrent() {
+		// Someone else already reconnected.
+		<-c.mu
+		unlockStreams()
+		return nil
+	}
+	return tracerr.Wrap(c.reconnect(unlockStreams))
+}
+
+// reconnect re-establishes the RPC and stream connections and adds all open
+// streams to the server again. Callers must hold refMu, which unlockStreams
+// releases, and the lock. Both are released when reconnect returns.
+func (c *KRPCClient) reconnect(unlockStreams func()) error {
+	defer unlockStreams()
+	defer func() { <-c.mu }()
+	c.reconnecting.Store(true)
+	defer c.reconnecting.Store(false)
+
+	c.connMu.Lock()
+	c.closeConns()
+	c.connMu.Unlock()
+
+	backoff := c.Reconnect.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		select {
+		case <-time.After(backoff):
+		case <-c.closed:
+			return tracerr.Wrap(ErrClosed)
+		case <-c.ctx.Done():
+			c.reconnectErr = c.ctx.Err()
+			return tracerr.Wrap(c.reconnectErr)
+		}
+
+		err := c.connectAttempt()
+		if err == nil {
+			err = c.readdStreams()
+			if err != nil {
+				c.connMu.Lock()
+				c.closeConns()
+				c.connMu.Unlock()
+			}
+		}
+		if err == nil || c.isClosed() {
+			return tracerr.Wrap(err)
+		}
+
+		if c.Reconnect.MaxAttempts > 0 && attempt >= c.Reconnect.MaxAttempts {
+			c.reconnectErr = tracerr.Errorf("Failed to reconnect after %v attempts: %v", attempt, err)
+			return c.reconnectErr
+		}
+		backoff *= 2
+		if backoff > c.Reconnect.MaxBackoff {
+			backoff = c.Reconnect.MaxBackoff
+		}
+	}
+}
+
+// connectAttempt connects to the server again. Close waits for it, and it
+// fails with ErrClosed if the client was closed in the meantime. Callers must
+// hold the lock.
+func (c *KRPCClient) connectAttempt() error {
+	c.connMu.Lock()
+	defer c.connMu.Unlock()
+	if c.isClosed() {
+		return tracerr.Wrap(ErrClosed)
+	}
+	err := c.connectRPC()
+	if err == nil && !c.RPCOnly {
+		err = c.connectStream(c.ctx)
+	}
+	if err == nil && c.isClosed() {
+		err = ErrClosed
+	}
+	if err != nil {
+		c.closeConns()
+		return tracerr.Wrap(err)
+	}
+	c.startPipeline()
+	return nil
+}
+
+// closeConns closes the RPC and stream connections. Callers must hold
+// connMu.
+func (c *KRPCClient) closeConns() {
+	if c.conn != nil {
+		c.conn.Close()
+	}
+	if c.StreamClient != nil {
+		c.StreamClient.Close()
+	}
+}
+
+// readdStreams adds every open stream created with AddStream to the server
+// again, with the same rate and started if it had been started, and moves the
+// local streams over to the new server stream IDs.
+// Callers must hold refMu and the lock.
+func (c *KRPCClient) readdStreams() error {
+	if c.StreamClient == nil {
+		return nil
+	}
+
+	ids := make(map[*streamManager]uint64)
+	for _, sm := range c.StreamClient.addedStreams() {
+		addCall, err := addStreamCall(sm.getCall(), sm.isStarted())
+		if err != nil {
+			return tracerr.Wrap(err)
+		}
+		results, err := c.callLocked(context.Background(), []*types.ProcedureCall{addCall})
+		if err != nil {
+			return tracerr.Wrap(err)
+		}
+		if results[0].Error != nil {
+			return tracerr.Wrap(translateError(results[0].Error))
+		}
+		var st types.Stream
+		if err := proto.Unmarshal(results[0].Value, &st); err != nil {
+			return tracerr.Wrap(err)
+		}
+		if rate := sm.getRate(); rate != 0 {
+			results, err := c.callLocked(context.Background(), []*types.ProcedureCall{setStreamRateCall(st.Id, rate)})
+			if err != nil {
+				return tracerr.Wrap(err)
+			}
+			if results[0].Error != nil {
+				return tracerr.Wrap(translateError(results[0].Error))
+			}
+		}
+		ids[sm] = st.Id
+	}
+	c.StreamClient.remapStreams(ids)
+	return nil
+}
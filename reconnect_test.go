@@ -0,0 +1,112 @@
+package krpcgo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/atburke/krpc-go/krpctest"
+	"github.com/atburke/krpc-go/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconnect(t *testing.T) {
+	server := krpctest.NewServer(t)
+	server.HandleDefault(func(call *types.ProcedureCall) ([]byte, error) {
+		return []byte(call.Procedure), nil
+	})
+	client := connect(t, server, KRPCClientConfig{
+		Reconnect: &ReconnectPolicy{InitialBackoff: 10 * time.Millisecond},
+	})
+
+	stream, err := client.AddStream(&types.ProcedureCall{Service: "Test", Procedure: "Value"})
+	require.NoError(t, err)
+	t.Cleanup(func() { stream.Close() })
+
+	// receiveOn pushes values on a server stream until one comes through.
+	receiveOn := func(id uint64, value string) {
+		require.Eventually(t, func() bool {
+			server.Push(id, []byte(value))
+			select {
+			case data := <-stream.C:
+				return string(data) == value
+			case <-time.After(10 * time.Millisecond):
+				return false
+			}
+		}, time.Second, 10*time.Millisecond)
+	}
+	ids := server.StreamIDs()
+	require.Len(t, ids, 1)
+	receiveOn(ids[0], "before")
+
+	server.DropConnections()
+
+	// The stream is added again under a new ID and the same channel keeps
+	// receiving.
+	require.Eventually(t, func() bool {
+		ids = server.StreamIDs()
+		return len(ids) == 1
+	}, time.Second, 10*time.Millisecond)
+	receiveOn(ids[0], "after")
+
+	require.Eventually(t, func() bool {
+		result, err := client.Call(&types.ProcedureCall{Service: "Test", Procedure: "Echo"})
+		return err == nil && string(result.Value) == "Echo"
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestReconnectGivesUp(t *testing.T) {
+	server := krpctest.NewServer(t)
+	client := connect(t, server, KRPCClientConfig{
+		RPCOnly:   true,
+		Reconnect: &ReconnectPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond},
+	})
+	server.Close()
+
+	_, err := client.Call(&types.ProcedureCall{Service: "Test", Procedure: "Echo"})
+	require.Error(t, err)
+	require.Eventually(t, func() bool {
+		_, err := client.Call(&types.ProcedureCall{Service: "Test", Procedure: "Echo"})
+		return err != nil && client.reconnectErr != nil
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestReconnectLosesEvents(t *testing.T) {
+	server := krpctest.NewServer(t)
+	client := connect(t, server, KRPCClientConfig{
+		Reconnect: &ReconnectPolicy{InitialBackoff: 10 * time.Millisecond},
+	})
+	event := addEvent(t, client)
+
+	// Events can't be added again, so they end instead of waiting forever.
+	server.DropConnections()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.ErrorIs(t, event.Wait(ctx), ErrStreamLost)
+
+	// The event's ID isn't removed from the new connection's streams.
+	require.NoError(t, event.Remove())
+	server.RequireNotCalled(t, "KRPC", "RemoveStream")
+}
+
+func TestCloseWhileReconnecting(t *testing.T) {
+	for _, delay := range []time.Duration{0, time.Millisecond, 5 * time.Millisecond, 20 * time.Millisecond} {
+		server := krpctest.NewServer(t)
+		client := connect(t, server, KRPCClientConfig{
+			Reconnect: &ReconnectPolicy{InitialBackoff: time.Millisecond},
+		})
+		stream, err := client.AddStream(&types.ProcedureCall{Service: "Test", Procedure: "Value"})
+		require.NoError(t, err)
+
+		server.DropConnections()
+		time.Sleep(delay)
+		client.Close()
+
+		// Streams end rather than carrying on with a new connection.
+		select {
+		case <-stream.Done():
+		case <-time.After(time.Second):
+			t.Fatalf("Stream still running after closing %v into reconnecting", delay)
+		}
+	}
+}
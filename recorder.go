@@ -0,0 +1,41 @@
+package krpcgo
+
+import (
+	"github.com/atburke/krpc-go/types"
+	"github.com/golang/protobuf/proto"
+)
+
+// Recorder records the messages exchanged with the server. Methods may be
+// called concurrently. See lib/record for a recorder that writes them to a
+// file.
+type Recorder interface {
+	// RecordRequest records a request sent to the RPC server.
+	RecordRequest(*types.Request)
+	// RecordResponse records a response received from the RPC server.
+	RecordResponse(*types.Response)
+	// RecordStreamUpdate records an update received from the stream server.
+	RecordStreamUpdate(*types.StreamUpdate)
+}
+
+// recordRequest passes a sent request to the recorder, if there is one.
+func (c *KRPCClient) recordRequest(out []byte) {
+	if c.Recorder == nil {
+		return
+	}
+	var req types.Request
+	if err := proto.Unmarshal(out, &req); err == nil {
+		c.Recorder.RecordRequest(&req)
+	}
+}
+
+// recordResponse passes a received response to the recorder, if there is
+// one.
+func (c *KRPCClient) recordResponse(in []byte) {
+	if c.Recorder == nil {
+		return
+	}
+	var resp types.Response
+	if err := proto.Unmarshal(in, &resp); err == nil {
+		c.Recorder.RecordResponse(&resp)
+	}
+}
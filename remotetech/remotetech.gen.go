@@ -6,8 +6,8 @@
 package remotetech
 
 import (
+	"context"
 	krpcgo "github.com/atburke/krpc-go"
-	krpc "github.com/atburke/krpc-go/krpc"
 	encode "github.com/atburke/krpc-go/lib/encode"
 	service "github.com/atburke/krpc-go/lib/service"
 	spacecenter "github.com/atburke/krpc-go/spacecenter"
@@ -50,7 +50,7 @@ type Antenna struct {
 }
 
 // NewAntenna creates a new Antenna.
-func NewAntenna(id uint64, client *krpcgo.KRPCClient) *Antenna {
+func NewAntenna(id uint64, client krpcgo.Caller) *Antenna {
 	c := &Antenna{BaseClass: service.BaseClass{Client: client}}
 	c.SetID(id)
 	return c
@@ -62,7 +62,7 @@ type Comms struct {
 }
 
 // NewComms creates a new Comms.
-func NewComms(id uint64, client *krpcgo.KRPCClient) *Comms {
+func NewComms(id uint64, client krpcgo.Caller) *Comms {
 	c := &Comms{BaseClass: service.BaseClass{Client: client}}
 	c.SetID(id)
 	return c
@@ -71,11 +71,11 @@ func NewComms(id uint64, client *krpcgo.KRPCClient) *Comms {
 // RemoteTech - this service provides functionality to interact with <a
 // href="https://forum.kerbalspaceprogram.com/index.php?/topic/139167-13-remotetech-v188-2017-09-03/">RemoteTech</a>.
 type RemoteTech struct {
-	Client *krpcgo.KRPCClient
+	Client krpcgo.Caller
 }
 
 // New creates a new RemoteTech.
-func New(client *krpcgo.KRPCClient) *RemoteTech {
+func New(client krpcgo.Caller) *RemoteTech {
 	return &RemoteTech{Client: client}
 }
 
@@ -84,6 +84,14 @@ func New(client *krpcgo.KRPCClient) *RemoteTech {
 //
 // Allowed game scenes: any.
 func (s *RemoteTech) Comms(vessel *spacecenter.Vessel) (*Comms, error) {
+	return s.CommsContext(context.Background(), vessel)
+}
+
+// CommsContext - get a communications object, representing the communication
+// capability of a particular vessel.
+//
+// Allowed game scenes: any.
+func (s *RemoteTech) CommsContext(ctx context.Context, vessel *spacecenter.Vessel) (*Comms, error) {
 	var err error
 	var argBytes []byte
 	var vv Comms
@@ -99,7 +107,7 @@ func (s *RemoteTech) Comms(vessel *spacecenter.Vessel) (*Comms, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -111,10 +119,83 @@ func (s *RemoteTech) Comms(vessel *spacecenter.Vessel) (*Comms, error) {
 	return &vv, nil
 }
 
+// CommsAsync - get a communications object, representing the communication
+// capability of a particular vessel.
+//
+// Allowed game scenes: any.
+func (s *RemoteTech) CommsAsync(vessel *spacecenter.Vessel) (*krpcgo.Future[*Comms], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Comms",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(vessel)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Comms, error) {
+		var vv Comms
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// CommsCall builds a call to Comms without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *RemoteTech) CommsCall(vessel *spacecenter.Vessel) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Comms",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(vessel)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeComms decodes the result of a call built with CommsCall.
+func (s *RemoteTech) DecodeComms(result *types.ProcedureResult) (*Comms, error) {
+	var vv Comms
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
 // Antenna - get the antenna object for a particular part.
 //
 // Allowed game scenes: any.
 func (s *RemoteTech) Antenna(part *spacecenter.Part) (*Antenna, error) {
+	return s.AntennaContext(context.Background(), part)
+}
+
+// AntennaContext - get the antenna object for a particular part.
+//
+// Allowed game scenes: any.
+func (s *RemoteTech) AntennaContext(ctx context.Context, part *spacecenter.Part) (*Antenna, error) {
 	var err error
 	var argBytes []byte
 	var vv Antenna
@@ -130,7 +211,7 @@ func (s *RemoteTech) Antenna(part *spacecenter.Part) (*Antenna, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -142,17 +223,89 @@ func (s *RemoteTech) Antenna(part *spacecenter.Part) (*Antenna, error) {
 	return &vv, nil
 }
 
+// AntennaAsync - get the antenna object for a particular part.
+//
+// Allowed game scenes: any.
+func (s *RemoteTech) AntennaAsync(part *spacecenter.Part) (*krpcgo.Future[*Antenna], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Antenna",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(part)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Antenna, error) {
+		var vv Antenna
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// AntennaCall builds a call to Antenna without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *RemoteTech) AntennaCall(part *spacecenter.Part) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Antenna",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(part)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeAntenna decodes the result of a call built with AntennaCall.
+func (s *RemoteTech) DecodeAntenna(result *types.ProcedureResult) (*Antenna, error) {
+	var vv Antenna
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
 // Available - whether RemoteTech is installed.
 //
 // Allowed game scenes: any.
 func (s *RemoteTech) Available() (bool, error) {
+	return s.AvailableContext(context.Background())
+}
+
+// AvailableContext - whether RemoteTech is installed.
+//
+// Allowed game scenes: any.
+func (s *RemoteTech) AvailableContext(ctx context.Context) (bool, error) {
 	var err error
 	var vv bool
 	request := &types.ProcedureCall{
 		Procedure: "get_Available",
 		Service:   "RemoteTech",
 	}
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -163,43 +316,88 @@ func (s *RemoteTech) Available() (bool, error) {
 	return vv, nil
 }
 
+// AvailableAsync - whether RemoteTech is installed.
+//
+// Allowed game scenes: any.
+func (s *RemoteTech) AvailableAsync() (*krpcgo.Future[bool], error) {
+	request := &types.ProcedureCall{
+		Procedure: "get_Available",
+		Service:   "RemoteTech",
+	}
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (bool, error) {
+		var vv bool
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
 // AvailableStream - whether RemoteTech is installed.
 //
 // Allowed game scenes: any.
-func (s *RemoteTech) AvailableStream() (*krpcgo.Stream[bool], error) {
+func (s *RemoteTech) AvailableStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[bool], error) {
 	var err error
 	request := &types.ProcedureCall{
 		Procedure: "get_Available",
 		Service:   "RemoteTech",
 	}
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) bool {
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (bool, error) {
 		var value bool
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
 	return stream, nil
 }
 
+// AvailableCall builds a call to Available without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *RemoteTech) AvailableCall() (*types.ProcedureCall, error) {
+	request := &types.ProcedureCall{
+		Procedure: "get_Available",
+		Service:   "RemoteTech",
+	}
+	return request, nil
+}
+
+// DecodeAvailable decodes the result of a call built with AvailableCall.
+func (s *RemoteTech) DecodeAvailable(result *types.ProcedureResult) (bool, error) {
+	var vv bool
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
 // GroundStations - the names of the ground stations.
 //
 // Allowed game scenes: any.
 func (s *RemoteTech) GroundStations() ([]string, error) {
+	return s.GroundStationsContext(context.Background())
+}
+
+// GroundStationsContext - the names of the ground stations.
+//
+// Allowed game scenes: any.
+func (s *RemoteTech) GroundStationsContext(ctx context.Context) ([]string, error) {
 	var err error
 	var vv []string
 	request := &types.ProcedureCall{
 		Procedure: "get_GroundStations",
 		Service:   "RemoteTech",
 	}
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -210,41 +408,1401 @@ func (s *RemoteTech) GroundStations() ([]string, error) {
 	return vv, nil
 }
 
+// GroundStationsAsync - the names of the ground stations.
+//
+// Allowed game scenes: any.
+func (s *RemoteTech) GroundStationsAsync() (*krpcgo.Future[[]string], error) {
+	request := &types.ProcedureCall{
+		Procedure: "get_GroundStations",
+		Service:   "RemoteTech",
+	}
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) ([]string, error) {
+		var vv []string
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
 // GroundStationsStream - the names of the ground stations.
 //
 // Allowed game scenes: any.
-func (s *RemoteTech) GroundStationsStream() (*krpcgo.Stream[[]string], error) {
+func (s *RemoteTech) GroundStationsStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[[]string], error) {
 	var err error
 	request := &types.ProcedureCall{
 		Procedure: "get_GroundStations",
 		Service:   "RemoteTech",
 	}
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) []string {
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) ([]string, error) {
 		var value []string
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
 	return stream, nil
 }
 
-// Part - get the part containing this antenna.
+// GroundStationsCall builds a call to GroundStations without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *RemoteTech) GroundStationsCall() (*types.ProcedureCall, error) {
+	request := &types.ProcedureCall{
+		Procedure: "get_GroundStations",
+		Service:   "RemoteTech",
+	}
+	return request, nil
+}
+
+// DecodeGroundStations decodes the result of a call built with
+// GroundStationsCall.
+func (s *RemoteTech) DecodeGroundStations(result *types.ProcedureResult) ([]string, error) {
+	var vv []string
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// Part - get the part containing this antenna.
+//
+// Allowed game scenes: any.
+func (s *Antenna) Part() (*spacecenter.Part, error) {
+	return s.PartContext(context.Background())
+}
+
+// PartContext - get the part containing this antenna.
+//
+// Allowed game scenes: any.
+func (s *Antenna) PartContext(ctx context.Context) (*spacecenter.Part, error) {
+	var err error
+	var argBytes []byte
+	var vv spacecenter.Part
+	request := &types.ProcedureCall{
+		Procedure: "Antenna_get_Part",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// PartAsync - get the part containing this antenna.
+//
+// Allowed game scenes: any.
+func (s *Antenna) PartAsync() (*krpcgo.Future[*spacecenter.Part], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Antenna_get_Part",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*spacecenter.Part, error) {
+		var vv spacecenter.Part
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// PartCall builds a call to Part without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Antenna) PartCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Antenna_get_Part",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodePart decodes the result of a call built with PartCall.
+func (s *Antenna) DecodePart(result *types.ProcedureResult) (*spacecenter.Part, error) {
+	var vv spacecenter.Part
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// HasConnection - whether the antenna has a connection.
+//
+// Allowed game scenes: any.
+func (s *Antenna) HasConnection() (bool, error) {
+	return s.HasConnectionContext(context.Background())
+}
+
+// HasConnectionContext - whether the antenna has a connection.
+//
+// Allowed game scenes: any.
+func (s *Antenna) HasConnectionContext(ctx context.Context) (bool, error) {
+	var err error
+	var argBytes []byte
+	var vv bool
+	request := &types.ProcedureCall{
+		Procedure: "Antenna_get_HasConnection",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// HasConnectionAsync - whether the antenna has a connection.
+//
+// Allowed game scenes: any.
+func (s *Antenna) HasConnectionAsync() (*krpcgo.Future[bool], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Antenna_get_HasConnection",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (bool, error) {
+		var vv bool
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// HasConnectionStream - whether the antenna has a connection.
+//
+// Allowed game scenes: any.
+func (s *Antenna) HasConnectionStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[bool], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Antenna_get_HasConnection",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (bool, error) {
+		var value bool
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// HasConnectionCall builds a call to HasConnection without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *Antenna) HasConnectionCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Antenna_get_HasConnection",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeHasConnection decodes the result of a call built with
+// HasConnectionCall.
+func (s *Antenna) DecodeHasConnection(result *types.ProcedureResult) (bool, error) {
+	var vv bool
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// Target - the object that the antenna is targetting. This property can be used
+// to set the target to <see cref="M:RemoteTech.Target.None" /> or <see
+// cref="M:RemoteTech.Target.ActiveVessel" />. To set the target to a celestial
+// body, ground station or vessel see <see
+// cref="M:RemoteTech.Antenna.TargetBody" />, <see
+// cref="M:RemoteTech.Antenna.TargetGroundStation" /> and <see
+// cref="M:RemoteTech.Antenna.TargetVessel" />.
+//
+// Allowed game scenes: any.
+func (s *Antenna) Target() (Target, error) {
+	return s.TargetContext(context.Background())
+}
+
+// TargetContext - the object that the antenna is targetting. This property can
+// be used to set the target to <see cref="M:RemoteTech.Target.None" /> or <see
+// cref="M:RemoteTech.Target.ActiveVessel" />. To set the target to a celestial
+// body, ground station or vessel see <see
+// cref="M:RemoteTech.Antenna.TargetBody" />, <see
+// cref="M:RemoteTech.Antenna.TargetGroundStation" /> and <see
+// cref="M:RemoteTech.Antenna.TargetVessel" />.
+//
+// Allowed game scenes: any.
+func (s *Antenna) TargetContext(ctx context.Context) (Target, error) {
+	var err error
+	var argBytes []byte
+	var vv Target
+	request := &types.ProcedureCall{
+		Procedure: "Antenna_get_Target",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// TargetAsync - the object that the antenna is targetting. This property can be
+// used to set the target to <see cref="M:RemoteTech.Target.None" /> or <see
+// cref="M:RemoteTech.Target.ActiveVessel" />. To set the target to a celestial
+// body, ground station or vessel see <see
+// cref="M:RemoteTech.Antenna.TargetBody" />, <see
+// cref="M:RemoteTech.Antenna.TargetGroundStation" /> and <see
+// cref="M:RemoteTech.Antenna.TargetVessel" />.
+//
+// Allowed game scenes: any.
+func (s *Antenna) TargetAsync() (*krpcgo.Future[Target], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Antenna_get_Target",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (Target, error) {
+		var vv Target
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// TargetStream - the object that the antenna is targetting. This property can
+// be used to set the target to <see cref="M:RemoteTech.Target.None" /> or <see
+// cref="M:RemoteTech.Target.ActiveVessel" />. To set the target to a celestial
+// body, ground station or vessel see <see
+// cref="M:RemoteTech.Antenna.TargetBody" />, <see
+// cref="M:RemoteTech.Antenna.TargetGroundStation" /> and <see
+// cref="M:RemoteTech.Antenna.TargetVessel" />.
+//
+// Allowed game scenes: any.
+func (s *Antenna) TargetStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[Target], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Antenna_get_Target",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (Target, error) {
+		var value Target
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// TargetCall builds a call to Target without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Antenna) TargetCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Antenna_get_Target",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeTarget decodes the result of a call built with TargetCall.
+func (s *Antenna) DecodeTarget(result *types.ProcedureResult) (Target, error) {
+	var vv Target
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetTarget - the object that the antenna is targetting. This property can be
+// used to set the target to <see cref="M:RemoteTech.Target.None" /> or <see
+// cref="M:RemoteTech.Target.ActiveVessel" />. To set the target to a celestial
+// body, ground station or vessel see <see
+// cref="M:RemoteTech.Antenna.TargetBody" />, <see
+// cref="M:RemoteTech.Antenna.TargetGroundStation" /> and <see
+// cref="M:RemoteTech.Antenna.TargetVessel" />.
+//
+// Allowed game scenes: any.
+func (s *Antenna) SetTarget(value Target) error {
+	return s.SetTargetContext(context.Background(), value)
+}
+
+// SetTargetContext - the object that the antenna is targetting. This property
+// can be used to set the target to <see cref="M:RemoteTech.Target.None" /> or
+// <see cref="M:RemoteTech.Target.ActiveVessel" />. To set the target to a
+// celestial body, ground station or vessel see <see
+// cref="M:RemoteTech.Antenna.TargetBody" />, <see
+// cref="M:RemoteTech.Antenna.TargetGroundStation" /> and <see
+// cref="M:RemoteTech.Antenna.TargetVessel" />.
+//
+// Allowed game scenes: any.
+func (s *Antenna) SetTargetContext(ctx context.Context, value Target) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Antenna_set_Target",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetTargetAsync - the object that the antenna is targetting. This property can
+// be used to set the target to <see cref="M:RemoteTech.Target.None" /> or <see
+// cref="M:RemoteTech.Target.ActiveVessel" />. To set the target to a celestial
+// body, ground station or vessel see <see
+// cref="M:RemoteTech.Antenna.TargetBody" />, <see
+// cref="M:RemoteTech.Antenna.TargetGroundStation" /> and <see
+// cref="M:RemoteTech.Antenna.TargetVessel" />.
+//
+// Allowed game scenes: any.
+func (s *Antenna) SetTargetAsync(value Target) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Antenna_set_Target",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetTargetCall builds a call to SetTarget without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Antenna) SetTargetCall(value Target) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Antenna_set_Target",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// TargetBody - the celestial body the antenna is targetting.
+//
+// Allowed game scenes: any.
+func (s *Antenna) TargetBody() (*spacecenter.CelestialBody, error) {
+	return s.TargetBodyContext(context.Background())
+}
+
+// TargetBodyContext - the celestial body the antenna is targetting.
+//
+// Allowed game scenes: any.
+func (s *Antenna) TargetBodyContext(ctx context.Context) (*spacecenter.CelestialBody, error) {
+	var err error
+	var argBytes []byte
+	var vv spacecenter.CelestialBody
+	request := &types.ProcedureCall{
+		Procedure: "Antenna_get_TargetBody",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// TargetBodyAsync - the celestial body the antenna is targetting.
+//
+// Allowed game scenes: any.
+func (s *Antenna) TargetBodyAsync() (*krpcgo.Future[*spacecenter.CelestialBody], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Antenna_get_TargetBody",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*spacecenter.CelestialBody, error) {
+		var vv spacecenter.CelestialBody
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// TargetBodyCall builds a call to TargetBody without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Antenna) TargetBodyCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Antenna_get_TargetBody",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeTargetBody decodes the result of a call built with TargetBodyCall.
+func (s *Antenna) DecodeTargetBody(result *types.ProcedureResult) (*spacecenter.CelestialBody, error) {
+	var vv spacecenter.CelestialBody
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// SetTargetBody - the celestial body the antenna is targetting.
+//
+// Allowed game scenes: any.
+func (s *Antenna) SetTargetBody(value *spacecenter.CelestialBody) error {
+	return s.SetTargetBodyContext(context.Background(), value)
+}
+
+// SetTargetBodyContext - the celestial body the antenna is targetting.
+//
+// Allowed game scenes: any.
+func (s *Antenna) SetTargetBodyContext(ctx context.Context, value *spacecenter.CelestialBody) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Antenna_set_TargetBody",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetTargetBodyAsync - the celestial body the antenna is targetting.
+//
+// Allowed game scenes: any.
+func (s *Antenna) SetTargetBodyAsync(value *spacecenter.CelestialBody) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Antenna_set_TargetBody",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetTargetBodyCall builds a call to SetTargetBody without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *Antenna) SetTargetBodyCall(value *spacecenter.CelestialBody) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Antenna_set_TargetBody",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// TargetGroundStation - the ground station the antenna is targetting.
+//
+// Allowed game scenes: any.
+func (s *Antenna) TargetGroundStation() (string, error) {
+	return s.TargetGroundStationContext(context.Background())
+}
+
+// TargetGroundStationContext - the ground station the antenna is targetting.
+//
+// Allowed game scenes: any.
+func (s *Antenna) TargetGroundStationContext(ctx context.Context) (string, error) {
+	var err error
+	var argBytes []byte
+	var vv string
+	request := &types.ProcedureCall{
+		Procedure: "Antenna_get_TargetGroundStation",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// TargetGroundStationAsync - the ground station the antenna is targetting.
+//
+// Allowed game scenes: any.
+func (s *Antenna) TargetGroundStationAsync() (*krpcgo.Future[string], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Antenna_get_TargetGroundStation",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (string, error) {
+		var vv string
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// TargetGroundStationStream - the ground station the antenna is targetting.
+//
+// Allowed game scenes: any.
+func (s *Antenna) TargetGroundStationStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[string], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Antenna_get_TargetGroundStation",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (string, error) {
+		var value string
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// TargetGroundStationCall builds a call to TargetGroundStation without making
+// it, such as to make several calls with CallMultiple, add a stream with
+// AddStream or use it in an expression.
+func (s *Antenna) TargetGroundStationCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Antenna_get_TargetGroundStation",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeTargetGroundStation decodes the result of a call built with
+// TargetGroundStationCall.
+func (s *Antenna) DecodeTargetGroundStation(result *types.ProcedureResult) (string, error) {
+	var vv string
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetTargetGroundStation - the ground station the antenna is targetting.
+//
+// Allowed game scenes: any.
+func (s *Antenna) SetTargetGroundStation(value string) error {
+	return s.SetTargetGroundStationContext(context.Background(), value)
+}
+
+// SetTargetGroundStationContext - the ground station the antenna is targetting.
+//
+// Allowed game scenes: any.
+func (s *Antenna) SetTargetGroundStationContext(ctx context.Context, value string) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Antenna_set_TargetGroundStation",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetTargetGroundStationAsync - the ground station the antenna is targetting.
+//
+// Allowed game scenes: any.
+func (s *Antenna) SetTargetGroundStationAsync(value string) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Antenna_set_TargetGroundStation",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetTargetGroundStationCall builds a call to SetTargetGroundStation without
+// making it, such as to make several calls with CallMultiple, add a stream with
+// AddStream or use it in an expression.
+func (s *Antenna) SetTargetGroundStationCall(value string) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Antenna_set_TargetGroundStation",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// TargetVessel - the vessel the antenna is targetting.
+//
+// Allowed game scenes: any.
+func (s *Antenna) TargetVessel() (*spacecenter.Vessel, error) {
+	return s.TargetVesselContext(context.Background())
+}
+
+// TargetVesselContext - the vessel the antenna is targetting.
+//
+// Allowed game scenes: any.
+func (s *Antenna) TargetVesselContext(ctx context.Context) (*spacecenter.Vessel, error) {
+	var err error
+	var argBytes []byte
+	var vv spacecenter.Vessel
+	request := &types.ProcedureCall{
+		Procedure: "Antenna_get_TargetVessel",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// TargetVesselAsync - the vessel the antenna is targetting.
+//
+// Allowed game scenes: any.
+func (s *Antenna) TargetVesselAsync() (*krpcgo.Future[*spacecenter.Vessel], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Antenna_get_TargetVessel",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*spacecenter.Vessel, error) {
+		var vv spacecenter.Vessel
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// TargetVesselCall builds a call to TargetVessel without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *Antenna) TargetVesselCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Antenna_get_TargetVessel",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeTargetVessel decodes the result of a call built with TargetVesselCall.
+func (s *Antenna) DecodeTargetVessel(result *types.ProcedureResult) (*spacecenter.Vessel, error) {
+	var vv spacecenter.Vessel
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// SetTargetVessel - the vessel the antenna is targetting.
+//
+// Allowed game scenes: any.
+func (s *Antenna) SetTargetVessel(value *spacecenter.Vessel) error {
+	return s.SetTargetVesselContext(context.Background(), value)
+}
+
+// SetTargetVesselContext - the vessel the antenna is targetting.
+//
+// Allowed game scenes: any.
+func (s *Antenna) SetTargetVesselContext(ctx context.Context, value *spacecenter.Vessel) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Antenna_set_TargetVessel",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetTargetVesselAsync - the vessel the antenna is targetting.
+//
+// Allowed game scenes: any.
+func (s *Antenna) SetTargetVesselAsync(value *spacecenter.Vessel) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Antenna_set_TargetVessel",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetTargetVesselCall builds a call to SetTargetVessel without making it, such
+// as to make several calls with CallMultiple, add a stream with AddStream or
+// use it in an expression.
+func (s *Antenna) SetTargetVesselCall(value *spacecenter.Vessel) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Antenna_set_TargetVessel",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// SignalDelayToVessel - the signal delay between the this vessel and another
+// vessel, in seconds.
+//
+// Allowed game scenes: any.
+func (s *Comms) SignalDelayToVessel(other *spacecenter.Vessel) (float64, error) {
+	return s.SignalDelayToVesselContext(context.Background(), other)
+}
+
+// SignalDelayToVesselContext - the signal delay between the this vessel and
+// another vessel, in seconds.
+//
+// Allowed game scenes: any.
+func (s *Comms) SignalDelayToVesselContext(ctx context.Context, other *spacecenter.Vessel) (float64, error) {
+	var err error
+	var argBytes []byte
+	var vv float64
+	request := &types.ProcedureCall{
+		Procedure: "Comms_SignalDelayToVessel",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(other)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SignalDelayToVesselAsync - the signal delay between the this vessel and
+// another vessel, in seconds.
+//
+// Allowed game scenes: any.
+func (s *Comms) SignalDelayToVesselAsync(other *spacecenter.Vessel) (*krpcgo.Future[float64], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Comms_SignalDelayToVessel",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(other)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (float64, error) {
+		var vv float64
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// SignalDelayToVesselStream - the signal delay between the this vessel and
+// another vessel, in seconds.
+//
+// Allowed game scenes: any.
+func (s *Comms) SignalDelayToVesselStream(other *spacecenter.Vessel, opts ...krpcgo.StreamOption) (*krpcgo.Stream[float64], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Comms_SignalDelayToVessel",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(other)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (float64, error) {
+		var value float64
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// SignalDelayToVesselCall builds a call to SignalDelayToVessel without making
+// it, such as to make several calls with CallMultiple, add a stream with
+// AddStream or use it in an expression.
+func (s *Comms) SignalDelayToVesselCall(other *spacecenter.Vessel) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Comms_SignalDelayToVessel",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(other)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeSignalDelayToVessel decodes the result of a call built with
+// SignalDelayToVesselCall.
+func (s *Comms) DecodeSignalDelayToVessel(result *types.ProcedureResult) (float64, error) {
+	var vv float64
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// Vessel - get the vessel.
 //
 // Allowed game scenes: any.
-func (s *Antenna) Part() (*spacecenter.Part, error) {
+func (s *Comms) Vessel() (*spacecenter.Vessel, error) {
+	return s.VesselContext(context.Background())
+}
+
+// VesselContext - get the vessel.
+//
+// Allowed game scenes: any.
+func (s *Comms) VesselContext(ctx context.Context) (*spacecenter.Vessel, error) {
 	var err error
 	var argBytes []byte
-	var vv spacecenter.Part
+	var vv spacecenter.Vessel
 	request := &types.ProcedureCall{
-		Procedure: "Antenna_get_Part",
+		Procedure: "Comms_get_Vessel",
 		Service:   "RemoteTech",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -255,7 +1813,7 @@ func (s *Antenna) Part() (*spacecenter.Part, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -267,44 +1825,44 @@ func (s *Antenna) Part() (*spacecenter.Part, error) {
 	return &vv, nil
 }
 
-// HasConnection - whether the antenna has a connection.
+// VesselAsync - get the vessel.
 //
 // Allowed game scenes: any.
-func (s *Antenna) HasConnection() (bool, error) {
+func (s *Comms) VesselAsync() (*krpcgo.Future[*spacecenter.Vessel], error) {
 	var err error
 	var argBytes []byte
-	var vv bool
 	request := &types.ProcedureCall{
-		Procedure: "Antenna_get_HasConnection",
+		Procedure: "Comms_get_Vessel",
 		Service:   "RemoteTech",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	return vv, nil
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*spacecenter.Vessel, error) {
+		var vv spacecenter.Vessel
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
 }
 
-// HasConnectionStream - whether the antenna has a connection.
-//
-// Allowed game scenes: any.
-func (s *Antenna) HasConnectionStream() (*krpcgo.Stream[bool], error) {
+// VesselCall builds a call to Vessel without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Comms) VesselCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Antenna_get_HasConnection",
+		Procedure: "Comms_get_Vessel",
 		Service:   "RemoteTech",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -315,38 +1873,39 @@ func (s *Antenna) HasConnectionStream() (*krpcgo.Stream[bool], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	return request, nil
+}
+
+// DecodeVessel decodes the result of a call built with VesselCall.
+func (s *Comms) DecodeVessel(result *types.ProcedureResult) (*spacecenter.Vessel, error) {
+	var vv spacecenter.Vessel
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return nil, tracerr.Wrap(err)
+		return &vv, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) bool {
-		var value bool
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
-	})
-	return stream, nil
+	vv.Client = s.Client
+	return &vv, nil
 }
 
-// Target - the object that the antenna is targetting. This property can be used
-// to set the target to <see cref="M:RemoteTech.Target.None" /> or <see
-// cref="M:RemoteTech.Target.ActiveVessel" />. To set the target to a celestial
-// body, ground station or vessel see <see
-// cref="M:RemoteTech.Antenna.TargetBody" />, <see
-// cref="M:RemoteTech.Antenna.TargetGroundStation" /> and <see
-// cref="M:RemoteTech.Antenna.TargetVessel" />.
+// HasLocalControl - whether the vessel can be controlled locally.
 //
 // Allowed game scenes: any.
-func (s *Antenna) Target() (Target, error) {
+func (s *Comms) HasLocalControl() (bool, error) {
+	return s.HasLocalControlContext(context.Background())
+}
+
+// HasLocalControlContext - whether the vessel can be controlled locally.
+//
+// Allowed game scenes: any.
+func (s *Comms) HasLocalControlContext(ctx context.Context) (bool, error) {
 	var err error
 	var argBytes []byte
-	var vv Target
+	var vv bool
 	request := &types.ProcedureCall{
-		Procedure: "Antenna_get_Target",
+		Procedure: "Comms_get_HasLocalControl",
 		Service:   "RemoteTech",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -357,7 +1916,7 @@ func (s *Antenna) Target() (Target, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -368,20 +1927,14 @@ func (s *Antenna) Target() (Target, error) {
 	return vv, nil
 }
 
-// TargetStream - the object that the antenna is targetting. This property can
-// be used to set the target to <see cref="M:RemoteTech.TargetStream.None" /> or
-// <see cref="M:RemoteTech.TargetStream.ActiveVessel" />. To set the target to a
-// celestial body, ground station or vessel see <see
-// cref="M:RemoteTech.Antenna.TargetStreamBody" />, <see
-// cref="M:RemoteTech.Antenna.TargetStreamGroundStation" /> and <see
-// cref="M:RemoteTech.Antenna.TargetStreamVessel" />.
+// HasLocalControlAsync - whether the vessel can be controlled locally.
 //
 // Allowed game scenes: any.
-func (s *Antenna) TargetStream() (*krpcgo.Stream[Target], error) {
+func (s *Comms) HasLocalControlAsync() (*krpcgo.Future[bool], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Antenna_get_Target",
+		Procedure: "Comms_get_HasLocalControl",
 		Service:   "RemoteTech",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -392,135 +1945,98 @@ func (s *Antenna) TargetStream() (*krpcgo.Stream[Target], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) Target {
-		var value Target
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (bool, error) {
+		var vv bool
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetTarget - the object that the antenna is targetting. This property can be
-// used to set the target to <see cref="M:RemoteTech.Target.None" /> or <see
-// cref="M:RemoteTech.Target.ActiveVessel" />. To set the target to a celestial
-// body, ground station or vessel see <see
-// cref="M:RemoteTech.Antenna.TargetBody" />, <see
-// cref="M:RemoteTech.Antenna.TargetGroundStation" /> and <see
-// cref="M:RemoteTech.Antenna.TargetVessel" />.
+// HasLocalControlStream - whether the vessel can be controlled locally.
 //
 // Allowed game scenes: any.
-func (s *Antenna) SetTarget(value Target) error {
+func (s *Comms) HasLocalControlStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[bool], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Antenna_set_Target",
+		Procedure: "Comms_get_HasLocalControl",
 		Service:   "RemoteTech",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (bool, error) {
+		var value bool
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return stream, nil
 }
 
-// TargetBody - the celestial body the antenna is targetting.
-//
-// Allowed game scenes: any.
-func (s *Antenna) TargetBody() (*spacecenter.CelestialBody, error) {
+// HasLocalControlCall builds a call to HasLocalControl without making it, such
+// as to make several calls with CallMultiple, add a stream with AddStream or
+// use it in an expression.
+func (s *Comms) HasLocalControlCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv spacecenter.CelestialBody
 	request := &types.ProcedureCall{
-		Procedure: "Antenna_get_TargetBody",
+		Procedure: "Comms_get_HasLocalControl",
 		Service:   "RemoteTech",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
+	return request, nil
+}
+
+// DecodeHasLocalControl decodes the result of a call built with
+// HasLocalControlCall.
+func (s *Comms) DecodeHasLocalControl(result *types.ProcedureResult) (bool, error) {
+	var vv bool
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	vv.Client = s.Client
-	return &vv, nil
+	return vv, nil
 }
 
-// SetTargetBody - the celestial body the antenna is targetting.
+// HasFlightComputer - whether the vessel has a flight computer on board.
 //
 // Allowed game scenes: any.
-func (s *Antenna) SetTargetBody(value *spacecenter.CelestialBody) error {
-	var err error
-	var argBytes []byte
-	request := &types.ProcedureCall{
-		Procedure: "Antenna_set_TargetBody",
-		Service:   "RemoteTech",
-	}
-	argBytes, err = encode.Marshal(s)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x0),
-		Value:    argBytes,
-	})
-	argBytes, err = encode.Marshal(value)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
-	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+func (s *Comms) HasFlightComputer() (bool, error) {
+	return s.HasFlightComputerContext(context.Background())
 }
 
-// TargetGroundStation - the ground station the antenna is targetting.
+// HasFlightComputerContext - whether the vessel has a flight computer on board.
 //
 // Allowed game scenes: any.
-func (s *Antenna) TargetGroundStation() (string, error) {
+func (s *Comms) HasFlightComputerContext(ctx context.Context) (bool, error) {
 	var err error
 	var argBytes []byte
-	var vv string
+	var vv bool
 	request := &types.ProcedureCall{
-		Procedure: "Antenna_get_TargetGroundStation",
+		Procedure: "Comms_get_HasFlightComputer",
 		Service:   "RemoteTech",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -531,7 +2047,7 @@ func (s *Antenna) TargetGroundStation() (string, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -542,14 +2058,14 @@ func (s *Antenna) TargetGroundStation() (string, error) {
 	return vv, nil
 }
 
-// TargetGroundStationStream - the ground station the antenna is targetting.
+// HasFlightComputerAsync - whether the vessel has a flight computer on board.
 //
 // Allowed game scenes: any.
-func (s *Antenna) TargetGroundStationStream() (*krpcgo.Stream[string], error) {
+func (s *Comms) HasFlightComputerAsync() (*krpcgo.Future[bool], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Antenna_get_TargetGroundStation",
+		Procedure: "Comms_get_HasFlightComputer",
 		Service:   "RemoteTech",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -560,168 +2076,156 @@ func (s *Antenna) TargetGroundStationStream() (*krpcgo.Stream[string], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) string {
-		var value string
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (bool, error) {
+		var vv bool
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetTargetGroundStation - the ground station the antenna is targetting.
+// HasFlightComputerStream - whether the vessel has a flight computer on board.
 //
 // Allowed game scenes: any.
-func (s *Antenna) SetTargetGroundStation(value string) error {
+func (s *Comms) HasFlightComputerStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[bool], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Antenna_set_TargetGroundStation",
+		Procedure: "Comms_get_HasFlightComputer",
 		Service:   "RemoteTech",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (bool, error) {
+		var value bool
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return stream, nil
 }
 
-// TargetVessel - the vessel the antenna is targetting.
-//
-// Allowed game scenes: any.
-func (s *Antenna) TargetVessel() (*spacecenter.Vessel, error) {
+// HasFlightComputerCall builds a call to HasFlightComputer without making it,
+// such as to make several calls with CallMultiple, add a stream with AddStream
+// or use it in an expression.
+func (s *Comms) HasFlightComputerCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv spacecenter.Vessel
 	request := &types.ProcedureCall{
-		Procedure: "Antenna_get_TargetVessel",
+		Procedure: "Comms_get_HasFlightComputer",
 		Service:   "RemoteTech",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
+	return request, nil
+}
+
+// DecodeHasFlightComputer decodes the result of a call built with
+// HasFlightComputerCall.
+func (s *Comms) DecodeHasFlightComputer(result *types.ProcedureResult) (bool, error) {
+	var vv bool
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	vv.Client = s.Client
-	return &vv, nil
+	return vv, nil
 }
 
-// SetTargetVessel - the vessel the antenna is targetting.
+// HasConnection - whether the vessel has any connection.
 //
 // Allowed game scenes: any.
-func (s *Antenna) SetTargetVessel(value *spacecenter.Vessel) error {
+func (s *Comms) HasConnection() (bool, error) {
+	return s.HasConnectionContext(context.Background())
+}
+
+// HasConnectionContext - whether the vessel has any connection.
+//
+// Allowed game scenes: any.
+func (s *Comms) HasConnectionContext(ctx context.Context) (bool, error) {
 	var err error
 	var argBytes []byte
+	var vv bool
 	request := &types.ProcedureCall{
-		Procedure: "Antenna_set_TargetVessel",
+		Procedure: "Comms_get_HasConnection",
 		Service:   "RemoteTech",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
-	})
-	_, err = s.Client.Call(request)
+	err = encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	return nil
+	return vv, nil
 }
 
-// SignalDelayToVessel - the signal delay between the this vessel and another
-// vessel, in seconds.
+// HasConnectionAsync - whether the vessel has any connection.
 //
 // Allowed game scenes: any.
-func (s *Comms) SignalDelayToVessel(other *spacecenter.Vessel) (float64, error) {
+func (s *Comms) HasConnectionAsync() (*krpcgo.Future[bool], error) {
 	var err error
 	var argBytes []byte
-	var vv float64
 	request := &types.ProcedureCall{
-		Procedure: "Comms_SignalDelayToVessel",
+		Procedure: "Comms_get_HasConnection",
 		Service:   "RemoteTech",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(other)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (bool, error) {
+		var vv bool
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	return vv, nil
+	return future, nil
 }
 
-// SignalDelayToVesselStream - the signal delay between the this vessel and
-// another vessel, in seconds.
+// HasConnectionStream - whether the vessel has any connection.
 //
 // Allowed game scenes: any.
-func (s *Comms) SignalDelayToVesselStream(other *spacecenter.Vessel) (*krpcgo.Stream[float64], error) {
+func (s *Comms) HasConnectionStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[bool], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Comms_SignalDelayToVessel",
+		Procedure: "Comms_get_HasConnection",
 		Service:   "RemoteTech",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -732,71 +2236,71 @@ func (s *Comms) SignalDelayToVesselStream(other *spacecenter.Vessel) (*krpcgo.St
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(other)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
-	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) float64 {
-		var value float64
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (bool, error) {
+		var value bool
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
 	return stream, nil
 }
 
-// Vessel - get the vessel.
-//
-// Allowed game scenes: any.
-func (s *Comms) Vessel() (*spacecenter.Vessel, error) {
+// HasConnectionCall builds a call to HasConnection without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *Comms) HasConnectionCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv spacecenter.Vessel
 	request := &types.ProcedureCall{
-		Procedure: "Comms_get_Vessel",
+		Procedure: "Comms_get_HasConnection",
 		Service:   "RemoteTech",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
+	return request, nil
+}
+
+// DecodeHasConnection decodes the result of a call built with
+// HasConnectionCall.
+func (s *Comms) DecodeHasConnection(result *types.ProcedureResult) (bool, error) {
+	var vv bool
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	vv.Client = s.Client
-	return &vv, nil
+	return vv, nil
 }
 
-// HasLocalControl - whether the vessel can be controlled locally.
+// HasConnectionToGroundStation - whether the vessel has a connection to a
+// ground station.
 //
 // Allowed game scenes: any.
-func (s *Comms) HasLocalControl() (bool, error) {
+func (s *Comms) HasConnectionToGroundStation() (bool, error) {
+	return s.HasConnectionToGroundStationContext(context.Background())
+}
+
+// HasConnectionToGroundStationContext - whether the vessel has a connection to
+// a ground station.
+//
+// Allowed game scenes: any.
+func (s *Comms) HasConnectionToGroundStationContext(ctx context.Context) (bool, error) {
 	var err error
 	var argBytes []byte
 	var vv bool
 	request := &types.ProcedureCall{
-		Procedure: "Comms_get_HasLocalControl",
+		Procedure: "Comms_get_HasConnectionToGroundStation",
 		Service:   "RemoteTech",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -807,7 +2311,7 @@ func (s *Comms) HasLocalControl() (bool, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -818,14 +2322,15 @@ func (s *Comms) HasLocalControl() (bool, error) {
 	return vv, nil
 }
 
-// HasLocalControlStream - whether the vessel can be controlled locally.
+// HasConnectionToGroundStationAsync - whether the vessel has a connection to a
+// ground station.
 //
 // Allowed game scenes: any.
-func (s *Comms) HasLocalControlStream() (*krpcgo.Stream[bool], error) {
+func (s *Comms) HasConnectionToGroundStationAsync() (*krpcgo.Future[bool], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Comms_get_HasLocalControl",
+		Procedure: "Comms_get_HasConnectionToGroundStation",
 		Service:   "RemoteTech",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -836,61 +2341,56 @@ func (s *Comms) HasLocalControlStream() (*krpcgo.Stream[bool], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) bool {
-		var value bool
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (bool, error) {
+		var vv bool
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// HasFlightComputer - whether the vessel has a flight computer on board.
+// HasConnectionToGroundStationStream - whether the vessel has a connection to a
+// ground station.
 //
 // Allowed game scenes: any.
-func (s *Comms) HasFlightComputer() (bool, error) {
+func (s *Comms) HasConnectionToGroundStationStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[bool], error) {
 	var err error
 	var argBytes []byte
-	var vv bool
 	request := &types.ProcedureCall{
-		Procedure: "Comms_get_HasFlightComputer",
+		Procedure: "Comms_get_HasConnectionToGroundStation",
 		Service:   "RemoteTech",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	return vv, nil
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (bool, error) {
+		var value bool
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
 }
 
-// HasFlightComputerStream - whether the vessel has a flight computer on board.
-//
-// Allowed game scenes: any.
-func (s *Comms) HasFlightComputerStream() (*krpcgo.Stream[bool], error) {
+// HasConnectionToGroundStationCall builds a call to
+// HasConnectionToGroundStation without making it, such as to make several calls
+// with CallMultiple, add a stream with AddStream or use it in an expression.
+func (s *Comms) HasConnectionToGroundStationCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Comms_get_HasFlightComputer",
+		Procedure: "Comms_get_HasConnectionToGroundStation",
 		Service:   "RemoteTech",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -901,32 +2401,39 @@ func (s *Comms) HasFlightComputerStream() (*krpcgo.Stream[bool], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	return request, nil
+}
+
+// DecodeHasConnectionToGroundStation decodes the result of a call built with
+// HasConnectionToGroundStationCall.
+func (s *Comms) DecodeHasConnectionToGroundStation(result *types.ProcedureResult) (bool, error) {
+	var vv bool
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return nil, tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) bool {
-		var value bool
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
-	})
-	return stream, nil
+	return vv, nil
 }
 
-// HasConnection - whether the vessel has any connection.
+// SignalDelay - the shortest signal delay to the vessel, in seconds.
 //
 // Allowed game scenes: any.
-func (s *Comms) HasConnection() (bool, error) {
+func (s *Comms) SignalDelay() (float64, error) {
+	return s.SignalDelayContext(context.Background())
+}
+
+// SignalDelayContext - the shortest signal delay to the vessel, in seconds.
+//
+// Allowed game scenes: any.
+func (s *Comms) SignalDelayContext(ctx context.Context) (float64, error) {
 	var err error
 	var argBytes []byte
-	var vv bool
+	var vv float64
 	request := &types.ProcedureCall{
-		Procedure: "Comms_get_HasConnection",
+		Procedure: "Comms_get_SignalDelay",
 		Service:   "RemoteTech",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -937,7 +2444,7 @@ func (s *Comms) HasConnection() (bool, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -948,14 +2455,14 @@ func (s *Comms) HasConnection() (bool, error) {
 	return vv, nil
 }
 
-// HasConnectionStream - whether the vessel has any connection.
+// SignalDelayAsync - the shortest signal delay to the vessel, in seconds.
 //
 // Allowed game scenes: any.
-func (s *Comms) HasConnectionStream() (*krpcgo.Stream[bool], error) {
+func (s *Comms) SignalDelayAsync() (*krpcgo.Future[float64], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Comms_get_HasConnection",
+		Procedure: "Comms_get_SignalDelay",
 		Service:   "RemoteTech",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -966,63 +2473,55 @@ func (s *Comms) HasConnectionStream() (*krpcgo.Stream[bool], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) bool {
-		var value bool
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (float64, error) {
+		var vv float64
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// HasConnectionToGroundStation - whether the vessel has a connection to a
-// ground station.
+// SignalDelayStream - the shortest signal delay to the vessel, in seconds.
 //
 // Allowed game scenes: any.
-func (s *Comms) HasConnectionToGroundStation() (bool, error) {
+func (s *Comms) SignalDelayStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[float64], error) {
 	var err error
 	var argBytes []byte
-	var vv bool
 	request := &types.ProcedureCall{
-		Procedure: "Comms_get_HasConnectionToGroundStation",
+		Procedure: "Comms_get_SignalDelay",
 		Service:   "RemoteTech",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	return vv, nil
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (float64, error) {
+		var value float64
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
 }
 
-// HasConnectionToGroundStationStream - whether the vessel has a connection to a
-// ground station.
-//
-// Allowed game scenes: any.
-func (s *Comms) HasConnectionToGroundStationStream() (*krpcgo.Stream[bool], error) {
+// SignalDelayCall builds a call to SignalDelay without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *Comms) SignalDelayCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Comms_get_HasConnectionToGroundStation",
+		Procedure: "Comms_get_SignalDelay",
 		Service:   "RemoteTech",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1033,32 +2532,40 @@ func (s *Comms) HasConnectionToGroundStationStream() (*krpcgo.Stream[bool], erro
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	return request, nil
+}
+
+// DecodeSignalDelay decodes the result of a call built with SignalDelayCall.
+func (s *Comms) DecodeSignalDelay(result *types.ProcedureResult) (float64, error) {
+	var vv float64
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return nil, tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) bool {
-		var value bool
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
-	})
-	return stream, nil
+	return vv, nil
 }
 
-// SignalDelay - the shortest signal delay to the vessel, in seconds.
+// SignalDelayToGroundStation - the signal delay between the vessel and the
+// closest ground station, in seconds.
 //
 // Allowed game scenes: any.
-func (s *Comms) SignalDelay() (float64, error) {
+func (s *Comms) SignalDelayToGroundStation() (float64, error) {
+	return s.SignalDelayToGroundStationContext(context.Background())
+}
+
+// SignalDelayToGroundStationContext - the signal delay between the vessel and
+// the closest ground station, in seconds.
+//
+// Allowed game scenes: any.
+func (s *Comms) SignalDelayToGroundStationContext(ctx context.Context) (float64, error) {
 	var err error
 	var argBytes []byte
 	var vv float64
 	request := &types.ProcedureCall{
-		Procedure: "Comms_get_SignalDelay",
+		Procedure: "Comms_get_SignalDelayToGroundStation",
 		Service:   "RemoteTech",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1069,7 +2576,7 @@ func (s *Comms) SignalDelay() (float64, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -1080,14 +2587,15 @@ func (s *Comms) SignalDelay() (float64, error) {
 	return vv, nil
 }
 
-// SignalDelayStream - the shortest signal delay to the vessel, in seconds.
+// SignalDelayToGroundStationAsync - the signal delay between the vessel and the
+// closest ground station, in seconds.
 //
 // Allowed game scenes: any.
-func (s *Comms) SignalDelayStream() (*krpcgo.Stream[float64], error) {
+func (s *Comms) SignalDelayToGroundStationAsync() (*krpcgo.Future[float64], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Comms_get_SignalDelay",
+		Procedure: "Comms_get_SignalDelayToGroundStation",
 		Service:   "RemoteTech",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1098,59 +2606,52 @@ func (s *Comms) SignalDelayStream() (*krpcgo.Stream[float64], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) float64 {
-		var value float64
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (float64, error) {
+		var vv float64
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SignalDelayToGroundStation - the signal delay between the vessel and the
-// closest ground station, in seconds.
+// SignalDelayToGroundStationStream - the signal delay between the vessel and
+// the closest ground station, in seconds.
 //
 // Allowed game scenes: any.
-func (s *Comms) SignalDelayToGroundStation() (float64, error) {
+func (s *Comms) SignalDelayToGroundStationStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[float64], error) {
 	var err error
 	var argBytes []byte
-	var vv float64
 	request := &types.ProcedureCall{
 		Procedure: "Comms_get_SignalDelayToGroundStation",
 		Service:   "RemoteTech",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	return vv, nil
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (float64, error) {
+		var value float64
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
 }
 
-// SignalDelayToGroundStationStream - the signal delay between the vessel and
-// the closest ground station, in seconds.
-//
-// Allowed game scenes: any.
-func (s *Comms) SignalDelayToGroundStationStream() (*krpcgo.Stream[float64], error) {
+// SignalDelayToGroundStationCall builds a call to SignalDelayToGroundStation
+// without making it, such as to make several calls with CallMultiple, add a
+// stream with AddStream or use it in an expression.
+func (s *Comms) SignalDelayToGroundStationCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
@@ -1165,27 +2666,34 @@ func (s *Comms) SignalDelayToGroundStationStream() (*krpcgo.Stream[float64], err
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	return request, nil
+}
+
+// DecodeSignalDelayToGroundStation decodes the result of a call built with
+// SignalDelayToGroundStationCall.
+func (s *Comms) DecodeSignalDelayToGroundStation(result *types.ProcedureResult) (float64, error) {
+	var vv float64
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return nil, tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) float64 {
-		var value float64
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
-	})
-	return stream, nil
+	return vv, nil
 }
 
 // Antennas - the antennas for this vessel.
 //
 // Allowed game scenes: any.
 func (s *Comms) Antennas() ([]*Antenna, error) {
+	return s.AntennasContext(context.Background())
+}
+
+// AntennasContext - the antennas for this vessel.
+//
+// Allowed game scenes: any.
+func (s *Comms) AntennasContext(ctx context.Context) ([]*Antenna, error) {
 	var err error
 	var argBytes []byte
 	var vv []*Antenna
@@ -1201,7 +2709,7 @@ func (s *Comms) Antennas() ([]*Antenna, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -1212,10 +2720,39 @@ func (s *Comms) Antennas() ([]*Antenna, error) {
 	return vv, nil
 }
 
+// AntennasAsync - the antennas for this vessel.
+//
+// Allowed game scenes: any.
+func (s *Comms) AntennasAsync() (*krpcgo.Future[[]*Antenna], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Comms_get_Antennas",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) ([]*Antenna, error) {
+		var vv []*Antenna
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
 // AntennasStream - the antennas for this vessel.
 //
 // Allowed game scenes: any.
-func (s *Comms) AntennasStream() (*krpcgo.Stream[[]*Antenna], error) {
+func (s *Comms) AntennasStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[[]*Antenna], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
@@ -1230,19 +2767,109 @@ func (s *Comms) AntennasStream() (*krpcgo.Stream[[]*Antenna], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) []*Antenna {
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) ([]*Antenna, error) {
 		var value []*Antenna
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
 	return stream, nil
 }
+
+// AntennasCall builds a call to Antennas without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Comms) AntennasCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Comms_get_Antennas",
+		Service:   "RemoteTech",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeAntennas decodes the result of a call built with AntennasCall.
+func (s *Comms) DecodeAntennas(result *types.ProcedureResult) ([]*Antenna, error) {
+	var vv []*Antenna
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+func init() {
+	krpcgo.RegisterReturnTypes("RemoteTech", map[string]*types.Type{
+		"Antenna": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Antenna",
+			Service: "RemoteTech",
+		},
+		"Antenna_get_HasConnection": &types.Type{Code: types.Type_BOOL},
+		"Antenna_get_Part": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Part",
+			Service: "SpaceCenter",
+		},
+		"Antenna_get_Target": &types.Type{
+			Code:    types.Type_ENUMERATION,
+			Name:    "Target",
+			Service: "RemoteTech",
+		},
+		"Antenna_get_TargetBody": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "CelestialBody",
+			Service: "SpaceCenter",
+		},
+		"Antenna_get_TargetGroundStation": &types.Type{Code: types.Type_STRING},
+		"Antenna_get_TargetVessel": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Vessel",
+			Service: "SpaceCenter",
+		},
+		"Comms": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Comms",
+			Service: "RemoteTech",
+		},
+		"Comms_SignalDelayToVessel": &types.Type{Code: types.Type_DOUBLE},
+		"Comms_get_Antennas": &types.Type{
+			Code: types.Type_LIST,
+			Types: []*types.Type{&types.Type{
+				Code:    types.Type_CLASS,
+				Name:    "Antenna",
+				Service: "RemoteTech",
+			}},
+		},
+		"Comms_get_HasConnection":                &types.Type{Code: types.Type_BOOL},
+		"Comms_get_HasConnectionToGroundStation": &types.Type{Code: types.Type_BOOL},
+		"Comms_get_HasFlightComputer":            &types.Type{Code: types.Type_BOOL},
+		"Comms_get_HasLocalControl":              &types.Type{Code: types.Type_BOOL},
+		"Comms_get_SignalDelay":                  &types.Type{Code: types.Type_DOUBLE},
+		"Comms_get_SignalDelayToGroundStation":   &types.Type{Code: types.Type_DOUBLE},
+		"Comms_get_Vessel": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Vessel",
+			Service: "SpaceCenter",
+		},
+		"get_Available": &types.Type{Code: types.Type_BOOL},
+		"get_GroundStations": &types.Type{
+			Code:  types.Type_LIST,
+			Types: []*types.Type{&types.Type{Code: types.Type_STRING}},
+		},
+	})
+}
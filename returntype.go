@@ -0,0 +1,42 @@
+package krpcgo
+
+import (
+	"sync"
+
+	"github.com/atburke/krpc-go/types"
+)
+
+var (
+	returnTypesMu sync.RWMutex
+	returnTypes   = make(map[string]map[string]*types.Type)
+)
+
+// RegisterReturnType registers the type of the value a procedure returns, so
+// that calls built for it can be used where their type matters, such as in
+// expressions.
+func RegisterReturnType(service, procedure string, t *types.Type) {
+	RegisterReturnTypes(service, map[string]*types.Type{procedure: t})
+}
+
+// RegisterReturnTypes registers the return types of several procedures of a
+// service, keyed by procedure name. Generated service packages register the
+// return types of their procedures when they are imported and built with call
+// builders.
+func RegisterReturnTypes(service string, procedureTypes map[string]*types.Type) {
+	returnTypesMu.Lock()
+	defer returnTypesMu.Unlock()
+	if returnTypes[service] == nil {
+		returnTypes[service] = make(map[string]*types.Type)
+	}
+	for procedure, t := range procedureTypes {
+		returnTypes[service][procedure] = t
+	}
+}
+
+// ReturnType gets the registered return type of the procedure a call is
+// made to, or nil if it isn't known.
+func ReturnType(call *types.ProcedureCall) *types.Type {
+	returnTypesMu.RLock()
+	defer returnTypesMu.RUnlock()
+	return returnTypes[call.Service][call.Procedure]
+}
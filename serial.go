@@ -0,0 +1,274 @@
+package krpcgo
+
+import (
+	"io"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/atburke/krpc-go/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/ztrue/tracerr"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Field numbers of the messages in MultiplexedRequest and MultiplexedResponse.
+const (
+	multiplexedRequest      = 2
+	multiplexedResponse     = 1
+	multiplexedStreamUpdate = 2
+)
+
+// serialUpdateBuffer is the number of stream updates kept until they're
+// received. Once it's full, the oldest updates are dropped, so that responses
+// never wait for stream updates to be received.
+const serialUpdateBuffer = 16
+
+// connectSerial opens the serial port, performs the connection handshake and
+// checks the server's version.
+func (c *KRPCClient) connectSerial() error {
+	port, err := openSerial(c.SerialPort)
+	if err != nil {
+		return tracerr.Wrap(&DialError{Addr: c.SerialPort, Err: err})
+	}
+	out, err := proto.Marshal(&types.MultiplexedRequest{
+		ConnectionRequest: &types.ConnectionRequest{
+			Type:       types.ConnectionRequest_RPC,
+			ClientName: c.ClientName,
+		},
+	})
+	if err != nil {
+		port.Close()
+		return tracerr.Wrap(err)
+	}
+	var resp *types.ConnectionResponse
+	err = send(port, out)
+	if err == nil {
+		var in []byte
+		in, err = receive(port)
+		if err == nil {
+			resp, err = connectionResponse(types.ConnectionRequest_RPC, in)
+		}
+	}
+	if err != nil {
+		port.Close()
+		return tracerr.Wrap(err)
+	}
+	copy(c.clientIdentifier[:], resp.ClientIdentifier)
+
+	c.serial = newSerialConn(port)
+	c.conn = c.serial.rpcConn()
+	if !c.SkipVersionCheck {
+		if err := c.checkVersion(c.ctx); err != nil {
+			c.serial.Close()
+			return tracerr.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// openSerial opens a serial port and puts it in raw mode.
+func openSerial(path string) (*os.File, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|serialOpenFlags, 0)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	if err := makeRaw(f); err != nil {
+		f.Close()
+		return nil, tracerr.Wrap(err)
+	}
+	return f, nil
+}
+
+// serialConn multiplexes the RPC and stream connections over a single byte
+// stream. Requests are sent wrapped in MultiplexedRequests, and the
+// MultiplexedResponses received are split into responses and stream updates.
+type serialConn struct {
+	rw io.ReadWriteCloser
+	// wmu is held while writing a message.
+	wmu       sync.Mutex
+	responses chan []byte
+	updates   chan []byte
+	// done is closed once reading fails, after which err is set.
+	done chan struct{}
+	err  error
+	// closed is closed when the serial port is closed.
+	closed    chan struct{}
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// newSerialConn starts reading messages from rw.
+func newSerialConn(rw io.ReadWriteCloser) *serialConn {
+	s := &serialConn{
+		rw:        rw,
+		responses: make(chan []byte),
+		updates:   make(chan []byte, serialUpdateBuffer),
+		done:      make(chan struct{}),
+		closed:    make(chan struct{}),
+	}
+	go s.read()
+	return s
+}
+
+// read receives MultiplexedResponses and hands out their contents until
+// reading fails.
+func (s *serialConn) read() {
+	defer close(s.done)
+	for {
+		in, err := receive(s.rw)
+		if err != nil {
+			s.err = tracerr.Wrap(err)
+			return
+		}
+		for len(in) > 0 {
+			num, typ, n := protowire.ConsumeTag(in)
+			if n < 0 {
+				s.err = tracerr.Wrap(protowire.ParseError(n))
+				return
+			}
+			in = in[n:]
+			n = protowire.ConsumeFieldValue(num, typ, in)
+			if n < 0 {
+				s.err = tracerr.Wrap(protowire.ParseError(n))
+				return
+			}
+			if typ == protowire.BytesType {
+				data, _ := protowire.ConsumeBytes(in)
+				switch num {
+				case multiplexedResponse:
+					select {
+					case s.responses <- data:
+					case <-s.closed:
+						s.err = tracerr.Wrap(net.ErrClosed)
+						return
+					}
+				case multiplexedStreamUpdate:
+					s.queueUpdate(data)
+				}
+			}
+			in = in[n:]
+		}
+	}
+}
+
+// queueUpdate queues a stream update without waiting for it to be received,
+// dropping the oldest queued update if the queue is full.
+func (s *serialConn) queueUpdate(data []byte) {
+	for {
+		select {
+		case s.updates <- data:
+			return
+		default:
+		}
+		select {
+		case <-s.updates:
+		default:
+		}
+	}
+}
+
+// writeMessage sends a message as the given field of a MultiplexedRequest.
+func (s *serialConn) writeMessage(num protowire.Number, data []byte) error {
+	out := protowire.AppendTag(nil, num, protowire.BytesType)
+	out = protowire.AppendBytes(out, data)
+	s.wmu.Lock()
+	defer s.wmu.Unlock()
+	return tracerr.Wrap(send(s.rw, out))
+}
+
+// Close closes the serial port.
+func (s *serialConn) Close() error {
+	s.closeOnce.Do(func() {
+		close(s.closed)
+		s.closeErr = s.rw.Close()
+	})
+	return tracerr.Wrap(s.closeErr)
+}
+
+// rpcConn gets a connection for sending requests and receiving responses.
+func (s *serialConn) rpcConn() messageConn {
+	return &serialChannel{serialConn: s, messages: s.responses, num: multiplexedRequest}
+}
+
+// streamConn gets a connection for receiving stream updates.
+func (s *serialConn) streamConn() messageConn {
+	return &serialChannel{serialConn: s, messages: s.updates}
+}
+
+// serialChannel is one of the connections multiplexed over a serialConn.
+// Closing it closes the serial port.
+type serialChannel struct {
+	*serialConn
+	messages chan []byte
+	// num is the MultiplexedRequest field that messages are sent as, or zero
+	// if messages can't be sent.
+	num protowire.Number
+
+	mu       sync.Mutex
+	deadline time.Time
+	// deadlineChanged is closed when the deadline changes.
+	deadlineChanged chan struct{}
+}
+
+// WriteMessage sends a message.
+func (c *serialChannel) WriteMessage(data []byte) error {
+	if c.num == 0 {
+		return tracerr.Errorf("Can't send messages over the stream connection")
+	}
+	return tracerr.Wrap(c.writeMessage(c.num, data))
+}
+
+// ReadMessage receives a message.
+func (c *serialChannel) ReadMessage() ([]byte, error) {
+	for {
+		data, ok, err := c.readUntil(c.getDeadline())
+		if ok {
+			return data, tracerr.Wrap(err)
+		}
+	}
+}
+
+// readUntil receives a message, unless the deadline changes first.
+func (c *serialChannel) readUntil(deadline time.Time, changed <-chan struct{}) ([]byte, bool, error) {
+	var timeout <-chan time.Time
+	if !deadline.IsZero() {
+		timer := time.NewTimer(time.Until(deadline))
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	select {
+	case data := <-c.messages:
+		return data, true, nil
+	case <-c.done:
+		return nil, true, c.err
+	case <-timeout:
+		return nil, true, os.ErrDeadlineExceeded
+	case <-changed:
+		return nil, false, nil
+	}
+}
+
+// SetDeadline sets the deadline for reading messages. Writes aren't
+// affected.
+func (c *serialChannel) SetDeadline(t time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.deadline = t
+	if c.deadlineChanged != nil {
+		close(c.deadlineChanged)
+		c.deadlineChanged = nil
+	}
+	return nil
+}
+
+// getDeadline gets the deadline and a channel that's closed when it changes.
+func (c *serialChannel) getDeadline() (time.Time, <-chan struct{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.deadlineChanged == nil {
+		c.deadlineChanged = make(chan struct{})
+	}
+	return c.deadline, c.deadlineChanged
+}
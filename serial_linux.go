@@ -0,0 +1,50 @@
+package krpcgo
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+
+	"github.com/ztrue/tracerr"
+)
+
+// serialOpenFlags are the extra flags serial ports are opened with.
+const serialOpenFlags = syscall.O_NOCTTY
+
+// makeRaw puts a terminal device in raw mode, so that the bytes sent and
+// received aren't altered. Like cfmakeraw(3).
+func makeRaw(f *os.File) error {
+	conn, err := f.SyscallConn()
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	var errno syscall.Errno
+	err = conn.Control(func(fd uintptr) {
+		var t syscall.Termios
+		if errno = ioctl(fd, syscall.TCGETS, unsafe.Pointer(&t)); errno != 0 {
+			return
+		}
+		t.Iflag &^= syscall.IGNBRK | syscall.BRKINT | syscall.PARMRK | syscall.ISTRIP |
+			syscall.INLCR | syscall.IGNCR | syscall.ICRNL | syscall.IXON
+		t.Oflag &^= syscall.OPOST
+		t.Lflag &^= syscall.ECHO | syscall.ECHONL | syscall.ICANON | syscall.ISIG | syscall.IEXTEN
+		t.Cflag &^= syscall.CSIZE | syscall.PARENB
+		t.Cflag |= syscall.CS8
+		t.Cc[syscall.VMIN] = 1
+		t.Cc[syscall.VTIME] = 0
+		errno = ioctl(fd, syscall.TCSETS, unsafe.Pointer(&t))
+	})
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	if errno != 0 {
+		return tracerr.Wrap(errno)
+	}
+	return nil
+}
+
+// ioctl performs an ioctl system call.
+func ioctl(fd, req uintptr, arg unsafe.Pointer) syscall.Errno {
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, fd, req, uintptr(arg))
+	return errno
+}
@@ -0,0 +1,99 @@
+package krpcgo
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/atburke/krpc-go/krpctest"
+	"github.com/atburke/krpc-go/types"
+	"github.com/stretchr/testify/require"
+)
+
+// openPTY opens a pty pair. It returns the master end and the path of the
+// slave end.
+func openPTY(t *testing.T) (*os.File, string) {
+	master, err := os.OpenFile("/dev/ptmx", os.O_RDWR|syscall.O_NOCTTY, 0)
+	if err != nil {
+		t.Skipf("Can't open pty: %v", err)
+	}
+	t.Cleanup(func() { master.Close() })
+	conn, err := master.SyscallConn()
+	require.NoError(t, err)
+	var n uint32
+	var errno syscall.Errno
+	require.NoError(t, conn.Control(func(fd uintptr) {
+		var unlock int32
+		if errno = ioctl(fd, syscall.TIOCSPTLCK, unsafe.Pointer(&unlock)); errno == 0 {
+			errno = ioctl(fd, syscall.TIOCGPTN, unsafe.Pointer(&n))
+		}
+	}))
+	if errno != 0 {
+		t.Skipf("Can't set up pty: %v", errno)
+	}
+	return master, fmt.Sprintf("/dev/pts/%d", n)
+}
+
+func TestSerialIO(t *testing.T) {
+	server := krpctest.NewServer(t)
+	server.HandleDefault(func(call *types.ProcedureCall) ([]byte, error) {
+		return []byte(call.Procedure), nil
+	})
+	master, path := openPTY(t)
+	server.ServeSerial(master)
+	client := connect(t, server, KRPCClientConfig{Protocol: ProtocolSerialIO, SerialPort: path})
+
+	result, err := client.Call(&types.ProcedureCall{Service: "Test", Procedure: "Echo"})
+	require.NoError(t, err)
+	require.Equal(t, "Echo", string(result.Value))
+
+	// Buffer the update, so that it's kept until it's received.
+	stream, err := client.AddStream(&types.ProcedureCall{Service: "Test", Procedure: "Value"}, WithBuffer(1))
+	require.NoError(t, err)
+	defer stream.Close()
+	require.NoError(t, server.Push(stream.ID, []byte("value")))
+	select {
+	case data := <-stream.C:
+		require.Equal(t, "value", string(data))
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for stream value")
+	}
+
+	// Responses and stream updates are interleaved on the same connection.
+	result, err = client.Call(&types.ProcedureCall{Service: "Test", Procedure: "Echo"})
+	require.NoError(t, err)
+	require.Equal(t, "Echo", string(result.Value))
+}
+
+func TestSerialIOUnreadUpdates(t *testing.T) {
+	server := krpctest.NewServer(t)
+	server.HandleDefault(func(call *types.ProcedureCall) ([]byte, error) {
+		return []byte(call.Procedure), nil
+	})
+	master, path := openPTY(t)
+	server.ServeSerial(master)
+	client := connect(t, server, KRPCClientConfig{Protocol: ProtocolSerialIO, SerialPort: path, RPCOnly: true})
+
+	// Nothing receives the stream updates, which mustn't hold up responses.
+	for i := 0; i < 2*serialUpdateBuffer; i++ {
+		require.NoError(t, server.Push(1, []byte("value")))
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	result, err := client.CallContext(ctx, &types.ProcedureCall{Service: "Test", Procedure: "Echo"})
+	require.NoError(t, err)
+	require.Equal(t, "Echo", string(result.Value))
+}
+
+func TestSerialIORejected(t *testing.T) {
+	server := krpctest.NewServer(t)
+	server.SetHandshakeStatus(types.ConnectionRequest_RPC, types.ConnectionResponse_TIMEOUT)
+	master, path := openPTY(t)
+	server.ServeSerial(master)
+	client := NewKRPCClient(KRPCClientConfig{Protocol: ProtocolSerialIO, SerialPort: path})
+	require.ErrorIs(t, client.Connect(context.Background()), ErrHandshakeTimeout)
+}
@@ -0,0 +1,13 @@
+//go:build !linux
+
+package krpcgo
+
+import "os"
+
+// serialOpenFlags are the extra flags serial ports are opened with.
+const serialOpenFlags = 0
+
+// makeRaw does nothing; the serial port must already be configured.
+func makeRaw(f *os.File) error {
+	return nil
+}
@@ -3,11 +3,12 @@ package krpcgo
 import (
 	"context"
 	"errors"
-	"fmt"
 	"io"
 	"net"
 	"os"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/atburke/krpc-go/lib/utils"
 	"github.com/atburke/krpc-go/types"
@@ -15,65 +16,194 @@ import (
 	"github.com/ztrue/tracerr"
 )
 
+var (
+	// ErrClosed is returned by Stream.Err when the stream ended because the
+	// client was closed.
+	ErrClosed = errors.New("client closed")
+	// ErrStreamClosed is returned by Stream.Get when the stream was closed.
+	ErrStreamClosed = errors.New("stream closed")
+	// ErrStreamLost is returned by Stream.Err when the stream ended because
+	// it couldn't be added to the server again after reconnecting, such as
+	// the stream of an event.
+	ErrStreamLost = errors.New("stream lost after reconnecting")
+)
+
 // StreamClient is a client for kRPC streams.
 type StreamClient struct {
 	sync.RWMutex
-	conn    net.Conn
+	conn    messageConn
 	streams map[uint64]*streamManager
+	// err is the error that ended the stream client, once Run returns.
+	err error
+	// connectionLost, if set, is called when the connection fails. If it
+	// returns nil, the stream client continues with its new connection.
+	connectionLost func(messageConn) error
+	// recorder, if set, records every stream update.
+	recorder Recorder
+	// refMu is held while streams are added to or removed from the server,
+	// so that a stream isn't removed just as it's added again. It's taken
+	// before the client's lock.
+	refMu sync.Mutex
+	// seq is the sequence number of the last stream update received.
+	seq uint64
+	// observers are called after each stream update with its sequence
+	// number and the streams it updated.
+	observers     map[int]func(seq uint64, updated map[*streamManager]bool)
+	newObserverID func() int
 }
 
 // NewStreamClient creates a new stream client with an existing connection.
 func NewStreamClient(conn net.Conn) *StreamClient {
+	return newStreamClient(&framedConn{conn})
+}
+
+func newStreamClient(conn messageConn) *StreamClient {
 	return &StreamClient{
-		conn:    conn,
-		streams: make(map[uint64]*streamManager),
+		conn:          conn,
+		streams:       make(map[uint64]*streamManager),
+		observers:     make(map[int]func(uint64, map[*streamManager]bool)),
+		newObserverID: utils.NewIDGenerator(),
 	}
 }
 
 // Close closes the stream client.
 func (s *StreamClient) Close() error {
-	return tracerr.Wrap(s.conn.Close())
+	return tracerr.Wrap(s.getConn().Close())
+}
+
+// getConn gets the current connection.
+func (s *StreamClient) getConn() messageConn {
+	s.RLock()
+	defer s.RUnlock()
+	return s.conn
+}
+
+// setConn replaces the connection, e.g. after reconnecting.
+func (s *StreamClient) setConn(conn messageConn) {
+	s.Lock()
+	defer s.Unlock()
+	s.conn = conn
 }
 
 // Send sends protobuf-encoded data to a stream server.
 func (s *StreamClient) Send(data []byte) error {
-	return tracerr.Wrap(send(s.conn, data))
+	return tracerr.Wrap(s.getConn().WriteMessage(data))
 }
 
 // Receive receives protobuf-encoded data from a stream server.
 func (s *StreamClient) Receive() ([]byte, error) {
-	data, err := receive(s.conn)
+	data, err := s.getConn().ReadMessage()
 	return data, tracerr.Wrap(err)
 }
 
-// Run starts the stream handler.
+// Run starts the stream handler. It receives stream updates until the
+// connection ends or ctx is done, and then ends every stream with the error
+// that stopped it.
 func (s *StreamClient) Run(ctx context.Context) {
+	err := s.receiveUpdates(ctx)
+	if isClosedError(err) {
+		err = ErrClosed
+	}
+	s.Lock()
+	s.err = err
+	sms := make([]*streamManager, 0, len(s.streams))
+	for _, sm := range s.streams {
+		sms = append(sms, sm)
+	}
+	s.Unlock()
+	for _, sm := range sms {
+		sm.end(err)
+	}
+}
+
+// receiveUpdates passes stream updates on to the streams until the
+// connection fails, an update can't be decoded, or ctx is done.
+func (s *StreamClient) receiveUpdates(ctx context.Context) error {
 	for {
-		data, err := s.Receive()
-		if errors.Is(err, io.EOF) {
-			return
+		conn := s.getConn()
+		data, err := conn.ReadMessage()
+		if err != nil && s.connectionLost != nil && ctx.Err() == nil {
+			if err := s.connectionLost(conn); err != nil {
+				return tracerr.Wrap(err)
+			}
+			continue
 		}
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error reading stream: %v\n", err)
+			return tracerr.Wrap(err)
 		}
 
 		var streamUpdate types.StreamUpdate
 		if err := proto.Unmarshal(data, &streamUpdate); err != nil {
-			fmt.Fprintf(os.Stderr, "Error unmarshaling stream result: %v\n", err)
+			return tracerr.Wrap(err)
+		}
+		if s.recorder != nil && len(streamUpdate.Results) > 0 {
+			s.recorder.RecordStreamUpdate(&streamUpdate)
+		}
+		s.seq++
+		observers := s.getObservers()
+		var updated map[*streamManager]bool
+		if len(observers) > 0 {
+			updated = make(map[*streamManager]bool)
 		}
 		for _, result := range streamUpdate.Results {
-			s.WriteToStream(result.Id, result.Result.Value)
+			sm := s.getStreamManager(result.Id)
+			sm.recordUpdate(result.Result.Error != nil)
+			// Results with errors have no value to pass on.
+			if result.Result.Error == nil {
+				sm.write(result.Result.Value)
+				if updated != nil {
+					updated[sm] = true
+				}
+			}
+		}
+		for _, observer := range observers {
+			observer(s.seq, updated)
 		}
 
 		select {
 		case <-ctx.Done():
 			s.Close()
-			return
+			return tracerr.Wrap(ctx.Err())
 		default:
 		}
 	}
 }
 
+// isClosedError checks if an error is from using a connection that was
+// closed on this end.
+func isClosedError(err error) bool {
+	return errors.Is(err, ErrClosed) || errors.Is(err, net.ErrClosed) ||
+		errors.Is(err, os.ErrClosed) || errors.Is(err, io.ErrClosedPipe)
+}
+
+// getObservers gets the functions to call after each stream update.
+func (s *StreamClient) getObservers() []func(uint64, map[*streamManager]bool) {
+	s.RLock()
+	defer s.RUnlock()
+	if len(s.observers) == 0 {
+		return nil
+	}
+	observers := make([]func(uint64, map[*streamManager]bool), 0, len(s.observers))
+	for _, observer := range s.observers {
+		observers = append(observers, observer)
+	}
+	return observers
+}
+
+// observe calls observer after each stream update, until the returned
+// function is called.
+func (s *StreamClient) observe(observer func(seq uint64, updated map[*streamManager]bool)) func() {
+	s.Lock()
+	defer s.Unlock()
+	id := s.newObserverID()
+	s.observers[id] = observer
+	return func() {
+		s.Lock()
+		defer s.Unlock()
+		delete(s.observers, id)
+	}
+}
+
 func (s *StreamClient) getStreamManager(id uint64) *streamManager {
 	s.RLock()
 	sm, ok := s.streams[id]
@@ -90,6 +220,10 @@ func (s *StreamClient) getStreamManager(id uint64) *streamManager {
 		return sm
 	}
 	sm = newStreamManager(id)
+	sm.client = s
+	if s.err != nil {
+		sm.end(s.err)
+	}
 	s.streams[id] = sm
 	return sm
 }
@@ -101,8 +235,8 @@ func (s *StreamClient) WriteToStream(id uint64, b []byte) {
 }
 
 // GetStream gets a byte stream for a particular stream ID.
-func (s *StreamClient) GetStream(id uint64) *Stream[[]byte] {
-	return s.getStreamManager(id).newStream()
+func (s *StreamClient) GetStream(id uint64, opts ...StreamOption) *Stream[[]byte] {
+	return s.getStreamManager(id).newStream(opts...)
 }
 
 // DeleteStream removes a byte stream for a particular stream ID. Note that
@@ -114,33 +248,198 @@ func (s *StreamClient) DeleteStream(id uint64) {
 	delete(s.streams, id)
 }
 
+// addedStreams gets the streams that were added with a procedure call and
+// are still in use.
+func (s *StreamClient) addedStreams() []*streamManager {
+	s.RLock()
+	defer s.RUnlock()
+
+	var sms []*streamManager
+	for _, sm := range s.streams {
+		if sm.getCall() != nil && sm.numRefs() > 0 {
+			sms = append(sms, sm)
+		}
+	}
+	return sms
+}
+
+// remapStreams replaces all streams with the given streams under their new
+// IDs. The other streams no longer exist on the server, so they're ended with
+// ErrStreamLost.
+func (s *StreamClient) remapStreams(ids map[*streamManager]uint64) {
+	s.Lock()
+	defer s.Unlock()
+
+	for _, sm := range s.streams {
+		if _, ok := ids[sm]; !ok {
+			sm.setLost()
+			sm.end(ErrStreamLost)
+		}
+	}
+	s.streams = make(map[uint64]*streamManager)
+	for sm, id := range ids {
+		sm.setID(id)
+		s.streams[id] = sm
+	}
+}
+
 type streamManager struct {
-	id       uint64
-	channels map[int]chan []byte
-	newID    func() int
+	id uint64
+	// client is the stream client receiving the stream's updates, if any.
+	client *StreamClient
+	// call is the procedure call the stream was added with, if known.
+	call *types.ProcedureCall
+	// refs counts the streams using the stream added to the server.
+	refs int
+	// started is set once the stream added to the server has started, and
+	// rate is its update rate, if set.
+	started bool
+	// lost is set if the stream wasn't added to the server again after
+	// reconnecting, so it no longer exists there.
+	lost      bool
+	rate      float32
+	listeners map[int]*Stream[[]byte]
+	latest    *latestValue[[]byte]
+	newID     func() int
+	metrics   streamMetrics
+	// dropped counts the updates dropped by every listener.
+	dropped atomic.Uint64
+	// ended is set once the streams have been ended, with err as the
+	// reason.
+	ended bool
+	err   error
 	sync.RWMutex
 }
 
 func newStreamManager(id uint64) *streamManager {
 	return &streamManager{
-		id:       id,
-		channels: make(map[int]chan []byte),
-		newID:    utils.NewIDGenerator(),
+		id:        id,
+		listeners: make(map[int]*Stream[[]byte]),
+		latest:    newLatestValue[[]byte](),
+		newID:     utils.NewIDGenerator(),
 	}
 }
 
-func (sm *streamManager) newStream() *Stream[[]byte] {
+// recordUpdate counts an update to the stream.
+func (sm *streamManager) recordUpdate(failed bool) {
 	sm.Lock()
 	defer sm.Unlock()
+	sm.metrics.updates++
+	if failed {
+		sm.metrics.errors++
+	}
+	sm.metrics.lastUpdate = time.Now()
+}
 
-	c := make(chan []byte)
-	idx := sm.newID()
-	sm.channels[idx] = c
-	s := &Stream[[]byte]{
-		C:     c,
-		ID:    sm.id,
-		clone: sm.newStream,
+// getMetrics gets the stream's metrics.
+func (sm *streamManager) getMetrics() streamMetrics {
+	sm.RLock()
+	defer sm.RUnlock()
+	metrics := sm.metrics
+	metrics.dropped = sm.dropped.Load()
+	return metrics
+}
+
+func (sm *streamManager) getID() uint64 {
+	sm.RLock()
+	defer sm.RUnlock()
+	return sm.id
+}
+
+func (sm *streamManager) setID(id uint64) {
+	sm.Lock()
+	defer sm.Unlock()
+	sm.id = id
+}
+
+func (sm *streamManager) getCall() *types.ProcedureCall {
+	sm.RLock()
+	defer sm.RUnlock()
+	return sm.call
+}
+
+func (sm *streamManager) setCall(call *types.ProcedureCall) {
+	sm.Lock()
+	defer sm.Unlock()
+	sm.call = call
+}
+
+// acquire adds a reference to the stream added to the server.
+func (sm *streamManager) acquire() {
+	sm.Lock()
+	defer sm.Unlock()
+	sm.refs++
+}
+
+// release drops a reference to the stream added to the server, and returns
+// the number of references left.
+func (sm *streamManager) release() int {
+	sm.Lock()
+	defer sm.Unlock()
+	sm.refs--
+	return sm.refs
+}
+
+func (sm *streamManager) setLost() {
+	sm.Lock()
+	defer sm.Unlock()
+	sm.lost = true
+}
+
+func (sm *streamManager) isLost() bool {
+	sm.RLock()
+	defer sm.RUnlock()
+	return sm.lost
+}
+
+func (sm *streamManager) numRefs() int {
+	sm.RLock()
+	defer sm.RUnlock()
+	return sm.refs
+}
+
+func (sm *streamManager) setStarted() {
+	sm.Lock()
+	defer sm.Unlock()
+	sm.started = true
+}
+
+func (sm *streamManager) isStarted() bool {
+	sm.RLock()
+	defer sm.RUnlock()
+	return sm.started
+}
+
+func (sm *streamManager) setRate(rate float32) {
+	sm.Lock()
+	defer sm.Unlock()
+	sm.rate = rate
+}
+
+func (sm *streamManager) getRate() float32 {
+	sm.RLock()
+	defer sm.RUnlock()
+	return sm.rate
+}
+
+func (sm *streamManager) newStream(opts ...StreamOption) *Stream[[]byte] {
+	sm.Lock()
+	defer sm.Unlock()
+
+	cfg := newStreamConfig(opts)
+	s := newStream(sm.id, make(chan []byte, cfg.BufferSize), func() *Stream[[]byte] {
+		return sm.newStream(opts...)
+	})
+	s.overflow = cfg.Overflow
+	s.manager = sm
+	s.latest, s.ready, s.updated = sm.latest.get, sm.latest.ready, sm.latest.updated
+	if sm.ended {
+		s.end(sm.err)
+		close(s.C)
+		return s
 	}
+	idx := sm.newID()
+	sm.listeners[idx] = s
 	s.AddCloser(func() error {
 		sm.deleteStream(idx)
 		return nil
@@ -152,28 +451,205 @@ func (sm *streamManager) deleteStream(idx int) {
 	sm.Lock()
 	defer sm.Unlock()
 
-	delete(sm.channels, idx)
+	if s, ok := sm.listeners[idx]; ok {
+		delete(sm.listeners, idx)
+		close(s.C)
+	}
 }
 
 func (sm *streamManager) write(b []byte) {
+	sm.latest.set(b)
 	sm.RLock()
 	defer sm.RUnlock()
 
-	for _, ch := range sm.channels {
-		select {
-		case ch <- b:
-		// Don't update channel if no one is listening.
-		default:
+	for _, s := range sm.listeners {
+		if dropped := s.send(b); dropped > 0 {
+			sm.dropped.Add(dropped)
 		}
 	}
 }
 
-// Stream is a struct for receiving stream data.
+// end ends every stream with an error, and any streams created later.
+func (sm *streamManager) end(err error) {
+	sm.Lock()
+	defer sm.Unlock()
+
+	sm.ended = true
+	sm.err = err
+	for idx, s := range sm.listeners {
+		delete(sm.listeners, idx)
+		s.end(err)
+		close(s.C)
+	}
+}
+
+// Stream is a struct for receiving stream data. C is closed when the stream
+// ends, either because it was closed or because the connection ended, after
+// Done is closed. Updates that can't be received from C straight away are
+// buffered or dropped according to the StreamOptions the stream was created
+// with.
 type Stream[T any] struct {
-	C       chan T
+	C chan T
+	// ID is the server's ID for the stream when it was created. The server
+	// assigns a new ID if the client reconnects.
 	ID      uint64
 	clone   func() *Stream[T]
 	closers []func() error
+	// overflow decides what happens to updates when C is full.
+	overflow OverflowPolicy
+	// dropped counts the updates dropped because C was full, and
+	// sourceDropped, if set, counts those dropped by the streams this one is
+	// derived from.
+	dropped       *atomic.Uint64
+	sourceDropped func() uint64
+	// start starts the streams on the server, if they can be started.
+	start []*streamStart
+	// manager, if set, manages the server stream that the stream's values
+	// come from, converted with MapStream or not at all.
+	manager *streamManager
+
+	// done is closed when the stream ends, after err is set.
+	done    chan struct{}
+	endOnce sync.Once
+	err     error
+	// latest gets the most recent value and when it arrived, once ready is
+	// closed. updated gets a channel that's closed when the value changes.
+	latest  func() (T, time.Time)
+	ready   <-chan struct{}
+	updated func() <-chan struct{}
+}
+
+func newStream[T any](id uint64, c chan T, clone func() *Stream[T]) *Stream[T] {
+	return &Stream[T]{
+		C:       c,
+		ID:      id,
+		clone:   clone,
+		done:    make(chan struct{}),
+		dropped: new(atomic.Uint64),
+	}
+}
+
+// NewStream creates a stream with the given ID that receives values from c,
+// such as for a Caller other than KRPCClient. Clones of the stream receive
+// from the same channel, so each value is only received by one of them. The
+// stream ends when c is closed. Values are passed on with the Block overflow
+// policy, which streams derived from it inherit, so none are dropped.
+func NewStream[T any](id uint64, c chan T) *Stream[T] {
+	s := newStream(id, make(chan T), func() *Stream[T] {
+		return NewStream(id, c)
+	})
+	s.overflow = Block
+	latest := newLatestValue[T]()
+	s.latest, s.ready, s.updated = latest.get, latest.ready, latest.updated
+
+	go func() {
+		defer close(s.C)
+		for {
+			select {
+			case value, ok := <-c:
+				if !ok {
+					s.end(nil)
+					return
+				}
+				latest.set(value)
+				select {
+				case s.C <- value:
+				case <-s.done:
+					return
+				}
+			case <-s.done:
+				return
+			}
+		}
+	}()
+	return s
+}
+
+// end marks the stream as ended by err, unless it already ended.
+func (s *Stream[T]) end(err error) {
+	s.endOnce.Do(func() {
+		s.err = err
+		close(s.done)
+	})
+}
+
+// Done returns a channel that is closed when the stream ends.
+func (s *Stream[T]) Done() <-chan struct{} {
+	return s.done
+}
+
+// Get gets the most recent value of the stream, waiting for the first value
+// if none has arrived yet. Unlike receiving from C, values aren't consumed,
+// so Get can be called repeatedly, e.g. once per iteration of a control
+// loop. See Latest.
+func (s *Stream[T]) Get() (T, error) {
+	value, _, err := s.Latest(context.Background())
+	return value, tracerr.Wrap(err)
+}
+
+// Latest gets the most recent value of the stream and when it arrived,
+// waiting for the first value until ctx is done. Once the stream has ended,
+// it returns the error from Err, or ErrStreamClosed if the stream was closed,
+// including if it ends because the value can't be converted by MapStreamErr.
+func (s *Stream[T]) Latest(ctx context.Context) (T, time.Time, error) {
+	var zero T
+	if err := s.endErr(); err != nil {
+		return zero, time.Time{}, tracerr.Wrap(err)
+	}
+	select {
+	case <-s.ready:
+	case <-s.done:
+		return zero, time.Time{}, tracerr.Wrap(s.endErr())
+	case <-ctx.Done():
+		return zero, time.Time{}, tracerr.Wrap(ctx.Err())
+	}
+	value, t := s.latest()
+	if err := s.endErr(); err != nil {
+		return zero, time.Time{}, tracerr.Wrap(err)
+	}
+	return value, t, nil
+}
+
+// endErr gets the error to return for the stream having ended, or nil if it
+// hasn't ended.
+func (s *Stream[T]) endErr() error {
+	select {
+	case <-s.done:
+		if s.err != nil {
+			return s.err
+		}
+		return ErrStreamClosed
+	default:
+		return nil
+	}
+}
+
+// Err returns the error that ended the stream, such as the error reading from
+// the connection, or ErrClosed if the client was closed. It returns nil if
+// the stream hasn't ended or was ended by Close.
+func (s *Stream[T]) Err() error {
+	select {
+	case <-s.done:
+		return s.err
+	default:
+		return nil
+	}
+}
+
+// Dropped gets the number of updates the stream has dropped because they
+// weren't received from C in time, including those dropped by the streams it
+// was derived from with MapStream or another combinator.
+func (s *Stream[T]) Dropped() uint64 {
+	dropped := s.dropped.Load()
+	if s.sourceDropped != nil {
+		dropped += s.sourceDropped()
+	}
+	return dropped
+}
+
+// config gets the buffer size and overflow policy of the stream.
+func (s *Stream[T]) config() StreamConfig {
+	return StreamConfig{BufferSize: cap(s.C), Overflow: s.overflow}
 }
 
 // Clone clones the stream for another thread to listen on.
@@ -187,6 +663,7 @@ func (s *Stream[T]) AddCloser(close func() error) {
 
 // Close closes the stream.
 func (s *Stream[T]) Close() error {
+	s.end(nil)
 	for _, close := range s.closers {
 		if err := close(); err != nil {
 			return tracerr.Wrap(err)
@@ -195,28 +672,54 @@ func (s *Stream[T]) Close() error {
 	return nil
 }
 
-// MapStream converts a stream to another type.
+// MapStream converts a stream to another type. The new stream ends when src
+// ends, with the same error, and closing it closes src. Unlike the other
+// combinators, it has no buffer of its own: values are passed on as they're
+// received, so they're buffered and dropped by src, and counted by the
+// client's metrics if src is a stream of the server.
 func MapStream[S, T any](src *Stream[S], m func(S) T) *Stream[T] {
-	ctx, cancel := context.WithCancel(context.Background())
-	dst := &Stream[T]{
-		C:  make(chan T),
-		ID: src.ID,
-		clone: func() *Stream[T] {
-			return MapStream(src.Clone(), m)
-		},
-	}
-
-	dst.AddCloser(func() error {
-		cancel()
-		return tracerr.Wrap(src.Close())
+	return MapStreamErr(src, func(value S) (T, error) {
+		return m(value), nil
 	})
+}
+
+// MapStreamErr converts a stream to another type like MapStream, with a
+// conversion that can fail, such as decoding. The new stream ends with the
+// error of the first value that can't be converted, which Err returns.
+// Closing it still closes src.
+func MapStreamErr[S, T any](src *Stream[S], m func(S) (T, error)) *Stream[T] {
+	dst, _ := deriveStreamWith(StreamConfig{Overflow: Block}, src.ID, func() *Stream[T] {
+		return MapStreamErr(src.Clone(), m)
+	}, src)
+	// The latest value is converted when it's asked for, rather than for
+	// every value.
+	dst.latest = func() (T, time.Time) {
+		value, t := src.latest()
+		converted, err := m(value)
+		if err != nil {
+			dst.end(tracerr.Wrap(err))
+		}
+		return converted, t
+	}
+	dst.ready, dst.updated = src.ready, src.updated
+	dst.manager = src.manager
 
 	go func() {
+		defer close(dst.C)
 		for {
 			select {
-			case data := <-src.C:
-				dst.C <- m(data)
-			case <-ctx.Done():
+			case data, ok := <-src.C:
+				if !ok {
+					dst.end(src.Err())
+					return
+				}
+				value, err := m(data)
+				if err != nil {
+					dst.end(tracerr.Wrap(err))
+					return
+				}
+				dst.send(value)
+			case <-dst.done:
 				return
 			}
 		}
@@ -224,3 +727,48 @@ func MapStream[S, T any](src *Stream[S], m func(S) T) *Stream[T] {
 
 	return dst
 }
+
+// latestValue holds the most recent value of a stream.
+type latestValue[T any] struct {
+	mu    sync.Mutex
+	value T
+	time  time.Time
+	// ready is closed once there is a value.
+	ready chan struct{}
+	// next is closed when the value changes, and then replaced.
+	next chan struct{}
+}
+
+func newLatestValue[T any]() *latestValue[T] {
+	return &latestValue[T]{
+		ready: make(chan struct{}),
+		next:  make(chan struct{}),
+	}
+}
+
+// set sets the value, which arrived now.
+func (l *latestValue[T]) set(value T) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.value = value
+	if l.time.IsZero() {
+		close(l.ready)
+	}
+	l.time = time.Now()
+	close(l.next)
+	l.next = make(chan struct{})
+}
+
+// updated gets a channel that's closed when the value next changes.
+func (l *latestValue[T]) updated() <-chan struct{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.next
+}
+
+// get gets the value and when it arrived.
+func (l *latestValue[T]) get() (T, time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.value, l.time
+}
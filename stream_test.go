@@ -2,12 +2,17 @@ package krpcgo
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/atburke/krpc-go/krpctest"
 	"github.com/atburke/krpc-go/lib/utils"
+	"github.com/atburke/krpc-go/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -25,7 +30,7 @@ func TestStreamManager(t *testing.T) {
 
 			for i := 0; i < numStreams; i++ {
 				i := i
-				stream := sm.newStream()
+				stream := sm.newStream(WithOverflow(Block))
 				go func() {
 					for {
 						select {
@@ -40,11 +45,7 @@ func TestStreamManager(t *testing.T) {
 				}()
 			}
 
-			// HACK: Stream values will likely be dropped if they're sent too
-			// quickly. Fortunately, in a real stream a) there will be a delay
-			// between updates anyway and b) it's ok to drop a few values.
 			for _, s := range input {
-				time.Sleep(10 * time.Millisecond)
 				sm.write([]byte(s))
 			}
 
@@ -61,3 +62,258 @@ func TestStreamManager(t *testing.T) {
 		})
 	}
 }
+
+func TestStreamOverflow(t *testing.T) {
+	input := []string{"a", "b", "c", "d", "e"}
+	tcs := []struct {
+		name        string
+		opts        []StreamOption
+		wantValues  []string
+		wantDropped uint64
+	}{
+		{
+			name:        "unbuffered",
+			wantDropped: 5,
+		},
+		{
+			name:        "drop newest",
+			opts:        []StreamOption{WithBuffer(3)},
+			wantValues:  []string{"a", "b", "c"},
+			wantDropped: 2,
+		},
+		{
+			name:        "drop oldest",
+			opts:        []StreamOption{WithBuffer(3), WithOverflow(DropOldest)},
+			wantValues:  []string{"c", "d", "e"},
+			wantDropped: 2,
+		},
+		{
+			name:        "conflate",
+			opts:        []StreamOption{WithBuffer(3), WithOverflow(Conflate)},
+			wantValues:  []string{"e"},
+			wantDropped: 4,
+		},
+		{
+			name:       "buffer holds everything",
+			opts:       []StreamOption{WithBuffer(10), WithOverflow(DropOldest)},
+			wantValues: input,
+		},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			sm := newStreamManager(0)
+			stream := sm.newStream(tc.opts...)
+			for _, s := range input {
+				sm.write([]byte(s))
+			}
+			require.NoError(t, stream.Close())
+
+			var values []string
+			for data := range stream.C {
+				values = append(values, string(data))
+			}
+			require.Equal(t, tc.wantValues, values)
+			require.Equal(t, tc.wantDropped, stream.Dropped())
+			require.Equal(t, tc.wantDropped, sm.getMetrics().dropped)
+		})
+	}
+}
+
+func TestStreamBlock(t *testing.T) {
+	sm := newStreamManager(0)
+	stream := sm.newStream(WithOverflow(Block))
+	written := make(chan struct{})
+	go func() {
+		defer close(written)
+		sm.write([]byte("a"))
+		sm.write([]byte("b"))
+	}()
+
+	for _, want := range []string{"a", "b"} {
+		select {
+		case data := <-stream.C:
+			require.Equal(t, want, string(data))
+		case <-time.After(time.Second):
+			t.Fatal("Timed out waiting for update")
+		}
+	}
+	<-written
+
+	// Closing the stream stops a blocked write.
+	blocked := make(chan struct{})
+	go func() {
+		defer close(blocked)
+		sm.write([]byte("c"))
+	}()
+	require.NoError(t, stream.Close())
+	select {
+	case <-blocked:
+	case <-time.After(time.Second):
+		t.Fatal("Write still blocked after closing the stream")
+	}
+	require.Zero(t, stream.Dropped())
+}
+
+// requireEnded checks that a stream ended with an error matching target, or
+// with no error if target is nil.
+func requireEnded[T any](t *testing.T, stream *Stream[T], target error) {
+	t.Helper()
+	select {
+	case <-stream.Done():
+	case <-time.After(time.Second):
+		t.Fatal("Stream didn't end")
+	}
+	for range stream.C {
+	}
+	if target == nil {
+		require.NoError(t, stream.Err())
+	} else {
+		require.ErrorIs(t, stream.Err(), target)
+	}
+}
+
+func TestStreamEnd(t *testing.T) {
+	call := &types.ProcedureCall{Service: "Test", Procedure: "Value"}
+
+	t.Run("closed", func(t *testing.T) {
+		client := connect(t, krpctest.NewServer(t), KRPCClientConfig{})
+		stream, err := client.AddStream(call)
+		require.NoError(t, err)
+		mapped := MapStream(stream.Clone(), func(b []byte) string { return string(b) })
+		require.NoError(t, stream.Close())
+		requireEnded(t, stream, nil)
+		require.NoError(t, mapped.Close())
+		requireEnded(t, mapped, nil)
+	})
+
+	t.Run("connection lost", func(t *testing.T) {
+		server := krpctest.NewServer(t)
+		client := connect(t, server, KRPCClientConfig{})
+		stream, err := client.AddStream(call)
+		require.NoError(t, err)
+		mapped := MapStream(stream.Clone(), func(b []byte) string { return string(b) })
+		server.DropConnections()
+		requireEnded(t, stream, io.EOF)
+		requireEnded(t, mapped, io.EOF)
+
+		// Streams created afterwards end straight away.
+		requireEnded(t, client.GetStream(stream.ID), io.EOF)
+	})
+
+	t.Run("client closed", func(t *testing.T) {
+		client := connect(t, krpctest.NewServer(t), KRPCClientConfig{})
+		stream, err := client.AddStream(call)
+		require.NoError(t, err)
+		client.Close()
+		requireEnded(t, stream, ErrClosed)
+	})
+
+	t.Run("conversion error", func(t *testing.T) {
+		server := krpctest.NewServer(t)
+		client := connect(t, server, KRPCClientConfig{})
+		stream, err := client.AddStream(call)
+		require.NoError(t, err)
+		errBad := errors.New("bad value")
+		convert := func(b []byte) (string, error) {
+			if string(b) == "bad" {
+				return "", errBad
+			}
+			return string(b), nil
+		}
+		received := MapStreamErr(stream.Clone(), convert)
+		latest := MapStreamErr(stream, convert)
+		require.NoError(t, server.Push(stream.ID, []byte("bad")))
+		requireEnded(t, received, errBad)
+		require.Eventually(t, func() bool {
+			_, err := latest.Get()
+			return errors.Is(err, errBad)
+		}, time.Second, time.Millisecond)
+		require.ErrorIs(t, latest.Err(), errBad)
+	})
+
+	t.Run("decode error", func(t *testing.T) {
+		clientConn, serverConn := net.Pipe()
+		defer serverConn.Close()
+		streamClient := NewStreamClient(clientConn)
+		go streamClient.Run(context.Background())
+		stream := streamClient.GetStream(1)
+		require.NoError(t, send(serverConn, []byte{0xff}))
+		select {
+		case <-stream.Done():
+		case <-time.After(time.Second):
+			t.Fatal("Stream didn't end")
+		}
+		require.Error(t, stream.Err())
+	})
+}
+
+func TestStreamLatest(t *testing.T) {
+	server := krpctest.NewServer(t)
+	client := connect(t, server, KRPCClientConfig{})
+	stream, err := client.AddStream(&types.ProcedureCall{Service: "Test", Procedure: "Value"})
+	require.NoError(t, err)
+	mapped := MapStream(stream.Clone(), func(b []byte) string { return string(b) })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, _, err = stream.Latest(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// Values are kept even though nobody is receiving from the channels.
+	before := time.Now()
+	require.NoError(t, server.Push(stream.ID, []byte("first")))
+	value, arrived, err := stream.Latest(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "first", string(value))
+	require.False(t, arrived.Before(before))
+	s, err := mapped.Get()
+	require.NoError(t, err)
+	require.Equal(t, "first", s)
+
+	require.NoError(t, server.Push(stream.ID, []byte("second")))
+	require.Eventually(t, func() bool {
+		s, err := mapped.Get()
+		return err == nil && s == "second"
+	}, time.Second, time.Millisecond)
+
+	// Streams created later start with the latest value.
+	later := MapStream(client.GetStream(stream.ID), func(b []byte) string { return string(b) })
+	defer later.Close()
+	s, err = later.Get()
+	require.NoError(t, err)
+	require.Equal(t, "second", s)
+
+	require.NoError(t, mapped.Close())
+	_, err = mapped.Get()
+	require.ErrorIs(t, err, ErrStreamClosed)
+}
+
+func TestSharedStream(t *testing.T) {
+	server := krpctest.NewServer(t)
+	client := connect(t, server, KRPCClientConfig{})
+	call := &types.ProcedureCall{Service: "Test", Procedure: "Value"}
+	first, err := client.AddStream(call)
+	require.NoError(t, err)
+	second, err := client.AddStream(call, WithBuffer(1))
+	require.NoError(t, err)
+	require.Equal(t, first.ID, second.ID)
+	mapped := MapStream(first.Clone(), func(b []byte) string { return string(b) })
+
+	// The server stream is kept until the last stream is closed.
+	require.NoError(t, first.Close())
+	require.NoError(t, mapped.Close())
+	require.Empty(t, server.CallsTo("KRPC", "RemoveStream"))
+	require.NoError(t, server.Push(second.ID, []byte("value")))
+	select {
+	case data := <-second.C:
+		require.Equal(t, "value", string(data))
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for update")
+	}
+
+	require.NoError(t, second.Close())
+	require.NoError(t, second.Close())
+	require.Len(t, server.CallsTo("KRPC", "RemoveStream"), 1)
+	require.Empty(t, server.StreamIDs())
+}
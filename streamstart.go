@@ -0,0 +1,109 @@
+package krpcgo
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+
+	"github.com/atburke/krpc-go/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/ztrue/tracerr"
+)
+
+// WithRate sets the number of updates per second the server sends for the
+// stream.
+func WithRate(rate float32) StreamOption {
+	return func(cfg *StreamConfig) {
+		cfg.Rate = rate
+	}
+}
+
+// Unstarted adds the stream to the server without starting it. Start it with
+// Stream.Start, or with StartAll to start several streams at once.
+func Unstarted(cfg *StreamConfig) {
+	cfg.Unstarted = true
+}
+
+// streamStart starts a stream on the server.
+type streamStart struct {
+	caller Caller
+	// call gets the call that starts the stream.
+	call func() *types.ProcedureCall
+	// started is called once the stream has started.
+	started func()
+}
+
+// Startable is a stream that can be started with StartAll. *Stream[T] is
+// Startable for any T.
+type Startable interface {
+	startInfo() []*streamStart
+}
+
+func (s *Stream[T]) startInfo() []*streamStart {
+	return s.start
+}
+
+// Start starts a stream created with the Unstarted option. Starting a stream
+// that has already started has no effect.
+func (s *Stream[T]) Start() error {
+	return tracerr.Wrap(StartAll(context.Background(), s))
+}
+
+// StartAll starts streams created with the Unstarted option together, so that
+// their first updates come from the same server update. Streams from the same
+// client are started in a single request. Combined streams start all of
+// their sources. Streams that can't be started, such as those created with
+// NewStream, are skipped.
+func StartAll(ctx context.Context, streams ...Startable) error {
+	var callers []Caller
+	starts := make(map[Caller][]*streamStart)
+	for _, stream := range streams {
+		for _, start := range stream.startInfo() {
+			if _, ok := starts[start.caller]; !ok {
+				callers = append(callers, start.caller)
+			}
+			starts[start.caller] = append(starts[start.caller], start)
+		}
+	}
+
+	for _, caller := range callers {
+		calls := make([]*types.ProcedureCall, len(starts[caller]))
+		for i, start := range starts[caller] {
+			calls[i] = start.call()
+		}
+		results, err := caller.CallMultipleContext(ctx, calls)
+		if err != nil {
+			return tracerr.Wrap(err)
+		}
+		for i, result := range results {
+			if result.Error != nil {
+				return tracerr.Wrap(translateError(result.Error))
+			}
+			starts[caller][i].started()
+		}
+	}
+	return nil
+}
+
+// startStreamCall creates a call to KRPC.StartStream for a stream ID.
+func startStreamCall(id uint64) *types.ProcedureCall {
+	return &types.ProcedureCall{
+		Service:   "KRPC",
+		Procedure: "StartStream",
+		Arguments: []*types.Argument{
+			{Position: 0, Value: proto.EncodeVarint(id)},
+		},
+	}
+}
+
+// setStreamRateCall creates a call to KRPC.SetStreamRate for a stream ID.
+func setStreamRateCall(id uint64, rate float32) *types.ProcedureCall {
+	return &types.ProcedureCall{
+		Service:   "KRPC",
+		Procedure: "SetStreamRate",
+		Arguments: []*types.Argument{
+			{Position: 0, Value: proto.EncodeVarint(id)},
+			{Position: 1, Value: binary.LittleEndian.AppendUint32(nil, math.Float32bits(rate))},
+		},
+	}
+}
@@ -0,0 +1,59 @@
+package krpcgo
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/atburke/krpc-go/krpctest"
+	"github.com/atburke/krpc-go/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartAll(t *testing.T) {
+	server := krpctest.NewServer(t)
+	var mu sync.Mutex
+	var batches [][]string
+	record := func(ctx context.Context, calls []*types.ProcedureCall, invoker Invoker) ([]*types.ProcedureResult, error) {
+		var names []string
+		for _, call := range calls {
+			names = append(names, procedureName(call))
+		}
+		mu.Lock()
+		batches = append(batches, names)
+		mu.Unlock()
+		return invoker(ctx, calls)
+	}
+	client := connect(t, server, KRPCClientConfig{Interceptors: []Interceptor{record}})
+
+	altitude, err := client.AddStream(&types.ProcedureCall{Service: "Test", Procedure: "Altitude"}, Unstarted, WithRate(1))
+	require.NoError(t, err)
+	speed, err := client.AddStream(&types.ProcedureCall{Service: "Test", Procedure: "Speed"}, Unstarted)
+	require.NoError(t, err)
+
+	adds := server.CallsTo("KRPC", "AddStream")
+	require.Len(t, adds, 2)
+	for _, add := range adds {
+		require.Equal(t, proto.EncodeVarint(0), add.Arguments[1].Value)
+	}
+	rates := server.CallsTo("KRPC", "SetStreamRate")
+	require.Len(t, rates, 1)
+	require.Equal(t, proto.EncodeVarint(altitude.ID), rates[0].Arguments[0].Value)
+	require.Equal(t, float32(1), math.Float32frombits(binary.LittleEndian.Uint32(rates[0].Arguments[1].Value)))
+
+	mapped := MapStream(speed, func(b []byte) string { return string(b) })
+	mu.Lock()
+	batches = nil
+	mu.Unlock()
+	require.NoError(t, StartAll(context.Background(), altitude, mapped))
+	require.Equal(t, [][]string{{"KRPC.StartStream", "KRPC.StartStream"}}, batches)
+	for _, id := range []uint64{altitude.ID, speed.ID} {
+		require.True(t, client.getStreamManager(id).isStarted())
+	}
+
+	// Streams that can't be started are skipped.
+	require.NoError(t, NewStream(1, make(chan int)).Start())
+}
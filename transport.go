@@ -0,0 +1,179 @@
+package krpcgo
+
+import (
+	"context"
+	"encoding/base64"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/atburke/krpc-go/internal/websocket"
+	"github.com/atburke/krpc-go/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/ztrue/tracerr"
+)
+
+// Dialer opens connections. *net.Dialer is a Dialer.
+type Dialer interface {
+	DialContext(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+// DialerFunc is a function that can be used as a Dialer, e.g. to connect
+// through a Unix socket instead.
+type DialerFunc func(ctx context.Context, network, address string) (net.Conn, error)
+
+// DialContext calls f.
+func (f DialerFunc) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	return f(ctx, network, address)
+}
+
+// dial connects to a server port.
+func (c *KRPCClient) dial(ctx context.Context, port string) (net.Conn, error) {
+	addr := net.JoinHostPort(c.Host, port)
+	conn, err := c.Dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, tracerr.Wrap(&DialError{Addr: addr, Err: err})
+	}
+	return conn, nil
+}
+
+// NewKRPCClientFromConns creates a client that uses already open connections
+// to the RPC and stream servers, such as either end of a net.Pipe, and
+// performs the connection handshakes. If streamConn is nil, the client is
+// RPC-only. If the client reconnects, it opens new connections with the
+// configured Dialer. The connections are closed if the handshakes fail.
+func NewKRPCClientFromConns(ctx context.Context, cfg KRPCClientConfig, rpcConn, streamConn net.Conn) (*KRPCClient, error) {
+	if streamConn == nil {
+		cfg.RPCOnly = true
+	}
+	c := NewKRPCClient(cfg)
+	c.ctx = ctx
+	if err := c.handshakeRPC(rpcConn); err != nil {
+		if streamConn != nil {
+			streamConn.Close()
+		}
+		return nil, tracerr.Wrap(err)
+	}
+	if streamConn != nil {
+		if err := c.handshakeStream(ctx, streamConn); err != nil {
+			rpcConn.Close()
+			return nil, tracerr.Wrap(err)
+		}
+	}
+	c.startPipeline()
+	return c, nil
+}
+
+// Protocol is a protocol for talking to a kRPC server. It must match the
+// protocol the server is configured with.
+type Protocol int
+
+const (
+	// ProtocolTCP sends messages over TCP, each prefixed with its
+	// varint-encoded length. This is the server's "Protobuf over TCP"
+	// protocol.
+	ProtocolTCP Protocol = iota
+	// ProtocolWebSocket sends messages as binary WebSocket messages. This is
+	// the server's "Protobuf over WebSockets" protocol.
+	ProtocolWebSocket
+	// ProtocolSerialIO multiplexes RPCs and stream updates over a serial
+	// port. This is the server's "Protobuf over SerialIO" protocol.
+	ProtocolSerialIO
+)
+
+// messageConn is a connection to a kRPC server that carries whole messages.
+type messageConn interface {
+	// WriteMessage sends a message.
+	WriteMessage(data []byte) error
+	// ReadMessage receives a message.
+	ReadMessage() ([]byte, error)
+	SetDeadline(t time.Time) error
+	Close() error
+}
+
+// framedConn carries messages over a byte stream, each prefixed with its
+// varint-encoded length.
+type framedConn struct {
+	net.Conn
+}
+
+// WriteMessage sends a message.
+func (c *framedConn) WriteMessage(data []byte) error {
+	return tracerr.Wrap(send(c.Conn, data))
+}
+
+// ReadMessage receives a message.
+func (c *framedConn) ReadMessage() ([]byte, error) {
+	data, err := receive(c.Conn)
+	return data, tracerr.Wrap(err)
+}
+
+// openRPC performs the protocol's handshake with the RPC server over conn,
+// and uses it as the RPC connection.
+func (c *KRPCClient) openRPC(conn net.Conn) error {
+	switch c.Protocol {
+	case ProtocolWebSocket:
+		ws, err := websocket.Client(conn, c.webSocketURL(c.RPCPort, url.Values{"name": {c.ClientName}}))
+		if err != nil {
+			return tracerr.Wrap(err)
+		}
+		c.conn = ws
+		// The client identifier isn't part of the handshake, so it has to
+		// be asked for.
+		result, err := c.callDirect(c.ctx, "GetClientID")
+		if err != nil {
+			return tracerr.Wrap(err)
+		}
+		// The result is encoded as protobuf bytes.
+		n, size := proto.DecodeVarint(result.Value)
+		if size == 0 || uint64(len(result.Value)-size) != n {
+			return tracerr.Errorf("Invalid client identifier")
+		}
+		copy(c.clientIdentifier[:], result.Value[size:])
+		return nil
+	case ProtocolTCP:
+		framed := &framedConn{conn}
+		resp, err := handshake(framed, &types.ConnectionRequest{
+			Type:       types.ConnectionRequest_RPC,
+			ClientName: c.ClientName,
+		})
+		if err != nil {
+			return tracerr.Wrap(err)
+		}
+		c.conn = framed
+		copy(c.clientIdentifier[:], resp.ClientIdentifier)
+		return nil
+	}
+	return tracerr.Errorf("Unknown protocol %v", c.Protocol)
+}
+
+// openStream performs the protocol's handshake with the stream server over
+// conn.
+func (c *KRPCClient) openStream(conn net.Conn) (messageConn, error) {
+	switch c.Protocol {
+	case ProtocolWebSocket:
+		id := base64.StdEncoding.EncodeToString(c.clientIdentifier[:])
+		ws, err := websocket.Client(conn, c.webSocketURL(c.StreamPort, url.Values{"id": {id}}))
+		return ws, tracerr.Wrap(err)
+	case ProtocolTCP:
+		framed := &framedConn{conn}
+		if _, err := handshake(framed, &types.ConnectionRequest{
+			Type:             types.ConnectionRequest_STREAM,
+			ClientIdentifier: c.clientIdentifier[:],
+		}); err != nil {
+			return nil, tracerr.Wrap(err)
+		}
+		return framed, nil
+	}
+	return nil, tracerr.Errorf("Unknown protocol %v", c.Protocol)
+}
+
+// webSocketURL gets the URL of a WebSocket server.
+func (c *KRPCClient) webSocketURL(port string, query url.Values) *url.URL {
+	return &url.URL{
+		Scheme:   "ws",
+		Host:     net.JoinHostPort(c.Host, port),
+		Path:     "/",
+		RawQuery: query.Encode(),
+	}
+}
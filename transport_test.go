@@ -0,0 +1,86 @@
+package krpcgo
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/atburke/krpc-go/krpctest"
+	"github.com/atburke/krpc-go/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewKRPCClientFromConns(t *testing.T) {
+	server := krpctest.NewServer(t)
+	server.HandleDefault(func(call *types.ProcedureCall) ([]byte, error) {
+		return []byte(call.Procedure), nil
+	})
+	rpcConn, streamConn := server.Pipe()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client, err := NewKRPCClientFromConns(ctx, KRPCClientConfig{}, rpcConn, streamConn)
+	require.NoError(t, err)
+	defer client.Close()
+
+	result, err := client.Call(&types.ProcedureCall{Service: "Test", Procedure: "Echo"})
+	require.NoError(t, err)
+	require.Equal(t, "Echo", string(result.Value))
+
+	// Buffer the update, so that it's kept until it's received.
+	stream, err := client.AddStream(&types.ProcedureCall{Service: "Test", Procedure: "Value"}, WithBuffer(1))
+	require.NoError(t, err)
+	defer stream.Close()
+	require.NoError(t, server.Push(stream.ID, []byte("value")))
+	select {
+	case data := <-stream.C:
+		require.Equal(t, "value", string(data))
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for stream value")
+	}
+}
+
+func TestDialer(t *testing.T) {
+	server := krpctest.NewServer(t)
+	var dials int32
+	connect(t, server, KRPCClientConfig{
+		Dialer: DialerFunc(func(ctx context.Context, network, address string) (net.Conn, error) {
+			atomic.AddInt32(&dials, 1)
+			var d net.Dialer
+			return d.DialContext(ctx, network, address)
+		}),
+	})
+	require.EqualValues(t, 2, atomic.LoadInt32(&dials))
+}
+
+func TestWebSocket(t *testing.T) {
+	server := krpctest.NewWebSocketServer(t)
+	server.HandleDefault(func(call *types.ProcedureCall) ([]byte, error) {
+		return []byte(call.Procedure), nil
+	})
+	client := connect(t, server, KRPCClientConfig{Protocol: ProtocolWebSocket})
+
+	result, err := client.Call(&types.ProcedureCall{Service: "Test", Procedure: "Echo"})
+	require.NoError(t, err)
+	require.Equal(t, "Echo", string(result.Value))
+
+	// Buffer the update, so that it's kept until it's received.
+	stream, err := client.AddStream(&types.ProcedureCall{Service: "Test", Procedure: "Value"}, WithBuffer(1))
+	require.NoError(t, err)
+	defer stream.Close()
+	require.NoError(t, server.Push(stream.ID, []byte("value")))
+	select {
+	case data := <-stream.C:
+		require.Equal(t, "value", string(data))
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for stream value")
+	}
+}
+
+func TestWebSocketRejected(t *testing.T) {
+	server := krpctest.NewWebSocketServer(t)
+	server.SetHandshakeStatus(types.ConnectionRequest_RPC, types.ConnectionResponse_TIMEOUT)
+	client := NewKRPCClient(serverConfig(server, KRPCClientConfig{Protocol: ProtocolWebSocket}))
+	require.Error(t, client.Connect(context.Background()))
+}
@@ -5,8 +5,8 @@
 package ui
 
 import (
+	"context"
 	krpcgo "github.com/atburke/krpc-go"
-	krpc "github.com/atburke/krpc-go/krpc"
 	encode "github.com/atburke/krpc-go/lib/encode"
 	service "github.com/atburke/krpc-go/lib/service"
 	types "github.com/atburke/krpc-go/types"
@@ -113,7 +113,7 @@ type Button struct {
 }
 
 // NewButton creates a new Button.
-func NewButton(id uint64, client *krpcgo.KRPCClient) *Button {
+func NewButton(id uint64, client krpcgo.Caller) *Button {
 	c := &Button{BaseClass: service.BaseClass{Client: client}}
 	c.SetID(id)
 	return c
@@ -126,7 +126,7 @@ type Canvas struct {
 }
 
 // NewCanvas creates a new Canvas.
-func NewCanvas(id uint64, client *krpcgo.KRPCClient) *Canvas {
+func NewCanvas(id uint64, client krpcgo.Caller) *Canvas {
 	c := &Canvas{BaseClass: service.BaseClass{Client: client}}
 	c.SetID(id)
 	return c
@@ -138,7 +138,7 @@ type InputField struct {
 }
 
 // NewInputField creates a new InputField.
-func NewInputField(id uint64, client *krpcgo.KRPCClient) *InputField {
+func NewInputField(id uint64, client krpcgo.Caller) *InputField {
 	c := &InputField{BaseClass: service.BaseClass{Client: client}}
 	c.SetID(id)
 	return c
@@ -151,7 +151,7 @@ type Panel struct {
 }
 
 // NewPanel creates a new Panel.
-func NewPanel(id uint64, client *krpcgo.KRPCClient) *Panel {
+func NewPanel(id uint64, client krpcgo.Caller) *Panel {
 	c := &Panel{BaseClass: service.BaseClass{Client: client}}
 	c.SetID(id)
 	return c
@@ -165,7 +165,7 @@ type RectTransform struct {
 }
 
 // NewRectTransform creates a new RectTransform.
-func NewRectTransform(id uint64, client *krpcgo.KRPCClient) *RectTransform {
+func NewRectTransform(id uint64, client krpcgo.Caller) *RectTransform {
 	c := &RectTransform{BaseClass: service.BaseClass{Client: client}}
 	c.SetID(id)
 	return c
@@ -177,7 +177,7 @@ type Text struct {
 }
 
 // NewText creates a new Text.
-func NewText(id uint64, client *krpcgo.KRPCClient) *Text {
+func NewText(id uint64, client krpcgo.Caller) *Text {
 	c := &Text{BaseClass: service.BaseClass{Client: client}}
 	c.SetID(id)
 	return c
@@ -186,11 +186,11 @@ func NewText(id uint64, client *krpcgo.KRPCClient) *Text {
 // UI - provides functionality for drawing and interacting with in-game user
 // interface elements.
 type UI struct {
-	Client *krpcgo.KRPCClient
+	Client krpcgo.Caller
 }
 
 // New creates a new UI.
-func New(client *krpcgo.KRPCClient) *UI {
+func New(client krpcgo.Caller) *UI {
 	return &UI{Client: client}
 }
 
@@ -198,13 +198,20 @@ func New(client *krpcgo.KRPCClient) *UI {
 //
 // Allowed game scenes: any.
 func (s *UI) AddCanvas() (*Canvas, error) {
+	return s.AddCanvasContext(context.Background())
+}
+
+// AddCanvasContext - add a new canvas.
+//
+// Allowed game scenes: any.
+func (s *UI) AddCanvasContext(ctx context.Context) (*Canvas, error) {
 	var err error
 	var vv Canvas
 	request := &types.ProcedureCall{
 		Procedure: "AddCanvas",
 		Service:   "UI",
 	}
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -216,10 +223,62 @@ func (s *UI) AddCanvas() (*Canvas, error) {
 	return &vv, nil
 }
 
+// AddCanvasAsync - add a new canvas.
+//
+// Allowed game scenes: any.
+func (s *UI) AddCanvasAsync() (*krpcgo.Future[*Canvas], error) {
+	request := &types.ProcedureCall{
+		Procedure: "AddCanvas",
+		Service:   "UI",
+	}
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Canvas, error) {
+		var vv Canvas
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// AddCanvasCall builds a call to AddCanvas without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *UI) AddCanvasCall() (*types.ProcedureCall, error) {
+	request := &types.ProcedureCall{
+		Procedure: "AddCanvas",
+		Service:   "UI",
+	}
+	return request, nil
+}
+
+// DecodeAddCanvas decodes the result of a call built with AddCanvasCall.
+func (s *UI) DecodeAddCanvas(result *types.ProcedureResult) (*Canvas, error) {
+	var vv Canvas
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
 // Message - display a message on the screen.
 //
 // Allowed game scenes: any.
 func (s *UI) Message(content string, duration float32, position MessagePosition, color types.Tuple3[float64, float64, float64], size float32) error {
+	return s.MessageContext(context.Background(), content, duration, position, color, size)
+}
+
+// MessageContext - display a message on the screen.
+//
+// Allowed game scenes: any.
+func (s *UI) MessageContext(ctx context.Context, content string, duration float32, position MessagePosition, color types.Tuple3[float64, float64, float64], size float32) error {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
@@ -266,185 +325,5405 @@ func (s *UI) Message(content string, duration float32, position MessagePosition,
 		Position: uint32(0x4),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// MessageAsync - display a message on the screen.
+//
+// Allowed game scenes: any.
+func (s *UI) MessageAsync(content string, duration float32, position MessagePosition, color types.Tuple3[float64, float64, float64], size float32) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Message",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(content)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(duration)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(position)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x2),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(color)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x3),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(size)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x4),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// MessageCall builds a call to Message without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *UI) MessageCall(content string, duration float32, position MessagePosition, color types.Tuple3[float64, float64, float64], size float32) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Message",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(content)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(duration)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(position)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x2),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(color)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x3),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(size)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x4),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// Clear - remove all user interface elements.
+//
+// Allowed game scenes: any.
+func (s *UI) Clear(clientOnly bool) error {
+	return s.ClearContext(context.Background(), clientOnly)
+}
+
+// ClearContext - remove all user interface elements.
+//
+// Allowed game scenes: any.
+func (s *UI) ClearContext(ctx context.Context, clientOnly bool) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Clear",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(clientOnly)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
 		return tracerr.Wrap(err)
 	}
 	return nil
 }
 
-// Clear - remove all user interface elements.
+// ClearAsync - remove all user interface elements.
+//
+// Allowed game scenes: any.
+func (s *UI) ClearAsync(clientOnly bool) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Clear",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(clientOnly)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// ClearCall builds a call to Clear without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *UI) ClearCall(clientOnly bool) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Clear",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(clientOnly)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// StockCanvas - the stock UI canvas.
+//
+// Allowed game scenes: any.
+func (s *UI) StockCanvas() (*Canvas, error) {
+	return s.StockCanvasContext(context.Background())
+}
+
+// StockCanvasContext - the stock UI canvas.
+//
+// Allowed game scenes: any.
+func (s *UI) StockCanvasContext(ctx context.Context) (*Canvas, error) {
+	var err error
+	var vv Canvas
+	request := &types.ProcedureCall{
+		Procedure: "get_StockCanvas",
+		Service:   "UI",
+	}
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// StockCanvasAsync - the stock UI canvas.
+//
+// Allowed game scenes: any.
+func (s *UI) StockCanvasAsync() (*krpcgo.Future[*Canvas], error) {
+	request := &types.ProcedureCall{
+		Procedure: "get_StockCanvas",
+		Service:   "UI",
+	}
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Canvas, error) {
+		var vv Canvas
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// StockCanvasCall builds a call to StockCanvas without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *UI) StockCanvasCall() (*types.ProcedureCall, error) {
+	request := &types.ProcedureCall{
+		Procedure: "get_StockCanvas",
+		Service:   "UI",
+	}
+	return request, nil
+}
+
+// DecodeStockCanvas decodes the result of a call built with StockCanvasCall.
+func (s *UI) DecodeStockCanvas(result *types.ProcedureResult) (*Canvas, error) {
+	var vv Canvas
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// Remove - remove the UI object.
+//
+// Allowed game scenes: any.
+func (s *Button) Remove() error {
+	return s.RemoveContext(context.Background())
+}
+
+// RemoveContext - remove the UI object.
+//
+// Allowed game scenes: any.
+func (s *Button) RemoveContext(ctx context.Context) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Button_Remove",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// RemoveAsync - remove the UI object.
+//
+// Allowed game scenes: any.
+func (s *Button) RemoveAsync() (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Button_Remove",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// RemoveCall builds a call to Remove without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Button) RemoveCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Button_Remove",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// RectTransform - the rect transform for the text.
+//
+// Allowed game scenes: any.
+func (s *Button) RectTransform() (*RectTransform, error) {
+	return s.RectTransformContext(context.Background())
+}
+
+// RectTransformContext - the rect transform for the text.
+//
+// Allowed game scenes: any.
+func (s *Button) RectTransformContext(ctx context.Context) (*RectTransform, error) {
+	var err error
+	var argBytes []byte
+	var vv RectTransform
+	request := &types.ProcedureCall{
+		Procedure: "Button_get_RectTransform",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// RectTransformAsync - the rect transform for the text.
+//
+// Allowed game scenes: any.
+func (s *Button) RectTransformAsync() (*krpcgo.Future[*RectTransform], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Button_get_RectTransform",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*RectTransform, error) {
+		var vv RectTransform
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// RectTransformCall builds a call to RectTransform without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *Button) RectTransformCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Button_get_RectTransform",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeRectTransform decodes the result of a call built with
+// RectTransformCall.
+func (s *Button) DecodeRectTransform(result *types.ProcedureResult) (*RectTransform, error) {
+	var vv RectTransform
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// Text - the text for the button.
+//
+// Allowed game scenes: any.
+func (s *Button) Text() (*Text, error) {
+	return s.TextContext(context.Background())
+}
+
+// TextContext - the text for the button.
+//
+// Allowed game scenes: any.
+func (s *Button) TextContext(ctx context.Context) (*Text, error) {
+	var err error
+	var argBytes []byte
+	var vv Text
+	request := &types.ProcedureCall{
+		Procedure: "Button_get_Text",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// TextAsync - the text for the button.
+//
+// Allowed game scenes: any.
+func (s *Button) TextAsync() (*krpcgo.Future[*Text], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Button_get_Text",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Text, error) {
+		var vv Text
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// TextCall builds a call to Text without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Button) TextCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Button_get_Text",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeText decodes the result of a call built with TextCall.
+func (s *Button) DecodeText(result *types.ProcedureResult) (*Text, error) {
+	var vv Text
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// Clicked - whether the button has been clicked.
+//
+// Allowed game scenes: any.
+func (s *Button) Clicked() (bool, error) {
+	return s.ClickedContext(context.Background())
+}
+
+// ClickedContext - whether the button has been clicked.
+//
+// Allowed game scenes: any.
+func (s *Button) ClickedContext(ctx context.Context) (bool, error) {
+	var err error
+	var argBytes []byte
+	var vv bool
+	request := &types.ProcedureCall{
+		Procedure: "Button_get_Clicked",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// ClickedAsync - whether the button has been clicked.
+//
+// Allowed game scenes: any.
+func (s *Button) ClickedAsync() (*krpcgo.Future[bool], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Button_get_Clicked",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (bool, error) {
+		var vv bool
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// ClickedStream - whether the button has been clicked.
+//
+// Allowed game scenes: any.
+func (s *Button) ClickedStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[bool], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Button_get_Clicked",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (bool, error) {
+		var value bool
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// ClickedCall builds a call to Clicked without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Button) ClickedCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Button_get_Clicked",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeClicked decodes the result of a call built with ClickedCall.
+func (s *Button) DecodeClicked(result *types.ProcedureResult) (bool, error) {
+	var vv bool
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetClicked - whether the button has been clicked.
+//
+// Allowed game scenes: any.
+func (s *Button) SetClicked(value bool) error {
+	return s.SetClickedContext(context.Background(), value)
+}
+
+// SetClickedContext - whether the button has been clicked.
+//
+// Allowed game scenes: any.
+func (s *Button) SetClickedContext(ctx context.Context, value bool) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Button_set_Clicked",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetClickedAsync - whether the button has been clicked.
+//
+// Allowed game scenes: any.
+func (s *Button) SetClickedAsync(value bool) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Button_set_Clicked",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetClickedCall builds a call to SetClicked without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Button) SetClickedCall(value bool) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Button_set_Clicked",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// Visible - whether the UI object is visible.
+//
+// Allowed game scenes: any.
+func (s *Button) Visible() (bool, error) {
+	return s.VisibleContext(context.Background())
+}
+
+// VisibleContext - whether the UI object is visible.
+//
+// Allowed game scenes: any.
+func (s *Button) VisibleContext(ctx context.Context) (bool, error) {
+	var err error
+	var argBytes []byte
+	var vv bool
+	request := &types.ProcedureCall{
+		Procedure: "Button_get_Visible",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// VisibleAsync - whether the UI object is visible.
+//
+// Allowed game scenes: any.
+func (s *Button) VisibleAsync() (*krpcgo.Future[bool], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Button_get_Visible",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (bool, error) {
+		var vv bool
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// VisibleStream - whether the UI object is visible.
+//
+// Allowed game scenes: any.
+func (s *Button) VisibleStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[bool], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Button_get_Visible",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (bool, error) {
+		var value bool
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// VisibleCall builds a call to Visible without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Button) VisibleCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Button_get_Visible",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeVisible decodes the result of a call built with VisibleCall.
+func (s *Button) DecodeVisible(result *types.ProcedureResult) (bool, error) {
+	var vv bool
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetVisible - whether the UI object is visible.
+//
+// Allowed game scenes: any.
+func (s *Button) SetVisible(value bool) error {
+	return s.SetVisibleContext(context.Background(), value)
+}
+
+// SetVisibleContext - whether the UI object is visible.
+//
+// Allowed game scenes: any.
+func (s *Button) SetVisibleContext(ctx context.Context, value bool) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Button_set_Visible",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetVisibleAsync - whether the UI object is visible.
+//
+// Allowed game scenes: any.
+func (s *Button) SetVisibleAsync(value bool) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Button_set_Visible",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetVisibleCall builds a call to SetVisible without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Button) SetVisibleCall(value bool) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Button_set_Visible",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// AddPanel - create a new container for user interface elements.
+//
+// Allowed game scenes: any.
+func (s *Canvas) AddPanel(visible bool) (*Panel, error) {
+	return s.AddPanelContext(context.Background(), visible)
+}
+
+// AddPanelContext - create a new container for user interface elements.
+//
+// Allowed game scenes: any.
+func (s *Canvas) AddPanelContext(ctx context.Context, visible bool) (*Panel, error) {
+	var err error
+	var argBytes []byte
+	var vv Panel
+	request := &types.ProcedureCall{
+		Procedure: "Canvas_AddPanel",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(visible)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// AddPanelAsync - create a new container for user interface elements.
+//
+// Allowed game scenes: any.
+func (s *Canvas) AddPanelAsync(visible bool) (*krpcgo.Future[*Panel], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Canvas_AddPanel",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(visible)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Panel, error) {
+		var vv Panel
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// AddPanelCall builds a call to AddPanel without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Canvas) AddPanelCall(visible bool) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Canvas_AddPanel",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(visible)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeAddPanel decodes the result of a call built with AddPanelCall.
+func (s *Canvas) DecodeAddPanel(result *types.ProcedureResult) (*Panel, error) {
+	var vv Panel
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// AddText - add text to the canvas.
+//
+// Allowed game scenes: any.
+func (s *Canvas) AddText(content string, visible bool) (*Text, error) {
+	return s.AddTextContext(context.Background(), content, visible)
+}
+
+// AddTextContext - add text to the canvas.
+//
+// Allowed game scenes: any.
+func (s *Canvas) AddTextContext(ctx context.Context, content string, visible bool) (*Text, error) {
+	var err error
+	var argBytes []byte
+	var vv Text
+	request := &types.ProcedureCall{
+		Procedure: "Canvas_AddText",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(content)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(visible)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x2),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// AddTextAsync - add text to the canvas.
+//
+// Allowed game scenes: any.
+func (s *Canvas) AddTextAsync(content string, visible bool) (*krpcgo.Future[*Text], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Canvas_AddText",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(content)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(visible)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x2),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Text, error) {
+		var vv Text
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// AddTextCall builds a call to AddText without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Canvas) AddTextCall(content string, visible bool) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Canvas_AddText",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(content)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(visible)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x2),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeAddText decodes the result of a call built with AddTextCall.
+func (s *Canvas) DecodeAddText(result *types.ProcedureResult) (*Text, error) {
+	var vv Text
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// AddInputField - add an input field to the canvas.
+//
+// Allowed game scenes: any.
+func (s *Canvas) AddInputField(visible bool) (*InputField, error) {
+	return s.AddInputFieldContext(context.Background(), visible)
+}
+
+// AddInputFieldContext - add an input field to the canvas.
+//
+// Allowed game scenes: any.
+func (s *Canvas) AddInputFieldContext(ctx context.Context, visible bool) (*InputField, error) {
+	var err error
+	var argBytes []byte
+	var vv InputField
+	request := &types.ProcedureCall{
+		Procedure: "Canvas_AddInputField",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(visible)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// AddInputFieldAsync - add an input field to the canvas.
+//
+// Allowed game scenes: any.
+func (s *Canvas) AddInputFieldAsync(visible bool) (*krpcgo.Future[*InputField], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Canvas_AddInputField",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(visible)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*InputField, error) {
+		var vv InputField
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// AddInputFieldCall builds a call to AddInputField without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *Canvas) AddInputFieldCall(visible bool) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Canvas_AddInputField",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(visible)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeAddInputField decodes the result of a call built with
+// AddInputFieldCall.
+func (s *Canvas) DecodeAddInputField(result *types.ProcedureResult) (*InputField, error) {
+	var vv InputField
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// AddButton - add a button to the canvas.
+//
+// Allowed game scenes: any.
+func (s *Canvas) AddButton(content string, visible bool) (*Button, error) {
+	return s.AddButtonContext(context.Background(), content, visible)
+}
+
+// AddButtonContext - add a button to the canvas.
+//
+// Allowed game scenes: any.
+func (s *Canvas) AddButtonContext(ctx context.Context, content string, visible bool) (*Button, error) {
+	var err error
+	var argBytes []byte
+	var vv Button
+	request := &types.ProcedureCall{
+		Procedure: "Canvas_AddButton",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(content)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(visible)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x2),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// AddButtonAsync - add a button to the canvas.
+//
+// Allowed game scenes: any.
+func (s *Canvas) AddButtonAsync(content string, visible bool) (*krpcgo.Future[*Button], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Canvas_AddButton",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(content)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(visible)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x2),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Button, error) {
+		var vv Button
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// AddButtonCall builds a call to AddButton without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Canvas) AddButtonCall(content string, visible bool) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Canvas_AddButton",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(content)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(visible)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x2),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeAddButton decodes the result of a call built with AddButtonCall.
+func (s *Canvas) DecodeAddButton(result *types.ProcedureResult) (*Button, error) {
+	var vv Button
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// Remove - remove the UI object.
+//
+// Allowed game scenes: any.
+func (s *Canvas) Remove() error {
+	return s.RemoveContext(context.Background())
+}
+
+// RemoveContext - remove the UI object.
+//
+// Allowed game scenes: any.
+func (s *Canvas) RemoveContext(ctx context.Context) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Canvas_Remove",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// RemoveAsync - remove the UI object.
+//
+// Allowed game scenes: any.
+func (s *Canvas) RemoveAsync() (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Canvas_Remove",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// RemoveCall builds a call to Remove without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Canvas) RemoveCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Canvas_Remove",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// RectTransform - the rect transform for the canvas.
+//
+// Allowed game scenes: any.
+func (s *Canvas) RectTransform() (*RectTransform, error) {
+	return s.RectTransformContext(context.Background())
+}
+
+// RectTransformContext - the rect transform for the canvas.
+//
+// Allowed game scenes: any.
+func (s *Canvas) RectTransformContext(ctx context.Context) (*RectTransform, error) {
+	var err error
+	var argBytes []byte
+	var vv RectTransform
+	request := &types.ProcedureCall{
+		Procedure: "Canvas_get_RectTransform",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// RectTransformAsync - the rect transform for the canvas.
+//
+// Allowed game scenes: any.
+func (s *Canvas) RectTransformAsync() (*krpcgo.Future[*RectTransform], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Canvas_get_RectTransform",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*RectTransform, error) {
+		var vv RectTransform
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// RectTransformCall builds a call to RectTransform without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *Canvas) RectTransformCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Canvas_get_RectTransform",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeRectTransform decodes the result of a call built with
+// RectTransformCall.
+func (s *Canvas) DecodeRectTransform(result *types.ProcedureResult) (*RectTransform, error) {
+	var vv RectTransform
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// Visible - whether the UI object is visible.
+//
+// Allowed game scenes: any.
+func (s *Canvas) Visible() (bool, error) {
+	return s.VisibleContext(context.Background())
+}
+
+// VisibleContext - whether the UI object is visible.
+//
+// Allowed game scenes: any.
+func (s *Canvas) VisibleContext(ctx context.Context) (bool, error) {
+	var err error
+	var argBytes []byte
+	var vv bool
+	request := &types.ProcedureCall{
+		Procedure: "Canvas_get_Visible",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// VisibleAsync - whether the UI object is visible.
+//
+// Allowed game scenes: any.
+func (s *Canvas) VisibleAsync() (*krpcgo.Future[bool], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Canvas_get_Visible",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (bool, error) {
+		var vv bool
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// VisibleStream - whether the UI object is visible.
+//
+// Allowed game scenes: any.
+func (s *Canvas) VisibleStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[bool], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Canvas_get_Visible",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (bool, error) {
+		var value bool
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// VisibleCall builds a call to Visible without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Canvas) VisibleCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Canvas_get_Visible",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeVisible decodes the result of a call built with VisibleCall.
+func (s *Canvas) DecodeVisible(result *types.ProcedureResult) (bool, error) {
+	var vv bool
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetVisible - whether the UI object is visible.
+//
+// Allowed game scenes: any.
+func (s *Canvas) SetVisible(value bool) error {
+	return s.SetVisibleContext(context.Background(), value)
+}
+
+// SetVisibleContext - whether the UI object is visible.
+//
+// Allowed game scenes: any.
+func (s *Canvas) SetVisibleContext(ctx context.Context, value bool) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Canvas_set_Visible",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetVisibleAsync - whether the UI object is visible.
+//
+// Allowed game scenes: any.
+func (s *Canvas) SetVisibleAsync(value bool) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Canvas_set_Visible",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetVisibleCall builds a call to SetVisible without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Canvas) SetVisibleCall(value bool) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Canvas_set_Visible",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// Remove - remove the UI object.
+//
+// Allowed game scenes: any.
+func (s *InputField) Remove() error {
+	return s.RemoveContext(context.Background())
+}
+
+// RemoveContext - remove the UI object.
+//
+// Allowed game scenes: any.
+func (s *InputField) RemoveContext(ctx context.Context) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "InputField_Remove",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// RemoveAsync - remove the UI object.
+//
+// Allowed game scenes: any.
+func (s *InputField) RemoveAsync() (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "InputField_Remove",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// RemoveCall builds a call to Remove without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *InputField) RemoveCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "InputField_Remove",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// RectTransform - the rect transform for the input field.
+//
+// Allowed game scenes: any.
+func (s *InputField) RectTransform() (*RectTransform, error) {
+	return s.RectTransformContext(context.Background())
+}
+
+// RectTransformContext - the rect transform for the input field.
+//
+// Allowed game scenes: any.
+func (s *InputField) RectTransformContext(ctx context.Context) (*RectTransform, error) {
+	var err error
+	var argBytes []byte
+	var vv RectTransform
+	request := &types.ProcedureCall{
+		Procedure: "InputField_get_RectTransform",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// RectTransformAsync - the rect transform for the input field.
+//
+// Allowed game scenes: any.
+func (s *InputField) RectTransformAsync() (*krpcgo.Future[*RectTransform], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "InputField_get_RectTransform",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*RectTransform, error) {
+		var vv RectTransform
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// RectTransformCall builds a call to RectTransform without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *InputField) RectTransformCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "InputField_get_RectTransform",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeRectTransform decodes the result of a call built with
+// RectTransformCall.
+func (s *InputField) DecodeRectTransform(result *types.ProcedureResult) (*RectTransform, error) {
+	var vv RectTransform
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// Value - the value of the input field.
+//
+// Allowed game scenes: any.
+func (s *InputField) Value() (string, error) {
+	return s.ValueContext(context.Background())
+}
+
+// ValueContext - the value of the input field.
+//
+// Allowed game scenes: any.
+func (s *InputField) ValueContext(ctx context.Context) (string, error) {
+	var err error
+	var argBytes []byte
+	var vv string
+	request := &types.ProcedureCall{
+		Procedure: "InputField_get_Value",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// ValueAsync - the value of the input field.
+//
+// Allowed game scenes: any.
+func (s *InputField) ValueAsync() (*krpcgo.Future[string], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "InputField_get_Value",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (string, error) {
+		var vv string
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// ValueStream - the value of the input field.
+//
+// Allowed game scenes: any.
+func (s *InputField) ValueStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[string], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "InputField_get_Value",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (string, error) {
+		var value string
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// ValueCall builds a call to Value without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *InputField) ValueCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "InputField_get_Value",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeValue decodes the result of a call built with ValueCall.
+func (s *InputField) DecodeValue(result *types.ProcedureResult) (string, error) {
+	var vv string
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetValue - the value of the input field.
+//
+// Allowed game scenes: any.
+func (s *InputField) SetValue(value string) error {
+	return s.SetValueContext(context.Background(), value)
+}
+
+// SetValueContext - the value of the input field.
+//
+// Allowed game scenes: any.
+func (s *InputField) SetValueContext(ctx context.Context, value string) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "InputField_set_Value",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetValueAsync - the value of the input field.
+//
+// Allowed game scenes: any.
+func (s *InputField) SetValueAsync(value string) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "InputField_set_Value",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetValueCall builds a call to SetValue without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *InputField) SetValueCall(value string) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "InputField_set_Value",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// Text - the text component of the input field.
+//
+// Allowed game scenes: any.
+func (s *InputField) Text() (*Text, error) {
+	return s.TextContext(context.Background())
+}
+
+// TextContext - the text component of the input field.
+//
+// Allowed game scenes: any.
+func (s *InputField) TextContext(ctx context.Context) (*Text, error) {
+	var err error
+	var argBytes []byte
+	var vv Text
+	request := &types.ProcedureCall{
+		Procedure: "InputField_get_Text",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// TextAsync - the text component of the input field.
+//
+// Allowed game scenes: any.
+func (s *InputField) TextAsync() (*krpcgo.Future[*Text], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "InputField_get_Text",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Text, error) {
+		var vv Text
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// TextCall builds a call to Text without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *InputField) TextCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "InputField_get_Text",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeText decodes the result of a call built with TextCall.
+func (s *InputField) DecodeText(result *types.ProcedureResult) (*Text, error) {
+	var vv Text
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// Changed - whether the input field has been changed.
+//
+// Allowed game scenes: any.
+func (s *InputField) Changed() (bool, error) {
+	return s.ChangedContext(context.Background())
+}
+
+// ChangedContext - whether the input field has been changed.
+//
+// Allowed game scenes: any.
+func (s *InputField) ChangedContext(ctx context.Context) (bool, error) {
+	var err error
+	var argBytes []byte
+	var vv bool
+	request := &types.ProcedureCall{
+		Procedure: "InputField_get_Changed",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// ChangedAsync - whether the input field has been changed.
+//
+// Allowed game scenes: any.
+func (s *InputField) ChangedAsync() (*krpcgo.Future[bool], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "InputField_get_Changed",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (bool, error) {
+		var vv bool
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// ChangedStream - whether the input field has been changed.
+//
+// Allowed game scenes: any.
+func (s *InputField) ChangedStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[bool], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "InputField_get_Changed",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (bool, error) {
+		var value bool
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// ChangedCall builds a call to Changed without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *InputField) ChangedCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "InputField_get_Changed",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeChanged decodes the result of a call built with ChangedCall.
+func (s *InputField) DecodeChanged(result *types.ProcedureResult) (bool, error) {
+	var vv bool
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetChanged - whether the input field has been changed.
+//
+// Allowed game scenes: any.
+func (s *InputField) SetChanged(value bool) error {
+	return s.SetChangedContext(context.Background(), value)
+}
+
+// SetChangedContext - whether the input field has been changed.
+//
+// Allowed game scenes: any.
+func (s *InputField) SetChangedContext(ctx context.Context, value bool) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "InputField_set_Changed",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetChangedAsync - whether the input field has been changed.
+//
+// Allowed game scenes: any.
+func (s *InputField) SetChangedAsync(value bool) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "InputField_set_Changed",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetChangedCall builds a call to SetChanged without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *InputField) SetChangedCall(value bool) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "InputField_set_Changed",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// Visible - whether the UI object is visible.
+//
+// Allowed game scenes: any.
+func (s *InputField) Visible() (bool, error) {
+	return s.VisibleContext(context.Background())
+}
+
+// VisibleContext - whether the UI object is visible.
+//
+// Allowed game scenes: any.
+func (s *InputField) VisibleContext(ctx context.Context) (bool, error) {
+	var err error
+	var argBytes []byte
+	var vv bool
+	request := &types.ProcedureCall{
+		Procedure: "InputField_get_Visible",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// VisibleAsync - whether the UI object is visible.
+//
+// Allowed game scenes: any.
+func (s *InputField) VisibleAsync() (*krpcgo.Future[bool], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "InputField_get_Visible",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (bool, error) {
+		var vv bool
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// VisibleStream - whether the UI object is visible.
+//
+// Allowed game scenes: any.
+func (s *InputField) VisibleStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[bool], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "InputField_get_Visible",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (bool, error) {
+		var value bool
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// VisibleCall builds a call to Visible without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *InputField) VisibleCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "InputField_get_Visible",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeVisible decodes the result of a call built with VisibleCall.
+func (s *InputField) DecodeVisible(result *types.ProcedureResult) (bool, error) {
+	var vv bool
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetVisible - whether the UI object is visible.
+//
+// Allowed game scenes: any.
+func (s *InputField) SetVisible(value bool) error {
+	return s.SetVisibleContext(context.Background(), value)
+}
+
+// SetVisibleContext - whether the UI object is visible.
+//
+// Allowed game scenes: any.
+func (s *InputField) SetVisibleContext(ctx context.Context, value bool) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "InputField_set_Visible",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetVisibleAsync - whether the UI object is visible.
+//
+// Allowed game scenes: any.
+func (s *InputField) SetVisibleAsync(value bool) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "InputField_set_Visible",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetVisibleCall builds a call to SetVisible without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *InputField) SetVisibleCall(value bool) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "InputField_set_Visible",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// AddPanel - create a panel within this panel.
+//
+// Allowed game scenes: any.
+func (s *Panel) AddPanel(visible bool) (*Panel, error) {
+	return s.AddPanelContext(context.Background(), visible)
+}
+
+// AddPanelContext - create a panel within this panel.
+//
+// Allowed game scenes: any.
+func (s *Panel) AddPanelContext(ctx context.Context, visible bool) (*Panel, error) {
+	var err error
+	var argBytes []byte
+	var vv Panel
+	request := &types.ProcedureCall{
+		Procedure: "Panel_AddPanel",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(visible)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// AddPanelAsync - create a panel within this panel.
+//
+// Allowed game scenes: any.
+func (s *Panel) AddPanelAsync(visible bool) (*krpcgo.Future[*Panel], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Panel_AddPanel",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(visible)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Panel, error) {
+		var vv Panel
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// AddPanelCall builds a call to AddPanel without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Panel) AddPanelCall(visible bool) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Panel_AddPanel",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(visible)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeAddPanel decodes the result of a call built with AddPanelCall.
+func (s *Panel) DecodeAddPanel(result *types.ProcedureResult) (*Panel, error) {
+	var vv Panel
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// AddText - add text to the panel.
+//
+// Allowed game scenes: any.
+func (s *Panel) AddText(content string, visible bool) (*Text, error) {
+	return s.AddTextContext(context.Background(), content, visible)
+}
+
+// AddTextContext - add text to the panel.
+//
+// Allowed game scenes: any.
+func (s *Panel) AddTextContext(ctx context.Context, content string, visible bool) (*Text, error) {
+	var err error
+	var argBytes []byte
+	var vv Text
+	request := &types.ProcedureCall{
+		Procedure: "Panel_AddText",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(content)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(visible)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x2),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// AddTextAsync - add text to the panel.
+//
+// Allowed game scenes: any.
+func (s *Panel) AddTextAsync(content string, visible bool) (*krpcgo.Future[*Text], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Panel_AddText",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(content)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(visible)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x2),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Text, error) {
+		var vv Text
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// AddTextCall builds a call to AddText without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Panel) AddTextCall(content string, visible bool) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Panel_AddText",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(content)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(visible)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x2),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeAddText decodes the result of a call built with AddTextCall.
+func (s *Panel) DecodeAddText(result *types.ProcedureResult) (*Text, error) {
+	var vv Text
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// AddInputField - add an input field to the panel.
+//
+// Allowed game scenes: any.
+func (s *Panel) AddInputField(visible bool) (*InputField, error) {
+	return s.AddInputFieldContext(context.Background(), visible)
+}
+
+// AddInputFieldContext - add an input field to the panel.
+//
+// Allowed game scenes: any.
+func (s *Panel) AddInputFieldContext(ctx context.Context, visible bool) (*InputField, error) {
+	var err error
+	var argBytes []byte
+	var vv InputField
+	request := &types.ProcedureCall{
+		Procedure: "Panel_AddInputField",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(visible)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// AddInputFieldAsync - add an input field to the panel.
+//
+// Allowed game scenes: any.
+func (s *Panel) AddInputFieldAsync(visible bool) (*krpcgo.Future[*InputField], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Panel_AddInputField",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(visible)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*InputField, error) {
+		var vv InputField
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// AddInputFieldCall builds a call to AddInputField without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *Panel) AddInputFieldCall(visible bool) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Panel_AddInputField",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(visible)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeAddInputField decodes the result of a call built with
+// AddInputFieldCall.
+func (s *Panel) DecodeAddInputField(result *types.ProcedureResult) (*InputField, error) {
+	var vv InputField
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// AddButton - add a button to the panel.
+//
+// Allowed game scenes: any.
+func (s *Panel) AddButton(content string, visible bool) (*Button, error) {
+	return s.AddButtonContext(context.Background(), content, visible)
+}
+
+// AddButtonContext - add a button to the panel.
+//
+// Allowed game scenes: any.
+func (s *Panel) AddButtonContext(ctx context.Context, content string, visible bool) (*Button, error) {
+	var err error
+	var argBytes []byte
+	var vv Button
+	request := &types.ProcedureCall{
+		Procedure: "Panel_AddButton",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(content)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(visible)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x2),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// AddButtonAsync - add a button to the panel.
+//
+// Allowed game scenes: any.
+func (s *Panel) AddButtonAsync(content string, visible bool) (*krpcgo.Future[*Button], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Panel_AddButton",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(content)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(visible)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x2),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*Button, error) {
+		var vv Button
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// AddButtonCall builds a call to AddButton without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Panel) AddButtonCall(content string, visible bool) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Panel_AddButton",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(content)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(visible)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x2),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeAddButton decodes the result of a call built with AddButtonCall.
+func (s *Panel) DecodeAddButton(result *types.ProcedureResult) (*Button, error) {
+	var vv Button
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// Remove - remove the UI object.
+//
+// Allowed game scenes: any.
+func (s *Panel) Remove() error {
+	return s.RemoveContext(context.Background())
+}
+
+// RemoveContext - remove the UI object.
+//
+// Allowed game scenes: any.
+func (s *Panel) RemoveContext(ctx context.Context) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Panel_Remove",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// RemoveAsync - remove the UI object.
+//
+// Allowed game scenes: any.
+func (s *Panel) RemoveAsync() (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Panel_Remove",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// RemoveCall builds a call to Remove without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Panel) RemoveCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Panel_Remove",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// RectTransform - the rect transform for the panel.
+//
+// Allowed game scenes: any.
+func (s *Panel) RectTransform() (*RectTransform, error) {
+	return s.RectTransformContext(context.Background())
+}
+
+// RectTransformContext - the rect transform for the panel.
+//
+// Allowed game scenes: any.
+func (s *Panel) RectTransformContext(ctx context.Context) (*RectTransform, error) {
+	var err error
+	var argBytes []byte
+	var vv RectTransform
+	request := &types.ProcedureCall{
+		Procedure: "Panel_get_RectTransform",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// RectTransformAsync - the rect transform for the panel.
+//
+// Allowed game scenes: any.
+func (s *Panel) RectTransformAsync() (*krpcgo.Future[*RectTransform], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Panel_get_RectTransform",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*RectTransform, error) {
+		var vv RectTransform
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
+}
+
+// RectTransformCall builds a call to RectTransform without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *Panel) RectTransformCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Panel_get_RectTransform",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeRectTransform decodes the result of a call built with
+// RectTransformCall.
+func (s *Panel) DecodeRectTransform(result *types.ProcedureResult) (*RectTransform, error) {
+	var vv RectTransform
+	if err := krpcgo.ResultError(result); err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return &vv, tracerr.Wrap(err)
+	}
+	vv.Client = s.Client
+	return &vv, nil
+}
+
+// Visible - whether the UI object is visible.
+//
+// Allowed game scenes: any.
+func (s *Panel) Visible() (bool, error) {
+	return s.VisibleContext(context.Background())
+}
+
+// VisibleContext - whether the UI object is visible.
+//
+// Allowed game scenes: any.
+func (s *Panel) VisibleContext(ctx context.Context) (bool, error) {
+	var err error
+	var argBytes []byte
+	var vv bool
+	request := &types.ProcedureCall{
+		Procedure: "Panel_get_Visible",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// VisibleAsync - whether the UI object is visible.
+//
+// Allowed game scenes: any.
+func (s *Panel) VisibleAsync() (*krpcgo.Future[bool], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Panel_get_Visible",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (bool, error) {
+		var vv bool
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// VisibleStream - whether the UI object is visible.
+//
+// Allowed game scenes: any.
+func (s *Panel) VisibleStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[bool], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Panel_get_Visible",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (bool, error) {
+		var value bool
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// VisibleCall builds a call to Visible without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Panel) VisibleCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Panel_get_Visible",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeVisible decodes the result of a call built with VisibleCall.
+func (s *Panel) DecodeVisible(result *types.ProcedureResult) (bool, error) {
+	var vv bool
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetVisible - whether the UI object is visible.
+//
+// Allowed game scenes: any.
+func (s *Panel) SetVisible(value bool) error {
+	return s.SetVisibleContext(context.Background(), value)
+}
+
+// SetVisibleContext - whether the UI object is visible.
+//
+// Allowed game scenes: any.
+func (s *Panel) SetVisibleContext(ctx context.Context, value bool) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Panel_set_Visible",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetVisibleAsync - whether the UI object is visible.
+//
+// Allowed game scenes: any.
+func (s *Panel) SetVisibleAsync(value bool) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Panel_set_Visible",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetVisibleCall builds a call to SetVisible without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Panel) SetVisibleCall(value bool) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Panel_set_Visible",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// Position - position of the rectangles pivot point relative to the anchors.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) Position() (types.Tuple2[float64, float64], error) {
+	return s.PositionContext(context.Background())
+}
+
+// PositionContext - position of the rectangles pivot point relative to the
+// anchors.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) PositionContext(ctx context.Context) (types.Tuple2[float64, float64], error) {
+	var err error
+	var argBytes []byte
+	var vv types.Tuple2[float64, float64]
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_get_Position",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// PositionAsync - position of the rectangles pivot point relative to the
+// anchors.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) PositionAsync() (*krpcgo.Future[types.Tuple2[float64, float64]], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_get_Position",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (types.Tuple2[float64, float64], error) {
+		var vv types.Tuple2[float64, float64]
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// PositionStream - position of the rectangles pivot point relative to the
+// anchors.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) PositionStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[types.Tuple2[float64, float64]], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_get_Position",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (types.Tuple2[float64, float64], error) {
+		var value types.Tuple2[float64, float64]
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// PositionCall builds a call to Position without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *RectTransform) PositionCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_get_Position",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodePosition decodes the result of a call built with PositionCall.
+func (s *RectTransform) DecodePosition(result *types.ProcedureResult) (types.Tuple2[float64, float64], error) {
+	var vv types.Tuple2[float64, float64]
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetPosition - position of the rectangles pivot point relative to the anchors.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) SetPosition(value types.Tuple2[float64, float64]) error {
+	return s.SetPositionContext(context.Background(), value)
+}
+
+// SetPositionContext - position of the rectangles pivot point relative to the
+// anchors.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) SetPositionContext(ctx context.Context, value types.Tuple2[float64, float64]) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_set_Position",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetPositionAsync - position of the rectangles pivot point relative to the
+// anchors.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) SetPositionAsync(value types.Tuple2[float64, float64]) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_set_Position",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetPositionCall builds a call to SetPosition without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *RectTransform) SetPositionCall(value types.Tuple2[float64, float64]) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_set_Position",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// LocalPosition - position of the rectangles pivot point relative to the
+// anchors.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) LocalPosition() (types.Tuple3[float64, float64, float64], error) {
+	return s.LocalPositionContext(context.Background())
+}
+
+// LocalPositionContext - position of the rectangles pivot point relative to the
+// anchors.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) LocalPositionContext(ctx context.Context) (types.Tuple3[float64, float64, float64], error) {
+	var err error
+	var argBytes []byte
+	var vv types.Tuple3[float64, float64, float64]
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_get_LocalPosition",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// LocalPositionAsync - position of the rectangles pivot point relative to the
+// anchors.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) LocalPositionAsync() (*krpcgo.Future[types.Tuple3[float64, float64, float64]], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_get_LocalPosition",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (types.Tuple3[float64, float64, float64], error) {
+		var vv types.Tuple3[float64, float64, float64]
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// LocalPositionStream - position of the rectangles pivot point relative to the
+// anchors.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) LocalPositionStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[types.Tuple3[float64, float64, float64]], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_get_LocalPosition",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (types.Tuple3[float64, float64, float64], error) {
+		var value types.Tuple3[float64, float64, float64]
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// LocalPositionCall builds a call to LocalPosition without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *RectTransform) LocalPositionCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_get_LocalPosition",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeLocalPosition decodes the result of a call built with
+// LocalPositionCall.
+func (s *RectTransform) DecodeLocalPosition(result *types.ProcedureResult) (types.Tuple3[float64, float64, float64], error) {
+	var vv types.Tuple3[float64, float64, float64]
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetLocalPosition - position of the rectangles pivot point relative to the
+// anchors.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) SetLocalPosition(value types.Tuple3[float64, float64, float64]) error {
+	return s.SetLocalPositionContext(context.Background(), value)
+}
+
+// SetLocalPositionContext - position of the rectangles pivot point relative to
+// the anchors.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) SetLocalPositionContext(ctx context.Context, value types.Tuple3[float64, float64, float64]) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_set_LocalPosition",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetLocalPositionAsync - position of the rectangles pivot point relative to
+// the anchors.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) SetLocalPositionAsync(value types.Tuple3[float64, float64, float64]) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_set_LocalPosition",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetLocalPositionCall builds a call to SetLocalPosition without making it,
+// such as to make several calls with CallMultiple, add a stream with AddStream
+// or use it in an expression.
+func (s *RectTransform) SetLocalPositionCall(value types.Tuple3[float64, float64, float64]) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_set_LocalPosition",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// Size - width and height of the rectangle.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) Size() (types.Tuple2[float64, float64], error) {
+	return s.SizeContext(context.Background())
+}
+
+// SizeContext - width and height of the rectangle.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) SizeContext(ctx context.Context) (types.Tuple2[float64, float64], error) {
+	var err error
+	var argBytes []byte
+	var vv types.Tuple2[float64, float64]
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_get_Size",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SizeAsync - width and height of the rectangle.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) SizeAsync() (*krpcgo.Future[types.Tuple2[float64, float64]], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_get_Size",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (types.Tuple2[float64, float64], error) {
+		var vv types.Tuple2[float64, float64]
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// SizeStream - width and height of the rectangle.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) SizeStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[types.Tuple2[float64, float64]], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_get_Size",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (types.Tuple2[float64, float64], error) {
+		var value types.Tuple2[float64, float64]
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// SizeCall builds a call to Size without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *RectTransform) SizeCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_get_Size",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeSize decodes the result of a call built with SizeCall.
+func (s *RectTransform) DecodeSize(result *types.ProcedureResult) (types.Tuple2[float64, float64], error) {
+	var vv types.Tuple2[float64, float64]
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetSize - width and height of the rectangle.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) SetSize(value types.Tuple2[float64, float64]) error {
+	return s.SetSizeContext(context.Background(), value)
+}
+
+// SetSizeContext - width and height of the rectangle.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) SetSizeContext(ctx context.Context, value types.Tuple2[float64, float64]) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_set_Size",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetSizeAsync - width and height of the rectangle.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) SetSizeAsync(value types.Tuple2[float64, float64]) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_set_Size",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetSizeCall builds a call to SetSize without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *RectTransform) SetSizeCall(value types.Tuple2[float64, float64]) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_set_Size",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// UpperRight - position of the rectangles upper right corner relative to the
+// anchors.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) UpperRight() (types.Tuple2[float64, float64], error) {
+	return s.UpperRightContext(context.Background())
+}
+
+// UpperRightContext - position of the rectangles upper right corner relative to
+// the anchors.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) UpperRightContext(ctx context.Context) (types.Tuple2[float64, float64], error) {
+	var err error
+	var argBytes []byte
+	var vv types.Tuple2[float64, float64]
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_get_UpperRight",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// UpperRightAsync - position of the rectangles upper right corner relative to
+// the anchors.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) UpperRightAsync() (*krpcgo.Future[types.Tuple2[float64, float64]], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_get_UpperRight",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (types.Tuple2[float64, float64], error) {
+		var vv types.Tuple2[float64, float64]
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// UpperRightStream - position of the rectangles upper right corner relative to
+// the anchors.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) UpperRightStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[types.Tuple2[float64, float64]], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_get_UpperRight",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (types.Tuple2[float64, float64], error) {
+		var value types.Tuple2[float64, float64]
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// UpperRightCall builds a call to UpperRight without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *RectTransform) UpperRightCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_get_UpperRight",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeUpperRight decodes the result of a call built with UpperRightCall.
+func (s *RectTransform) DecodeUpperRight(result *types.ProcedureResult) (types.Tuple2[float64, float64], error) {
+	var vv types.Tuple2[float64, float64]
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetUpperRight - position of the rectangles upper right corner relative to the
+// anchors.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) SetUpperRight(value types.Tuple2[float64, float64]) error {
+	return s.SetUpperRightContext(context.Background(), value)
+}
+
+// SetUpperRightContext - position of the rectangles upper right corner relative
+// to the anchors.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) SetUpperRightContext(ctx context.Context, value types.Tuple2[float64, float64]) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_set_UpperRight",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetUpperRightAsync - position of the rectangles upper right corner relative
+// to the anchors.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) SetUpperRightAsync(value types.Tuple2[float64, float64]) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_set_UpperRight",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetUpperRightCall builds a call to SetUpperRight without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *RectTransform) SetUpperRightCall(value types.Tuple2[float64, float64]) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_set_UpperRight",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// LowerLeft - position of the rectangles lower left corner relative to the
+// anchors.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) LowerLeft() (types.Tuple2[float64, float64], error) {
+	return s.LowerLeftContext(context.Background())
+}
+
+// LowerLeftContext - position of the rectangles lower left corner relative to
+// the anchors.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) LowerLeftContext(ctx context.Context) (types.Tuple2[float64, float64], error) {
+	var err error
+	var argBytes []byte
+	var vv types.Tuple2[float64, float64]
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_get_LowerLeft",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	result, err := s.Client.CallContext(ctx, request)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// LowerLeftAsync - position of the rectangles lower left corner relative to the
+// anchors.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) LowerLeftAsync() (*krpcgo.Future[types.Tuple2[float64, float64]], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_get_LowerLeft",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (types.Tuple2[float64, float64], error) {
+		var vv types.Tuple2[float64, float64]
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// LowerLeftStream - position of the rectangles lower left corner relative to
+// the anchors.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) LowerLeftStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[types.Tuple2[float64, float64]], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_get_LowerLeft",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (types.Tuple2[float64, float64], error) {
+		var value types.Tuple2[float64, float64]
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// LowerLeftCall builds a call to LowerLeft without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *RectTransform) LowerLeftCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_get_LowerLeft",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeLowerLeft decodes the result of a call built with LowerLeftCall.
+func (s *RectTransform) DecodeLowerLeft(result *types.ProcedureResult) (types.Tuple2[float64, float64], error) {
+	var vv types.Tuple2[float64, float64]
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetLowerLeft - position of the rectangles lower left corner relative to the
+// anchors.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) SetLowerLeft(value types.Tuple2[float64, float64]) error {
+	return s.SetLowerLeftContext(context.Background(), value)
+}
+
+// SetLowerLeftContext - position of the rectangles lower left corner relative
+// to the anchors.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) SetLowerLeftContext(ctx context.Context, value types.Tuple2[float64, float64]) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_set_LowerLeft",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetLowerLeftAsync - position of the rectangles lower left corner relative to
+// the anchors.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) SetLowerLeftAsync(value types.Tuple2[float64, float64]) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_set_LowerLeft",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetLowerLeftCall builds a call to SetLowerLeft without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *RectTransform) SetLowerLeftCall(value types.Tuple2[float64, float64]) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_set_LowerLeft",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// SetAnchor - set the minimum and maximum anchor points as a fraction of the
+// size of the parent rectangle.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) SetAnchor(value types.Tuple2[float64, float64]) error {
+	return s.SetAnchorContext(context.Background(), value)
+}
+
+// SetAnchorContext - set the minimum and maximum anchor points as a fraction of
+// the size of the parent rectangle.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) SetAnchorContext(ctx context.Context, value types.Tuple2[float64, float64]) error {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_set_Anchor",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
+}
+
+// SetAnchorAsync - set the minimum and maximum anchor points as a fraction of
+// the size of the parent rectangle.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) SetAnchorAsync(value types.Tuple2[float64, float64]) (*krpcgo.Future[struct{}], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_set_Anchor",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// SetAnchorCall builds a call to SetAnchor without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *RectTransform) SetAnchorCall(value types.Tuple2[float64, float64]) (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_set_Anchor",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	argBytes, err = encode.Marshal(value)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// AnchorMax - the anchor point for the lower left corner of the rectangle
+// defined as a fraction of the size of the parent rectangle.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) AnchorMax() (types.Tuple2[float64, float64], error) {
+	return s.AnchorMaxContext(context.Background())
+}
+
+// AnchorMaxContext - the anchor point for the lower left corner of the
+// rectangle defined as a fraction of the size of the parent rectangle.
 //
 // Allowed game scenes: any.
-func (s *UI) Clear(clientOnly bool) error {
+func (s *RectTransform) AnchorMaxContext(ctx context.Context) (types.Tuple2[float64, float64], error) {
 	var err error
 	var argBytes []byte
+	var vv types.Tuple2[float64, float64]
 	request := &types.ProcedureCall{
-		Procedure: "Clear",
+		Procedure: "RectTransform_get_AnchorMax",
 		Service:   "UI",
 	}
-	argBytes, err = encode.Marshal(clientOnly)
+	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
-}
-
-// StockCanvas - the stock UI canvas.
-//
-// Allowed game scenes: any.
-func (s *UI) StockCanvas() (*Canvas, error) {
-	var err error
-	var vv Canvas
-	request := &types.ProcedureCall{
-		Procedure: "get_StockCanvas",
-		Service:   "UI",
-	}
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
 	err = encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	vv.Client = s.Client
-	return &vv, nil
+	return vv, nil
 }
 
-// Remove - remove the UI object.
+// AnchorMaxAsync - the anchor point for the lower left corner of the rectangle
+// defined as a fraction of the size of the parent rectangle.
 //
 // Allowed game scenes: any.
-func (s *Button) Remove() error {
+func (s *RectTransform) AnchorMaxAsync() (*krpcgo.Future[types.Tuple2[float64, float64]], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Button_Remove",
+		Procedure: "RectTransform_get_AnchorMax",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (types.Tuple2[float64, float64], error) {
+		var vv types.Tuple2[float64, float64]
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
 }
 
-// RectTransform - the rect transform for the text.
+// AnchorMaxStream - the anchor point for the lower left corner of the rectangle
+// defined as a fraction of the size of the parent rectangle.
 //
 // Allowed game scenes: any.
-func (s *Button) RectTransform() (*RectTransform, error) {
+func (s *RectTransform) AnchorMaxStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[types.Tuple2[float64, float64]], error) {
 	var err error
 	var argBytes []byte
-	var vv RectTransform
 	request := &types.ProcedureCall{
-		Procedure: "Button_get_RectTransform",
+		Procedure: "RectTransform_get_AnchorMax",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	vv.Client = s.Client
-	return &vv, nil
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (types.Tuple2[float64, float64], error) {
+		var value types.Tuple2[float64, float64]
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
 }
 
-// Text - the text for the button.
-//
-// Allowed game scenes: any.
-func (s *Button) Text() (*Text, error) {
+// AnchorMaxCall builds a call to AnchorMax without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *RectTransform) AnchorMaxCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv Text
 	request := &types.ProcedureCall{
-		Procedure: "Button_get_Text",
+		Procedure: "RectTransform_get_AnchorMax",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
+	return request, nil
+}
+
+// DecodeAnchorMax decodes the result of a call built with AnchorMaxCall.
+func (s *RectTransform) DecodeAnchorMax(result *types.ProcedureResult) (types.Tuple2[float64, float64], error) {
+	var vv types.Tuple2[float64, float64]
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	vv.Client = s.Client
-	return &vv, nil
+	return vv, nil
 }
 
-// Clicked - whether the button has been clicked.
+// SetAnchorMax - the anchor point for the lower left corner of the rectangle
+// defined as a fraction of the size of the parent rectangle.
 //
 // Allowed game scenes: any.
-func (s *Button) Clicked() (bool, error) {
+func (s *RectTransform) SetAnchorMax(value types.Tuple2[float64, float64]) error {
+	return s.SetAnchorMaxContext(context.Background(), value)
+}
+
+// SetAnchorMaxContext - the anchor point for the lower left corner of the
+// rectangle defined as a fraction of the size of the parent rectangle.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) SetAnchorMaxContext(ctx context.Context, value types.Tuple2[float64, float64]) error {
 	var err error
 	var argBytes []byte
-	var vv bool
 	request := &types.ProcedureCall{
-		Procedure: "Button_get_Clicked",
+		Procedure: "RectTransform_set_AnchorMax",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	return vv, nil
+	return nil
 }
 
-// ClickedStream - whether the button has been clicked.
+// SetAnchorMaxAsync - the anchor point for the lower left corner of the
+// rectangle defined as a fraction of the size of the parent rectangle.
 //
 // Allowed game scenes: any.
-func (s *Button) ClickedStream() (*krpcgo.Stream[bool], error) {
+func (s *RectTransform) SetAnchorMaxAsync(value types.Tuple2[float64, float64]) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Button_get_Clicked",
+		Procedure: "RectTransform_set_AnchorMax",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -455,36 +5734,33 @@ func (s *Button) ClickedStream() (*krpcgo.Stream[bool], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) bool {
-		var value bool
-		encode.Unmarshal(b, &value)
-		return value
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
 	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetClicked - whether the button has been clicked.
-//
-// Allowed game scenes: any.
-func (s *Button) SetClicked(value bool) error {
+// SetAnchorMaxCall builds a call to SetAnchorMax without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *RectTransform) SetAnchorMaxCall(value types.Tuple2[float64, float64]) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Button_set_Clicked",
+		Procedure: "RectTransform_set_AnchorMax",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
@@ -492,28 +5768,33 @@ func (s *Button) SetClicked(value bool) error {
 	})
 	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return request, nil
 }
 
-// Visible - whether the UI object is visible.
+// AnchorMin - the anchor point for the upper right corner of the rectangle
+// defined as a fraction of the size of the parent rectangle.
 //
 // Allowed game scenes: any.
-func (s *Button) Visible() (bool, error) {
+func (s *RectTransform) AnchorMin() (types.Tuple2[float64, float64], error) {
+	return s.AnchorMinContext(context.Background())
+}
+
+// AnchorMinContext - the anchor point for the upper right corner of the
+// rectangle defined as a fraction of the size of the parent rectangle.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) AnchorMinContext(ctx context.Context) (types.Tuple2[float64, float64], error) {
 	var err error
 	var argBytes []byte
-	var vv bool
+	var vv types.Tuple2[float64, float64]
 	request := &types.ProcedureCall{
-		Procedure: "Button_get_Visible",
+		Procedure: "RectTransform_get_AnchorMin",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -524,7 +5805,7 @@ func (s *Button) Visible() (bool, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -535,14 +5816,15 @@ func (s *Button) Visible() (bool, error) {
 	return vv, nil
 }
 
-// VisibleStream - whether the UI object is visible.
+// AnchorMinAsync - the anchor point for the upper right corner of the rectangle
+// defined as a fraction of the size of the parent rectangle.
 //
 // Allowed game scenes: any.
-func (s *Button) VisibleStream() (*krpcgo.Stream[bool], error) {
+func (s *RectTransform) AnchorMinAsync() (*krpcgo.Future[types.Tuple2[float64, float64]], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Button_get_Visible",
+		Procedure: "RectTransform_get_AnchorMin",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -553,31 +5835,99 @@ func (s *Button) VisibleStream() (*krpcgo.Stream[bool], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (types.Tuple2[float64, float64], error) {
+		var vv types.Tuple2[float64, float64]
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// AnchorMinStream - the anchor point for the upper right corner of the
+// rectangle defined as a fraction of the size of the parent rectangle.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) AnchorMinStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[types.Tuple2[float64, float64]], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_get_AnchorMin",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) bool {
-		var value bool
-		encode.Unmarshal(b, &value)
-		return value
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
 	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	rawStream, err := s.Client.AddStream(request, opts...)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (types.Tuple2[float64, float64], error) {
+		var value types.Tuple2[float64, float64]
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
 	return stream, nil
 }
 
-// SetVisible - whether the UI object is visible.
+// AnchorMinCall builds a call to AnchorMin without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *RectTransform) AnchorMinCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_get_AnchorMin",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
+	}
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	return request, nil
+}
+
+// DecodeAnchorMin decodes the result of a call built with AnchorMinCall.
+func (s *RectTransform) DecodeAnchorMin(result *types.ProcedureResult) (types.Tuple2[float64, float64], error) {
+	var vv types.Tuple2[float64, float64]
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
+}
+
+// SetAnchorMin - the anchor point for the upper right corner of the rectangle
+// defined as a fraction of the size of the parent rectangle.
 //
 // Allowed game scenes: any.
-func (s *Button) SetVisible(value bool) error {
+func (s *RectTransform) SetAnchorMin(value types.Tuple2[float64, float64]) error {
+	return s.SetAnchorMinContext(context.Background(), value)
+}
+
+// SetAnchorMinContext - the anchor point for the upper right corner of the
+// rectangle defined as a fraction of the size of the parent rectangle.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) SetAnchorMinContext(ctx context.Context, value types.Tuple2[float64, float64]) error {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Button_set_Visible",
+		Procedure: "RectTransform_set_AnchorMin",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -596,279 +5946,260 @@ func (s *Button) SetVisible(value bool) error {
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
 		return tracerr.Wrap(err)
 	}
 	return nil
 }
 
-// AddPanel - create a new container for user interface elements.
+// SetAnchorMinAsync - the anchor point for the upper right corner of the
+// rectangle defined as a fraction of the size of the parent rectangle.
 //
 // Allowed game scenes: any.
-func (s *Canvas) AddPanel(visible bool) (*Panel, error) {
+func (s *RectTransform) SetAnchorMinAsync(value types.Tuple2[float64, float64]) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
-	var vv Panel
 	request := &types.ProcedureCall{
-		Procedure: "Canvas_AddPanel",
+		Procedure: "RectTransform_set_AnchorMin",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(visible)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	vv.Client = s.Client
-	return &vv, nil
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
 }
 
-// AddText - add text to the canvas.
-//
-// Allowed game scenes: any.
-func (s *Canvas) AddText(content string, visible bool) (*Text, error) {
+// SetAnchorMinCall builds a call to SetAnchorMin without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *RectTransform) SetAnchorMinCall(value types.Tuple2[float64, float64]) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv Text
 	request := &types.ProcedureCall{
-		Procedure: "Canvas_AddText",
+		Procedure: "RectTransform_set_AnchorMin",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(content)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
-	})
-	argBytes, err = encode.Marshal(visible)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x2),
+		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	vv.Client = s.Client
-	return &vv, nil
+	return request, nil
 }
 
-// AddInputField - add an input field to the canvas.
+// Pivot - location of the pivot point around which the rectangle rotates,
+// defined as a fraction of the size of the rectangle itself.
 //
 // Allowed game scenes: any.
-func (s *Canvas) AddInputField(visible bool) (*InputField, error) {
+func (s *RectTransform) Pivot() (types.Tuple2[float64, float64], error) {
+	return s.PivotContext(context.Background())
+}
+
+// PivotContext - location of the pivot point around which the rectangle
+// rotates, defined as a fraction of the size of the rectangle itself.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) PivotContext(ctx context.Context) (types.Tuple2[float64, float64], error) {
 	var err error
 	var argBytes []byte
-	var vv InputField
+	var vv types.Tuple2[float64, float64]
 	request := &types.ProcedureCall{
-		Procedure: "Canvas_AddInputField",
+		Procedure: "RectTransform_get_Pivot",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(visible)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
-	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
 	err = encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	vv.Client = s.Client
-	return &vv, nil
+	return vv, nil
 }
 
-// AddButton - add a button to the canvas.
+// PivotAsync - location of the pivot point around which the rectangle rotates,
+// defined as a fraction of the size of the rectangle itself.
 //
 // Allowed game scenes: any.
-func (s *Canvas) AddButton(content string, visible bool) (*Button, error) {
+func (s *RectTransform) PivotAsync() (*krpcgo.Future[types.Tuple2[float64, float64]], error) {
 	var err error
 	var argBytes []byte
-	var vv Button
 	request := &types.ProcedureCall{
-		Procedure: "Canvas_AddButton",
+		Procedure: "RectTransform_get_Pivot",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(content)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
-	})
-	argBytes, err = encode.Marshal(visible)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x2),
-		Value:    argBytes,
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (types.Tuple2[float64, float64], error) {
+		var vv types.Tuple2[float64, float64]
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	vv.Client = s.Client
-	return &vv, nil
+	return future, nil
 }
 
-// Remove - remove the UI object.
+// PivotStream - location of the pivot point around which the rectangle rotates,
+// defined as a fraction of the size of the rectangle itself.
 //
 // Allowed game scenes: any.
-func (s *Canvas) Remove() error {
+func (s *RectTransform) PivotStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[types.Tuple2[float64, float64]], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Canvas_Remove",
+		Procedure: "RectTransform_get_Pivot",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	return nil
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (types.Tuple2[float64, float64], error) {
+		var value types.Tuple2[float64, float64]
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
 }
 
-// RectTransform - the rect transform for the canvas.
-//
-// Allowed game scenes: any.
-func (s *Canvas) RectTransform() (*RectTransform, error) {
+// PivotCall builds a call to Pivot without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *RectTransform) PivotCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv RectTransform
 	request := &types.ProcedureCall{
-		Procedure: "Canvas_get_RectTransform",
+		Procedure: "RectTransform_get_Pivot",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
+	return request, nil
+}
+
+// DecodePivot decodes the result of a call built with PivotCall.
+func (s *RectTransform) DecodePivot(result *types.ProcedureResult) (types.Tuple2[float64, float64], error) {
+	var vv types.Tuple2[float64, float64]
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	vv.Client = s.Client
-	return &vv, nil
+	return vv, nil
 }
 
-// Visible - whether the UI object is visible.
+// SetPivot - location of the pivot point around which the rectangle rotates,
+// defined as a fraction of the size of the rectangle itself.
 //
 // Allowed game scenes: any.
-func (s *Canvas) Visible() (bool, error) {
+func (s *RectTransform) SetPivot(value types.Tuple2[float64, float64]) error {
+	return s.SetPivotContext(context.Background(), value)
+}
+
+// SetPivotContext - location of the pivot point around which the rectangle
+// rotates, defined as a fraction of the size of the rectangle itself.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) SetPivotContext(ctx context.Context, value types.Tuple2[float64, float64]) error {
 	var err error
 	var argBytes []byte
-	var vv bool
 	request := &types.ProcedureCall{
-		Procedure: "Canvas_get_Visible",
+		Procedure: "RectTransform_set_Pivot",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	return vv, nil
+	return nil
 }
 
-// VisibleStream - whether the UI object is visible.
+// SetPivotAsync - location of the pivot point around which the rectangle
+// rotates, defined as a fraction of the size of the rectangle itself.
 //
 // Allowed game scenes: any.
-func (s *Canvas) VisibleStream() (*krpcgo.Stream[bool], error) {
+func (s *RectTransform) SetPivotAsync(value types.Tuple2[float64, float64]) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Canvas_get_Visible",
+		Procedure: "RectTransform_set_Pivot",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -879,36 +6210,33 @@ func (s *Canvas) VisibleStream() (*krpcgo.Stream[bool], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) bool {
-		var value bool
-		encode.Unmarshal(b, &value)
-		return value
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
 	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetVisible - whether the UI object is visible.
-//
-// Allowed game scenes: any.
-func (s *Canvas) SetVisible(value bool) error {
+// SetPivotCall builds a call to SetPivot without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *RectTransform) SetPivotCall(value types.Tuple2[float64, float64]) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Canvas_set_Visible",
+		Procedure: "RectTransform_set_Pivot",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
@@ -916,153 +6244,204 @@ func (s *Canvas) SetVisible(value bool) error {
 	})
 	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return request, nil
 }
 
-// Remove - remove the UI object.
+// Rotation - rotation, as a quaternion, of the object around its pivot point.
 //
 // Allowed game scenes: any.
-func (s *InputField) Remove() error {
+func (s *RectTransform) Rotation() (types.Tuple4[float64, float64, float64, float64], error) {
+	return s.RotationContext(context.Background())
+}
+
+// RotationContext - rotation, as a quaternion, of the object around its pivot
+// point.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) RotationContext(ctx context.Context) (types.Tuple4[float64, float64, float64, float64], error) {
 	var err error
 	var argBytes []byte
+	var vv types.Tuple4[float64, float64, float64, float64]
 	request := &types.ProcedureCall{
-		Procedure: "InputField_Remove",
+		Procedure: "RectTransform_get_Rotation",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	return nil
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
 }
 
-// RectTransform - the rect transform for the input field.
+// RotationAsync - rotation, as a quaternion, of the object around its pivot
+// point.
 //
 // Allowed game scenes: any.
-func (s *InputField) RectTransform() (*RectTransform, error) {
+func (s *RectTransform) RotationAsync() (*krpcgo.Future[types.Tuple4[float64, float64, float64, float64]], error) {
 	var err error
 	var argBytes []byte
-	var vv RectTransform
 	request := &types.ProcedureCall{
-		Procedure: "InputField_get_RectTransform",
+		Procedure: "RectTransform_get_Rotation",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (types.Tuple4[float64, float64, float64, float64], error) {
+		var vv types.Tuple4[float64, float64, float64, float64]
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
+}
+
+// RotationStream - rotation, as a quaternion, of the object around its pivot
+// point.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) RotationStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[types.Tuple4[float64, float64, float64, float64]], error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "RectTransform_get_Rotation",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x0),
+		Value:    argBytes,
+	})
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	vv.Client = s.Client
-	return &vv, nil
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (types.Tuple4[float64, float64, float64, float64], error) {
+		var value types.Tuple4[float64, float64, float64, float64]
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
 }
 
-// Value - the value of the input field.
-//
-// Allowed game scenes: any.
-func (s *InputField) Value() (string, error) {
+// RotationCall builds a call to Rotation without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *RectTransform) RotationCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv string
 	request := &types.ProcedureCall{
-		Procedure: "InputField_get_Value",
+		Procedure: "RectTransform_get_Rotation",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
+	return request, nil
+}
+
+// DecodeRotation decodes the result of a call built with RotationCall.
+func (s *RectTransform) DecodeRotation(result *types.ProcedureResult) (types.Tuple4[float64, float64, float64, float64], error) {
+	var vv types.Tuple4[float64, float64, float64, float64]
+	if err := krpcgo.ResultError(result); err != nil {
 		return vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
 	return vv, nil
 }
 
-// ValueStream - the value of the input field.
+// SetRotation - rotation, as a quaternion, of the object around its pivot
+// point.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) SetRotation(value types.Tuple4[float64, float64, float64, float64]) error {
+	return s.SetRotationContext(context.Background(), value)
+}
+
+// SetRotationContext - rotation, as a quaternion, of the object around its
+// pivot point.
 //
 // Allowed game scenes: any.
-func (s *InputField) ValueStream() (*krpcgo.Stream[string], error) {
+func (s *RectTransform) SetRotationContext(ctx context.Context, value types.Tuple4[float64, float64, float64, float64]) error {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "InputField_get_Value",
+		Procedure: "RectTransform_set_Rotation",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return nil, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return nil, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) string {
-		var value string
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
 	})
-	return stream, nil
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
 }
 
-// SetValue - the value of the input field.
+// SetRotationAsync - rotation, as a quaternion, of the object around its pivot
+// point.
 //
 // Allowed game scenes: any.
-func (s *InputField) SetValue(value string) error {
+func (s *RectTransform) SetRotationAsync(value types.Tuple4[float64, float64, float64, float64]) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "InputField_set_Value",
+		Procedure: "RectTransform_set_Rotation",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
@@ -1070,59 +6449,64 @@ func (s *InputField) SetValue(value string) error {
 	})
 	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
 }
 
-// Text - the text component of the input field.
-//
-// Allowed game scenes: any.
-func (s *InputField) Text() (*Text, error) {
+// SetRotationCall builds a call to SetRotation without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *RectTransform) SetRotationCall(value types.Tuple4[float64, float64, float64, float64]) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv Text
 	request := &types.ProcedureCall{
-		Procedure: "InputField_get_Text",
+		Procedure: "RectTransform_set_Rotation",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	vv.Client = s.Client
-	return &vv, nil
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	return request, nil
 }
 
-// Changed - whether the input field has been changed.
+// Scale - scale factor applied to the object in the x, y and z dimensions.
 //
 // Allowed game scenes: any.
-func (s *InputField) Changed() (bool, error) {
+func (s *RectTransform) Scale() (types.Tuple3[float64, float64, float64], error) {
+	return s.ScaleContext(context.Background())
+}
+
+// ScaleContext - scale factor applied to the object in the x, y and z
+// dimensions.
+//
+// Allowed game scenes: any.
+func (s *RectTransform) ScaleContext(ctx context.Context) (types.Tuple3[float64, float64, float64], error) {
 	var err error
 	var argBytes []byte
-	var vv bool
+	var vv types.Tuple3[float64, float64, float64]
 	request := &types.ProcedureCall{
-		Procedure: "InputField_get_Changed",
+		Procedure: "RectTransform_get_Scale",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1133,7 +6517,7 @@ func (s *InputField) Changed() (bool, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -1144,14 +6528,14 @@ func (s *InputField) Changed() (bool, error) {
 	return vv, nil
 }
 
-// ChangedStream - whether the input field has been changed.
+// ScaleAsync - scale factor applied to the object in the x, y and z dimensions.
 //
 // Allowed game scenes: any.
-func (s *InputField) ChangedStream() (*krpcgo.Stream[bool], error) {
+func (s *RectTransform) ScaleAsync() (*krpcgo.Future[types.Tuple3[float64, float64, float64]], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "InputField_get_Changed",
+		Procedure: "RectTransform_get_Scale",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1162,129 +6546,98 @@ func (s *InputField) ChangedStream() (*krpcgo.Stream[bool], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) bool {
-		var value bool
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (types.Tuple3[float64, float64, float64], error) {
+		var vv types.Tuple3[float64, float64, float64]
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetChanged - whether the input field has been changed.
+// ScaleStream - scale factor applied to the object in the x, y and z
+// dimensions.
 //
 // Allowed game scenes: any.
-func (s *InputField) SetChanged(value bool) error {
+func (s *RectTransform) ScaleStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[types.Tuple3[float64, float64, float64]], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "InputField_set_Changed",
+		Procedure: "RectTransform_get_Scale",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (types.Tuple3[float64, float64, float64], error) {
+		var value types.Tuple3[float64, float64, float64]
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return stream, nil
 }
 
-// Visible - whether the UI object is visible.
-//
-// Allowed game scenes: any.
-func (s *InputField) Visible() (bool, error) {
+// ScaleCall builds a call to Scale without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *RectTransform) ScaleCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv bool
 	request := &types.ProcedureCall{
-		Procedure: "InputField_get_Visible",
+		Procedure: "RectTransform_get_Scale",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
+	return request, nil
+}
+
+// DecodeScale decodes the result of a call built with ScaleCall.
+func (s *RectTransform) DecodeScale(result *types.ProcedureResult) (types.Tuple3[float64, float64, float64], error) {
+	var vv types.Tuple3[float64, float64, float64]
+	if err := krpcgo.ResultError(result); err != nil {
 		return vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
 	return vv, nil
 }
 
-// VisibleStream - whether the UI object is visible.
+// SetScale - scale factor applied to the object in the x, y and z dimensions.
 //
 // Allowed game scenes: any.
-func (s *InputField) VisibleStream() (*krpcgo.Stream[bool], error) {
-	var err error
-	var argBytes []byte
-	request := &types.ProcedureCall{
-		Procedure: "InputField_get_Visible",
-		Service:   "UI",
-	}
-	argBytes, err = encode.Marshal(s)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x0),
-		Value:    argBytes,
-	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) bool {
-		var value bool
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
-	})
-	return stream, nil
+func (s *RectTransform) SetScale(value types.Tuple3[float64, float64, float64]) error {
+	return s.SetScaleContext(context.Background(), value)
 }
 
-// SetVisible - whether the UI object is visible.
+// SetScaleContext - scale factor applied to the object in the x, y and z
+// dimensions.
 //
 // Allowed game scenes: any.
-func (s *InputField) SetVisible(value bool) error {
+func (s *RectTransform) SetScaleContext(ctx context.Context, value types.Tuple3[float64, float64, float64]) error {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "InputField_set_Visible",
+		Procedure: "RectTransform_set_Scale",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1303,174 +6656,179 @@ func (s *InputField) SetVisible(value bool) error {
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
 		return tracerr.Wrap(err)
 	}
 	return nil
 }
 
-// AddPanel - create a panel within this panel.
+// SetScaleAsync - scale factor applied to the object in the x, y and z
+// dimensions.
 //
 // Allowed game scenes: any.
-func (s *Panel) AddPanel(visible bool) (*Panel, error) {
+func (s *RectTransform) SetScaleAsync(value types.Tuple3[float64, float64, float64]) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
-	var vv Panel
 	request := &types.ProcedureCall{
-		Procedure: "Panel_AddPanel",
+		Procedure: "RectTransform_set_Scale",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(visible)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	vv.Client = s.Client
-	return &vv, nil
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
 }
 
-// AddText - add text to the panel.
-//
-// Allowed game scenes: any.
-func (s *Panel) AddText(content string, visible bool) (*Text, error) {
+// SetScaleCall builds a call to SetScale without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *RectTransform) SetScaleCall(value types.Tuple3[float64, float64, float64]) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv Text
 	request := &types.ProcedureCall{
-		Procedure: "Panel_AddText",
+		Procedure: "RectTransform_set_Scale",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(content)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(visible)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x2),
-		Value:    argBytes,
-	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	vv.Client = s.Client
-	return &vv, nil
+	return request, nil
 }
 
-// AddInputField - add an input field to the panel.
+// Remove - remove the UI object.
 //
 // Allowed game scenes: any.
-func (s *Panel) AddInputField(visible bool) (*InputField, error) {
+func (s *Text) Remove() error {
+	return s.RemoveContext(context.Background())
+}
+
+// RemoveContext - remove the UI object.
+//
+// Allowed game scenes: any.
+func (s *Text) RemoveContext(ctx context.Context) error {
 	var err error
 	var argBytes []byte
-	var vv InputField
 	request := &types.ProcedureCall{
-		Procedure: "Panel_AddInputField",
+		Procedure: "Text_Remove",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(visible)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
-	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return &vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	vv.Client = s.Client
-	return &vv, nil
+	return nil
 }
 
-// AddButton - add a button to the panel.
+// RemoveAsync - remove the UI object.
 //
 // Allowed game scenes: any.
-func (s *Panel) AddButton(content string, visible bool) (*Button, error) {
+func (s *Text) RemoveAsync() (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
-	var vv Button
 	request := &types.ProcedureCall{
-		Procedure: "Panel_AddButton",
+		Procedure: "Text_Remove",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(content)
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
+}
+
+// RemoveCall builds a call to Remove without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Text) RemoveCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Text_Remove",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
+		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(visible)
+	return request, nil
+}
+
+// RectTransform - the rect transform for the text.
+//
+// Allowed game scenes: any.
+func (s *Text) RectTransform() (*RectTransform, error) {
+	return s.RectTransformContext(context.Background())
+}
+
+// RectTransformContext - the rect transform for the text.
+//
+// Allowed game scenes: any.
+func (s *Text) RectTransformContext(ctx context.Context) (*RectTransform, error) {
+	var err error
+	var argBytes []byte
+	var vv RectTransform
+	request := &types.ProcedureCall{
+		Procedure: "Text_get_RectTransform",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x2),
+		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -1482,55 +6840,65 @@ func (s *Panel) AddButton(content string, visible bool) (*Button, error) {
 	return &vv, nil
 }
 
-// Remove - remove the UI object.
+// RectTransformAsync - the rect transform for the text.
 //
 // Allowed game scenes: any.
-func (s *Panel) Remove() error {
+func (s *Text) RectTransformAsync() (*krpcgo.Future[*RectTransform], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Panel_Remove",
+		Procedure: "Text_get_RectTransform",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (*RectTransform, error) {
+		var vv RectTransform
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return &vv, tracerr.Wrap(err)
+		}
+		vv.Client = s.Client
+		return &vv, nil
+	})
+	return future, nil
 }
 
-// RectTransform - the rect transform for the panel.
-//
-// Allowed game scenes: any.
-func (s *Panel) RectTransform() (*RectTransform, error) {
+// RectTransformCall builds a call to RectTransform without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *Text) RectTransformCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv RectTransform
 	request := &types.ProcedureCall{
-		Procedure: "Panel_get_RectTransform",
+		Procedure: "Text_get_RectTransform",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
+	return request, nil
+}
+
+// DecodeRectTransform decodes the result of a call built with
+// RectTransformCall.
+func (s *Text) DecodeRectTransform(result *types.ProcedureResult) (*RectTransform, error) {
+	var vv RectTransform
+	if err := krpcgo.ResultError(result); err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return &vv, tracerr.Wrap(err)
 	}
@@ -1538,15 +6906,22 @@ func (s *Panel) RectTransform() (*RectTransform, error) {
 	return &vv, nil
 }
 
-// Visible - whether the UI object is visible.
+// AvailableFonts - a list of all available fonts.
 //
 // Allowed game scenes: any.
-func (s *Panel) Visible() (bool, error) {
+func (s *Text) AvailableFonts() ([]string, error) {
+	return s.AvailableFontsContext(context.Background())
+}
+
+// AvailableFontsContext - a list of all available fonts.
+//
+// Allowed game scenes: any.
+func (s *Text) AvailableFontsContext(ctx context.Context) ([]string, error) {
 	var err error
 	var argBytes []byte
-	var vv bool
+	var vv []string
 	request := &types.ProcedureCall{
-		Procedure: "Panel_get_Visible",
+		Procedure: "Text_get_AvailableFonts",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1557,7 +6932,7 @@ func (s *Panel) Visible() (bool, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -1568,14 +6943,14 @@ func (s *Panel) Visible() (bool, error) {
 	return vv, nil
 }
 
-// VisibleStream - whether the UI object is visible.
+// AvailableFontsAsync - a list of all available fonts.
 //
 // Allowed game scenes: any.
-func (s *Panel) VisibleStream() (*krpcgo.Stream[bool], error) {
+func (s *Text) AvailableFontsAsync() (*krpcgo.Future[[]string], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Panel_get_Visible",
+		Procedure: "Text_get_AvailableFonts",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1586,195 +6961,186 @@ func (s *Panel) VisibleStream() (*krpcgo.Stream[bool], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) bool {
-		var value bool
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) ([]string, error) {
+		var vv []string
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetVisible - whether the UI object is visible.
+// AvailableFontsStream - a list of all available fonts.
 //
 // Allowed game scenes: any.
-func (s *Panel) SetVisible(value bool) error {
+func (s *Text) AvailableFontsStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[[]string], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Panel_set_Visible",
+		Procedure: "Text_get_AvailableFonts",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) ([]string, error) {
+		var value []string
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return stream, nil
 }
 
-// Position - position of the rectangles pivot point relative to the anchors.
-//
-// Allowed game scenes: any.
-func (s *RectTransform) Position() (types.Tuple2[float64, float64], error) {
+// AvailableFontsCall builds a call to AvailableFonts without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *Text) AvailableFontsCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv types.Tuple2[float64, float64]
 	request := &types.ProcedureCall{
-		Procedure: "RectTransform_get_Position",
+		Procedure: "Text_get_AvailableFonts",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
+	return request, nil
+}
+
+// DecodeAvailableFonts decodes the result of a call built with
+// AvailableFontsCall.
+func (s *Text) DecodeAvailableFonts(result *types.ProcedureResult) ([]string, error) {
+	var vv []string
+	if err := krpcgo.ResultError(result); err != nil {
 		return vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
 	return vv, nil
 }
 
-// PositionStream - position of the rectangles pivot point relative to the
-// anchors.
+// Content - the text string
 //
 // Allowed game scenes: any.
-func (s *RectTransform) PositionStream() (*krpcgo.Stream[types.Tuple2[float64, float64]], error) {
+func (s *Text) Content() (string, error) {
+	return s.ContentContext(context.Background())
+}
+
+// ContentContext - the text string
+//
+// Allowed game scenes: any.
+func (s *Text) ContentContext(ctx context.Context) (string, error) {
 	var err error
 	var argBytes []byte
+	var vv string
 	request := &types.ProcedureCall{
-		Procedure: "RectTransform_get_Position",
+		Procedure: "Text_get_Content",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return nil, tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
-		return nil, tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) types.Tuple2[float64, float64] {
-		var value types.Tuple2[float64, float64]
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
-	})
-	return stream, nil
+	err = encode.Unmarshal(result.Value, &vv)
+	if err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	return vv, nil
 }
 
-// SetPosition - position of the rectangles pivot point relative to the anchors.
+// ContentAsync - the text string
 //
 // Allowed game scenes: any.
-func (s *RectTransform) SetPosition(value types.Tuple2[float64, float64]) error {
+func (s *Text) ContentAsync() (*krpcgo.Future[string], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "RectTransform_set_Position",
+		Procedure: "Text_get_Content",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (string, error) {
+		var vv string
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return future, nil
 }
 
-// LocalPosition - position of the rectangles pivot point relative to the
-// anchors.
+// ContentStream - the text string
 //
 // Allowed game scenes: any.
-func (s *RectTransform) LocalPosition() (types.Tuple3[float64, float64, float64], error) {
+func (s *Text) ContentStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[string], error) {
 	var err error
 	var argBytes []byte
-	var vv types.Tuple3[float64, float64, float64]
 	request := &types.ProcedureCall{
-		Procedure: "RectTransform_get_LocalPosition",
+		Procedure: "Text_get_Content",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	return vv, nil
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (string, error) {
+		var value string
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
 }
 
-// LocalPositionStream - position of the rectangles pivot point relative to the
-// anchors.
-//
-// Allowed game scenes: any.
-func (s *RectTransform) LocalPositionStream() (*krpcgo.Stream[types.Tuple3[float64, float64, float64]], error) {
+// ContentCall builds a call to Content without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Text) ContentCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "RectTransform_get_LocalPosition",
+		Procedure: "Text_get_Content",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1785,32 +7151,37 @@ func (s *RectTransform) LocalPositionStream() (*krpcgo.Stream[types.Tuple3[float
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	return request, nil
+}
+
+// DecodeContent decodes the result of a call built with ContentCall.
+func (s *Text) DecodeContent(result *types.ProcedureResult) (string, error) {
+	var vv string
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return nil, tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) types.Tuple3[float64, float64, float64] {
-		var value types.Tuple3[float64, float64, float64]
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
-	})
-	return stream, nil
+	return vv, nil
 }
 
-// SetLocalPosition - position of the rectangles pivot point relative to the
-// anchors.
+// SetContent - the text string
 //
 // Allowed game scenes: any.
-func (s *RectTransform) SetLocalPosition(value types.Tuple3[float64, float64, float64]) error {
+func (s *Text) SetContent(value string) error {
+	return s.SetContentContext(context.Background(), value)
+}
+
+// SetContentContext - the text string
+//
+// Allowed game scenes: any.
+func (s *Text) SetContentContext(ctx context.Context, value string) error {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "RectTransform_set_LocalPosition",
+		Procedure: "Text_set_Content",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1829,91 +7200,58 @@ func (s *RectTransform) SetLocalPosition(value types.Tuple3[float64, float64, fl
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
 		return tracerr.Wrap(err)
 	}
 	return nil
 }
 
-// Size - width and height of the rectangle.
+// SetContentAsync - the text string
 //
 // Allowed game scenes: any.
-func (s *RectTransform) Size() (types.Tuple2[float64, float64], error) {
+func (s *Text) SetContentAsync(value string) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
-	var vv types.Tuple2[float64, float64]
 	request := &types.ProcedureCall{
-		Procedure: "RectTransform_get_Size",
+		Procedure: "Text_set_Content",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	return vv, nil
-}
-
-// SizeStream - width and height of the rectangle.
-//
-// Allowed game scenes: any.
-func (s *RectTransform) SizeStream() (*krpcgo.Stream[types.Tuple2[float64, float64]], error) {
-	var err error
-	var argBytes []byte
-	request := &types.ProcedureCall{
-		Procedure: "RectTransform_get_Size",
-		Service:   "UI",
-	}
-	argBytes, err = encode.Marshal(s)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x0),
+		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) types.Tuple2[float64, float64] {
-		var value types.Tuple2[float64, float64]
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetSize - width and height of the rectangle.
-//
-// Allowed game scenes: any.
-func (s *RectTransform) SetSize(value types.Tuple2[float64, float64]) error {
+// SetContentCall builds a call to SetContent without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Text) SetContentCall(value string) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "RectTransform_set_Size",
+		Procedure: "Text_set_Content",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
@@ -1921,29 +7259,31 @@ func (s *RectTransform) SetSize(value types.Tuple2[float64, float64]) error {
 	})
 	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return request, nil
 }
 
-// UpperRight - position of the rectangles upper right corner relative to the
-// anchors.
+// Font - name of the font
 //
 // Allowed game scenes: any.
-func (s *RectTransform) UpperRight() (types.Tuple2[float64, float64], error) {
+func (s *Text) Font() (string, error) {
+	return s.FontContext(context.Background())
+}
+
+// FontContext - name of the font
+//
+// Allowed game scenes: any.
+func (s *Text) FontContext(ctx context.Context) (string, error) {
 	var err error
 	var argBytes []byte
-	var vv types.Tuple2[float64, float64]
+	var vv string
 	request := &types.ProcedureCall{
-		Procedure: "RectTransform_get_UpperRight",
+		Procedure: "Text_get_Font",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1954,7 +7294,7 @@ func (s *RectTransform) UpperRight() (types.Tuple2[float64, float64], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -1965,15 +7305,14 @@ func (s *RectTransform) UpperRight() (types.Tuple2[float64, float64], error) {
 	return vv, nil
 }
 
-// UpperRightStream - position of the rectangles upper right corner relative to
-// the anchors.
+// FontAsync - name of the font
 //
 // Allowed game scenes: any.
-func (s *RectTransform) UpperRightStream() (*krpcgo.Stream[types.Tuple2[float64, float64]], error) {
+func (s *Text) FontAsync() (*krpcgo.Future[string], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "RectTransform_get_UpperRight",
+		Procedure: "Text_get_Font",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -1984,138 +7323,134 @@ func (s *RectTransform) UpperRightStream() (*krpcgo.Stream[types.Tuple2[float64,
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) types.Tuple2[float64, float64] {
-		var value types.Tuple2[float64, float64]
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (string, error) {
+		var vv string
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetUpperRight - position of the rectangles upper right corner relative to the
-// anchors.
+// FontStream - name of the font
 //
 // Allowed game scenes: any.
-func (s *RectTransform) SetUpperRight(value types.Tuple2[float64, float64]) error {
+func (s *Text) FontStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[string], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "RectTransform_set_UpperRight",
+		Procedure: "Text_get_Font",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (string, error) {
+		var value string
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return stream, nil
 }
 
-// LowerLeft - position of the rectangles lower left corner relative to the
-// anchors.
-//
-// Allowed game scenes: any.
-func (s *RectTransform) LowerLeft() (types.Tuple2[float64, float64], error) {
+// FontCall builds a call to Font without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Text) FontCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv types.Tuple2[float64, float64]
 	request := &types.ProcedureCall{
-		Procedure: "RectTransform_get_LowerLeft",
+		Procedure: "Text_get_Font",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
+	return request, nil
+}
+
+// DecodeFont decodes the result of a call built with FontCall.
+func (s *Text) DecodeFont(result *types.ProcedureResult) (string, error) {
+	var vv string
+	if err := krpcgo.ResultError(result); err != nil {
 		return vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
 	return vv, nil
 }
 
-// LowerLeftStream - position of the rectangles lower left corner relative to
-// the anchors.
+// SetFont - name of the font
+//
+// Allowed game scenes: any.
+func (s *Text) SetFont(value string) error {
+	return s.SetFontContext(context.Background(), value)
+}
+
+// SetFontContext - name of the font
 //
 // Allowed game scenes: any.
-func (s *RectTransform) LowerLeftStream() (*krpcgo.Stream[types.Tuple2[float64, float64]], error) {
+func (s *Text) SetFontContext(ctx context.Context, value string) error {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "RectTransform_get_LowerLeft",
+		Procedure: "Text_set_Font",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return nil, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return nil, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) types.Tuple2[float64, float64] {
-		var value types.Tuple2[float64, float64]
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
 	})
-	return stream, nil
+	_, err = s.Client.CallContext(ctx, request)
+	if err != nil {
+		return tracerr.Wrap(err)
+	}
+	return nil
 }
 
-// SetLowerLeft - position of the rectangles lower left corner relative to the
-// anchors.
+// SetFontAsync - name of the font
 //
 // Allowed game scenes: any.
-func (s *RectTransform) SetLowerLeft(value types.Tuple2[float64, float64]) error {
+func (s *Text) SetFontAsync(value string) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "RectTransform_set_LowerLeft",
+		Procedure: "Text_set_Font",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
@@ -2123,33 +7458,31 @@ func (s *RectTransform) SetLowerLeft(value types.Tuple2[float64, float64]) error
 	})
 	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
 }
 
-// SetAnchor - set the minimum and maximum anchor points as a fraction of the
-// size of the parent rectangle.
-//
-// Allowed game scenes: any.
-func (s *RectTransform) SetAnchor(value types.Tuple2[float64, float64]) error {
+// SetFontCall builds a call to SetFont without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Text) SetFontCall(value string) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "RectTransform_set_Anchor",
+		Procedure: "Text_set_Font",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
@@ -2157,29 +7490,31 @@ func (s *RectTransform) SetAnchor(value types.Tuple2[float64, float64]) error {
 	})
 	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return request, nil
 }
 
-// AnchorMax - the anchor point for the lower left corner of the rectangle
-// defined as a fraction of the size of the parent rectangle.
+// Size - font size.
 //
 // Allowed game scenes: any.
-func (s *RectTransform) AnchorMax() (types.Tuple2[float64, float64], error) {
+func (s *Text) Size() (int32, error) {
+	return s.SizeContext(context.Background())
+}
+
+// SizeContext - font size.
+//
+// Allowed game scenes: any.
+func (s *Text) SizeContext(ctx context.Context) (int32, error) {
 	var err error
 	var argBytes []byte
-	var vv types.Tuple2[float64, float64]
+	var vv int32
 	request := &types.ProcedureCall{
-		Procedure: "RectTransform_get_AnchorMax",
+		Procedure: "Text_get_Size",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2190,7 +7525,7 @@ func (s *RectTransform) AnchorMax() (types.Tuple2[float64, float64], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -2201,15 +7536,14 @@ func (s *RectTransform) AnchorMax() (types.Tuple2[float64, float64], error) {
 	return vv, nil
 }
 
-// AnchorMaxStream - the anchor point for the lower left corner of the rectangle
-// defined as a fraction of the size of the parent rectangle.
+// SizeAsync - font size.
 //
 // Allowed game scenes: any.
-func (s *RectTransform) AnchorMaxStream() (*krpcgo.Stream[types.Tuple2[float64, float64]], error) {
+func (s *Text) SizeAsync() (*krpcgo.Future[int32], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "RectTransform_get_AnchorMax",
+		Procedure: "Text_get_Size",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2220,97 +7554,129 @@ func (s *RectTransform) AnchorMaxStream() (*krpcgo.Stream[types.Tuple2[float64,
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) types.Tuple2[float64, float64] {
-		var value types.Tuple2[float64, float64]
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (int32, error) {
+		var vv int32
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetAnchorMax - the anchor point for the lower left corner of the rectangle
-// defined as a fraction of the size of the parent rectangle.
+// SizeStream - font size.
 //
 // Allowed game scenes: any.
-func (s *RectTransform) SetAnchorMax(value types.Tuple2[float64, float64]) error {
+func (s *Text) SizeStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[int32], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "RectTransform_set_AnchorMax",
+		Procedure: "Text_get_Size",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (int32, error) {
+		var value int32
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// SizeCall builds a call to Size without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Text) SizeCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Text_get_Size",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
+		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	return request, nil
+}
+
+// DecodeSize decodes the result of a call built with SizeCall.
+func (s *Text) DecodeSize(result *types.ProcedureResult) (int32, error) {
+	var vv int32
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	return nil
+	return vv, nil
 }
 
-// AnchorMin - the anchor point for the upper right corner of the rectangle
-// defined as a fraction of the size of the parent rectangle.
+// SetSize - font size.
 //
 // Allowed game scenes: any.
-func (s *RectTransform) AnchorMin() (types.Tuple2[float64, float64], error) {
+func (s *Text) SetSize(value int32) error {
+	return s.SetSizeContext(context.Background(), value)
+}
+
+// SetSizeContext - font size.
+//
+// Allowed game scenes: any.
+func (s *Text) SetSizeContext(ctx context.Context, value int32) error {
 	var err error
 	var argBytes []byte
-	var vv types.Tuple2[float64, float64]
 	request := &types.ProcedureCall{
-		Procedure: "RectTransform_get_AnchorMin",
+		Procedure: "Text_set_Size",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	return vv, nil
+	return nil
 }
 
-// AnchorMinStream - the anchor point for the upper right corner of the
-// rectangle defined as a fraction of the size of the parent rectangle.
+// SetSizeAsync - font size.
 //
 // Allowed game scenes: any.
-func (s *RectTransform) AnchorMinStream() (*krpcgo.Stream[types.Tuple2[float64, float64]], error) {
+func (s *Text) SetSizeAsync(value int32) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "RectTransform_get_AnchorMin",
+		Procedure: "Text_set_Size",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2321,37 +7687,33 @@ func (s *RectTransform) AnchorMinStream() (*krpcgo.Stream[types.Tuple2[float64,
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) types.Tuple2[float64, float64] {
-		var value types.Tuple2[float64, float64]
-		encode.Unmarshal(b, &value)
-		return value
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
 	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetAnchorMin - the anchor point for the upper right corner of the rectangle
-// defined as a fraction of the size of the parent rectangle.
-//
-// Allowed game scenes: any.
-func (s *RectTransform) SetAnchorMin(value types.Tuple2[float64, float64]) error {
+// SetSizeCall builds a call to SetSize without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Text) SetSizeCall(value int32) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "RectTransform_set_AnchorMin",
+		Procedure: "Text_set_Size",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
@@ -2359,29 +7721,31 @@ func (s *RectTransform) SetAnchorMin(value types.Tuple2[float64, float64]) error
 	})
 	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return request, nil
 }
 
-// Pivot - location of the pivot point around which the rectangle rotates,
-// defined as a fraction of the size of the rectangle itself.
+// Style - font style.
 //
 // Allowed game scenes: any.
-func (s *RectTransform) Pivot() (types.Tuple2[float64, float64], error) {
+func (s *Text) Style() (FontStyle, error) {
+	return s.StyleContext(context.Background())
+}
+
+// StyleContext - font style.
+//
+// Allowed game scenes: any.
+func (s *Text) StyleContext(ctx context.Context) (FontStyle, error) {
 	var err error
 	var argBytes []byte
-	var vv types.Tuple2[float64, float64]
+	var vv FontStyle
 	request := &types.ProcedureCall{
-		Procedure: "RectTransform_get_Pivot",
+		Procedure: "Text_get_Style",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2392,7 +7756,7 @@ func (s *RectTransform) Pivot() (types.Tuple2[float64, float64], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -2403,15 +7767,14 @@ func (s *RectTransform) Pivot() (types.Tuple2[float64, float64], error) {
 	return vv, nil
 }
 
-// PivotStream - location of the pivot point around which the rectangle rotates,
-// defined as a fraction of the size of the rectangle itself.
+// StyleAsync - font style.
 //
 // Allowed game scenes: any.
-func (s *RectTransform) PivotStream() (*krpcgo.Stream[types.Tuple2[float64, float64]], error) {
+func (s *Text) StyleAsync() (*krpcgo.Future[FontStyle], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "RectTransform_get_Pivot",
+		Procedure: "Text_get_Style",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2422,96 +7785,129 @@ func (s *RectTransform) PivotStream() (*krpcgo.Stream[types.Tuple2[float64, floa
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) types.Tuple2[float64, float64] {
-		var value types.Tuple2[float64, float64]
-		encode.Unmarshal(b, &value)
-		return value
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (FontStyle, error) {
+		var vv FontStyle
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
 	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
-	})
-	return stream, nil
+	return future, nil
 }
 
-// SetPivot - location of the pivot point around which the rectangle rotates,
-// defined as a fraction of the size of the rectangle itself.
+// StyleStream - font style.
 //
 // Allowed game scenes: any.
-func (s *RectTransform) SetPivot(value types.Tuple2[float64, float64]) error {
+func (s *Text) StyleStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[FontStyle], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "RectTransform_set_Pivot",
+		Procedure: "Text_get_Style",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
+	}
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (FontStyle, error) {
+		var value FontStyle
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
+	})
+	return stream, nil
+}
+
+// StyleCall builds a call to Style without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Text) StyleCall() (*types.ProcedureCall, error) {
+	var err error
+	var argBytes []byte
+	request := &types.ProcedureCall{
+		Procedure: "Text_get_Style",
+		Service:   "UI",
+	}
+	argBytes, err = encode.Marshal(s)
+	if err != nil {
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
+		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	return request, nil
+}
+
+// DecodeStyle decodes the result of a call built with StyleCall.
+func (s *Text) DecodeStyle(result *types.ProcedureResult) (FontStyle, error) {
+	var vv FontStyle
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	return nil
+	return vv, nil
 }
 
-// Rotation - rotation, as a quaternion, of the object around its pivot point.
+// SetStyle - font style.
 //
 // Allowed game scenes: any.
-func (s *RectTransform) Rotation() (types.Tuple4[float64, float64, float64, float64], error) {
+func (s *Text) SetStyle(value FontStyle) error {
+	return s.SetStyleContext(context.Background(), value)
+}
+
+// SetStyleContext - font style.
+//
+// Allowed game scenes: any.
+func (s *Text) SetStyleContext(ctx context.Context, value FontStyle) error {
 	var err error
 	var argBytes []byte
-	var vv types.Tuple4[float64, float64, float64, float64]
 	request := &types.ProcedureCall{
-		Procedure: "RectTransform_get_Rotation",
+		Procedure: "Text_set_Style",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	return vv, nil
+	return nil
 }
 
-// RotationStream - rotation, as a quaternion, of the object around its pivot
-// point.
+// SetStyleAsync - font style.
 //
 // Allowed game scenes: any.
-func (s *RectTransform) RotationStream() (*krpcgo.Stream[types.Tuple4[float64, float64, float64, float64]], error) {
+func (s *Text) SetStyleAsync(value FontStyle) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "RectTransform_get_Rotation",
+		Procedure: "Text_set_Style",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2522,37 +7918,33 @@ func (s *RectTransform) RotationStream() (*krpcgo.Stream[types.Tuple4[float64, f
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) types.Tuple4[float64, float64, float64, float64] {
-		var value types.Tuple4[float64, float64, float64, float64]
-		encode.Unmarshal(b, &value)
-		return value
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
 	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetRotation - rotation, as a quaternion, of the object around its pivot
-// point.
-//
-// Allowed game scenes: any.
-func (s *RectTransform) SetRotation(value types.Tuple4[float64, float64, float64, float64]) error {
+// SetStyleCall builds a call to SetStyle without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Text) SetStyleCall(value FontStyle) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "RectTransform_set_Rotation",
+		Procedure: "Text_set_Style",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
@@ -2560,28 +7952,31 @@ func (s *RectTransform) SetRotation(value types.Tuple4[float64, float64, float64
 	})
 	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return request, nil
 }
 
-// Scale - scale factor applied to the object in the x, y and z dimensions.
+// Alignment - alignment.
 //
 // Allowed game scenes: any.
-func (s *RectTransform) Scale() (types.Tuple3[float64, float64, float64], error) {
+func (s *Text) Alignment() (TextAnchor, error) {
+	return s.AlignmentContext(context.Background())
+}
+
+// AlignmentContext - alignment.
+//
+// Allowed game scenes: any.
+func (s *Text) AlignmentContext(ctx context.Context) (TextAnchor, error) {
 	var err error
 	var argBytes []byte
-	var vv types.Tuple3[float64, float64, float64]
+	var vv TextAnchor
 	request := &types.ProcedureCall{
-		Procedure: "RectTransform_get_Scale",
+		Procedure: "Text_get_Alignment",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2592,7 +7987,7 @@ func (s *RectTransform) Scale() (types.Tuple3[float64, float64, float64], error)
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -2603,15 +7998,14 @@ func (s *RectTransform) Scale() (types.Tuple3[float64, float64, float64], error)
 	return vv, nil
 }
 
-// ScaleStream - scale factor applied to the object in the x, y and z
-// dimensions.
+// AlignmentAsync - alignment.
 //
 // Allowed game scenes: any.
-func (s *RectTransform) ScaleStream() (*krpcgo.Stream[types.Tuple3[float64, float64, float64]], error) {
+func (s *Text) AlignmentAsync() (*krpcgo.Future[TextAnchor], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "RectTransform_get_Scale",
+		Procedure: "Text_get_Alignment",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2622,150 +8016,161 @@ func (s *RectTransform) ScaleStream() (*krpcgo.Stream[types.Tuple3[float64, floa
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) types.Tuple3[float64, float64, float64] {
-		var value types.Tuple3[float64, float64, float64]
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (TextAnchor, error) {
+		var vv TextAnchor
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetScale - scale factor applied to the object in the x, y and z dimensions.
+// AlignmentStream - alignment.
 //
 // Allowed game scenes: any.
-func (s *RectTransform) SetScale(value types.Tuple3[float64, float64, float64]) error {
+func (s *Text) AlignmentStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[TextAnchor], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "RectTransform_set_Scale",
+		Procedure: "Text_get_Alignment",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (TextAnchor, error) {
+		var value TextAnchor
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return stream, nil
 }
 
-// Remove - remove the UI object.
-//
-// Allowed game scenes: any.
-func (s *Text) Remove() error {
+// AlignmentCall builds a call to Alignment without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Text) AlignmentCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_Remove",
+		Procedure: "Text_get_Alignment",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	return request, nil
+}
+
+// DecodeAlignment decodes the result of a call built with AlignmentCall.
+func (s *Text) DecodeAlignment(result *types.ProcedureResult) (TextAnchor, error) {
+	var vv TextAnchor
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
+	}
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	return nil
+	return vv, nil
 }
 
-// RectTransform - the rect transform for the text.
+// SetAlignment - alignment.
 //
 // Allowed game scenes: any.
-func (s *Text) RectTransform() (*RectTransform, error) {
+func (s *Text) SetAlignment(value TextAnchor) error {
+	return s.SetAlignmentContext(context.Background(), value)
+}
+
+// SetAlignmentContext - alignment.
+//
+// Allowed game scenes: any.
+func (s *Text) SetAlignmentContext(ctx context.Context, value TextAnchor) error {
 	var err error
 	var argBytes []byte
-	var vv RectTransform
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_RectTransform",
+		Procedure: "Text_set_Alignment",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
-		return &vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	vv.Client = s.Client
-	return &vv, nil
+	return nil
 }
 
-// AvailableFonts - a list of all available fonts.
+// SetAlignmentAsync - alignment.
 //
 // Allowed game scenes: any.
-func (s *Text) AvailableFonts() ([]string, error) {
+func (s *Text) SetAlignmentAsync(value TextAnchor) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
-	var vv []string
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_AvailableFonts",
+		Procedure: "Text_set_Alignment",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	return vv, nil
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
 }
 
-// AvailableFontsStream - a list of all available fonts.
-//
-// Allowed game scenes: any.
-func (s *Text) AvailableFontsStream() (*krpcgo.Stream[[]string], error) {
+// SetAlignmentCall builds a call to SetAlignment without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *Text) SetAlignmentCall(value TextAnchor) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_AvailableFonts",
+		Procedure: "Text_set_Alignment",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2776,32 +8181,33 @@ func (s *Text) AvailableFontsStream() (*krpcgo.Stream[[]string], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) []string {
-		var value []string
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
 	})
-	return stream, nil
+	return request, nil
 }
 
-// Content - the text string
+// LineSpacing - line spacing.
 //
 // Allowed game scenes: any.
-func (s *Text) Content() (string, error) {
+func (s *Text) LineSpacing() (float32, error) {
+	return s.LineSpacingContext(context.Background())
+}
+
+// LineSpacingContext - line spacing.
+//
+// Allowed game scenes: any.
+func (s *Text) LineSpacingContext(ctx context.Context) (float32, error) {
 	var err error
 	var argBytes []byte
-	var vv string
+	var vv float32
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_Content",
+		Procedure: "Text_get_LineSpacing",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2812,7 +8218,7 @@ func (s *Text) Content() (string, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -2823,14 +8229,14 @@ func (s *Text) Content() (string, error) {
 	return vv, nil
 }
 
-// ContentStream - the text string
+// LineSpacingAsync - line spacing.
 //
 // Allowed game scenes: any.
-func (s *Text) ContentStream() (*krpcgo.Stream[string], error) {
+func (s *Text) LineSpacingAsync() (*krpcgo.Future[float32], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_Content",
+		Procedure: "Text_get_LineSpacing",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2841,129 +8247,96 @@ func (s *Text) ContentStream() (*krpcgo.Stream[string], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) string {
-		var value string
-		encode.Unmarshal(b, &value)
-		return value
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (float32, error) {
+		var vv float32
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
 	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
-	})
-	return stream, nil
+	return future, nil
 }
 
-// SetContent - the text string
+// LineSpacingStream - line spacing.
 //
 // Allowed game scenes: any.
-func (s *Text) SetContent(value string) error {
+func (s *Text) LineSpacingStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[float32], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_set_Content",
+		Procedure: "Text_get_LineSpacing",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (float32, error) {
+		var value float32
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return stream, nil
 }
 
-// Font - name of the font
-//
-// Allowed game scenes: any.
-func (s *Text) Font() (string, error) {
+// LineSpacingCall builds a call to LineSpacing without making it, such as to
+// make several calls with CallMultiple, add a stream with AddStream or use it
+// in an expression.
+func (s *Text) LineSpacingCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv string
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_Font",
+		Procedure: "Text_get_LineSpacing",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
+	return request, nil
+}
+
+// DecodeLineSpacing decodes the result of a call built with LineSpacingCall.
+func (s *Text) DecodeLineSpacing(result *types.ProcedureResult) (float32, error) {
+	var vv float32
+	if err := krpcgo.ResultError(result); err != nil {
 		return vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
 	return vv, nil
 }
 
-// FontStream - name of the font
+// SetLineSpacing - line spacing.
 //
 // Allowed game scenes: any.
-func (s *Text) FontStream() (*krpcgo.Stream[string], error) {
-	var err error
-	var argBytes []byte
-	request := &types.ProcedureCall{
-		Procedure: "Text_get_Font",
-		Service:   "UI",
-	}
-	argBytes, err = encode.Marshal(s)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x0),
-		Value:    argBytes,
-	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) string {
-		var value string
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
-	})
-	return stream, nil
+func (s *Text) SetLineSpacing(value float32) error {
+	return s.SetLineSpacingContext(context.Background(), value)
 }
 
-// SetFont - name of the font
+// SetLineSpacingContext - line spacing.
 //
 // Allowed game scenes: any.
-func (s *Text) SetFont(value string) error {
+func (s *Text) SetLineSpacingContext(ctx context.Context, value float32) error {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_set_Font",
+		Procedure: "Text_set_LineSpacing",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -2982,51 +8355,21 @@ func (s *Text) SetFont(value string) error {
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
 		return tracerr.Wrap(err)
 	}
 	return nil
 }
 
-// Size - font size.
-//
-// Allowed game scenes: any.
-func (s *Text) Size() (int32, error) {
-	var err error
-	var argBytes []byte
-	var vv int32
-	request := &types.ProcedureCall{
-		Procedure: "Text_get_Size",
-		Service:   "UI",
-	}
-	argBytes, err = encode.Marshal(s)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x0),
-		Value:    argBytes,
-	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	return vv, nil
-}
-
-// SizeStream - font size.
+// SetLineSpacingAsync - line spacing.
 //
 // Allowed game scenes: any.
-func (s *Text) SizeStream() (*krpcgo.Stream[int32], error) {
+func (s *Text) SetLineSpacingAsync(value float32) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_Size",
+		Procedure: "Text_set_LineSpacing",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -3037,36 +8380,33 @@ func (s *Text) SizeStream() (*krpcgo.Stream[int32], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) int32 {
-		var value int32
-		encode.Unmarshal(b, &value)
-		return value
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
 	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetSize - font size.
-//
-// Allowed game scenes: any.
-func (s *Text) SetSize(value int32) error {
+// SetLineSpacingCall builds a call to SetLineSpacing without making it, such as
+// to make several calls with CallMultiple, add a stream with AddStream or use
+// it in an expression.
+func (s *Text) SetLineSpacingCall(value float32) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_set_Size",
+		Procedure: "Text_set_LineSpacing",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
@@ -3074,28 +8414,31 @@ func (s *Text) SetSize(value int32) error {
 	})
 	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return request, nil
 }
 
-// Style - font style.
+// Color - set the color
 //
 // Allowed game scenes: any.
-func (s *Text) Style() (FontStyle, error) {
+func (s *Text) Color() (types.Tuple3[float64, float64, float64], error) {
+	return s.ColorContext(context.Background())
+}
+
+// ColorContext - set the color
+//
+// Allowed game scenes: any.
+func (s *Text) ColorContext(ctx context.Context) (types.Tuple3[float64, float64, float64], error) {
 	var err error
 	var argBytes []byte
-	var vv FontStyle
+	var vv types.Tuple3[float64, float64, float64]
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_Style",
+		Procedure: "Text_get_Color",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -3106,7 +8449,7 @@ func (s *Text) Style() (FontStyle, error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
@@ -3117,14 +8460,14 @@ func (s *Text) Style() (FontStyle, error) {
 	return vv, nil
 }
 
-// StyleStream - font style.
+// ColorAsync - set the color
 //
 // Allowed game scenes: any.
-func (s *Text) StyleStream() (*krpcgo.Stream[FontStyle], error) {
+func (s *Text) ColorAsync() (*krpcgo.Future[types.Tuple3[float64, float64, float64]], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_Style",
+		Procedure: "Text_get_Color",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -3135,129 +8478,96 @@ func (s *Text) StyleStream() (*krpcgo.Stream[FontStyle], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) FontStyle {
-		var value FontStyle
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (types.Tuple3[float64, float64, float64], error) {
+		var vv types.Tuple3[float64, float64, float64]
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetStyle - font style.
+// ColorStream - set the color
 //
 // Allowed game scenes: any.
-func (s *Text) SetStyle(value FontStyle) error {
+func (s *Text) ColorStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[types.Tuple3[float64, float64, float64]], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_set_Style",
+		Procedure: "Text_get_Color",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (types.Tuple3[float64, float64, float64], error) {
+		var value types.Tuple3[float64, float64, float64]
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return stream, nil
 }
 
-// Alignment - alignment.
-//
-// Allowed game scenes: any.
-func (s *Text) Alignment() (TextAnchor, error) {
+// ColorCall builds a call to Color without making it, such as to make several
+// calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Text) ColorCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
-	var vv TextAnchor
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_Alignment",
+		Procedure: "Text_get_Color",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
+	return request, nil
+}
+
+// DecodeColor decodes the result of a call built with ColorCall.
+func (s *Text) DecodeColor(result *types.ProcedureResult) (types.Tuple3[float64, float64, float64], error) {
+	var vv types.Tuple3[float64, float64, float64]
+	if err := krpcgo.ResultError(result); err != nil {
 		return vv, tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
 		return vv, tracerr.Wrap(err)
 	}
 	return vv, nil
 }
 
-// AlignmentStream - alignment.
+// SetColor - set the color
 //
 // Allowed game scenes: any.
-func (s *Text) AlignmentStream() (*krpcgo.Stream[TextAnchor], error) {
-	var err error
-	var argBytes []byte
-	request := &types.ProcedureCall{
-		Procedure: "Text_get_Alignment",
-		Service:   "UI",
-	}
-	argBytes, err = encode.Marshal(s)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x0),
-		Value:    argBytes,
-	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
-	if err != nil {
-		return nil, tracerr.Wrap(err)
-	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) TextAnchor {
-		var value TextAnchor
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
-	})
-	return stream, nil
+func (s *Text) SetColor(value types.Tuple3[float64, float64, float64]) error {
+	return s.SetColorContext(context.Background(), value)
 }
 
-// SetAlignment - alignment.
+// SetColorContext - set the color
 //
 // Allowed game scenes: any.
-func (s *Text) SetAlignment(value TextAnchor) error {
+func (s *Text) SetColorContext(ctx context.Context, value types.Tuple3[float64, float64, float64]) error {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_set_Alignment",
+		Procedure: "Text_set_Color",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -3276,51 +8586,53 @@ func (s *Text) SetAlignment(value TextAnchor) error {
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
 		return tracerr.Wrap(err)
 	}
 	return nil
 }
 
-// LineSpacing - line spacing.
+// SetColorAsync - set the color
 //
 // Allowed game scenes: any.
-func (s *Text) LineSpacing() (float32, error) {
+func (s *Text) SetColorAsync(value types.Tuple3[float64, float64, float64]) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
-	var vv float32
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_LineSpacing",
+		Procedure: "Text_set_Color",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
-	return vv, nil
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
+	})
+	return future, nil
 }
 
-// LineSpacingStream - line spacing.
-//
-// Allowed game scenes: any.
-func (s *Text) LineSpacingStream() (*krpcgo.Stream[float32], error) {
+// SetColorCall builds a call to SetColor without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Text) SetColorCall(value types.Tuple3[float64, float64, float64]) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_LineSpacing",
+		Procedure: "Text_set_Color",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -3331,94 +8643,91 @@ func (s *Text) LineSpacingStream() (*krpcgo.Stream[float32], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) float32 {
-		var value float32
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
 	})
-	return stream, nil
+	return request, nil
 }
 
-// SetLineSpacing - line spacing.
+// Visible - whether the UI object is visible.
 //
 // Allowed game scenes: any.
-func (s *Text) SetLineSpacing(value float32) error {
+func (s *Text) Visible() (bool, error) {
+	return s.VisibleContext(context.Background())
+}
+
+// VisibleContext - whether the UI object is visible.
+//
+// Allowed game scenes: any.
+func (s *Text) VisibleContext(ctx context.Context) (bool, error) {
 	var err error
 	var argBytes []byte
+	var vv bool
 	request := &types.ProcedureCall{
-		Procedure: "Text_set_LineSpacing",
+		Procedure: "Text_get_Visible",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
+	result, err := s.Client.CallContext(ctx, request)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
-	})
-	_, err = s.Client.Call(request)
+	err = encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	return nil
+	return vv, nil
 }
 
-// Color - set the color
+// VisibleAsync - whether the UI object is visible.
 //
 // Allowed game scenes: any.
-func (s *Text) Color() (types.Tuple3[float64, float64, float64], error) {
+func (s *Text) VisibleAsync() (*krpcgo.Future[bool], error) {
 	var err error
 	var argBytes []byte
-	var vv types.Tuple3[float64, float64, float64]
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_Color",
+		Procedure: "Text_get_Visible",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	err = encode.Unmarshal(result.Value, &vv)
-	if err != nil {
-		return vv, tracerr.Wrap(err)
-	}
-	return vv, nil
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (bool, error) {
+		var vv bool
+		err := encode.Unmarshal(result.Value, &vv)
+		if err != nil {
+			return vv, tracerr.Wrap(err)
+		}
+		return vv, nil
+	})
+	return future, nil
 }
 
-// ColorStream - set the color
+// VisibleStream - whether the UI object is visible.
 //
 // Allowed game scenes: any.
-func (s *Text) ColorStream() (*krpcgo.Stream[types.Tuple3[float64, float64, float64]], error) {
+func (s *Text) VisibleStream(opts ...krpcgo.StreamOption) (*krpcgo.Stream[bool], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_Color",
+		Procedure: "Text_get_Visible",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -3429,94 +8738,100 @@ func (s *Text) ColorStream() (*krpcgo.Stream[types.Tuple3[float64, float64, floa
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	rawStream, err := s.Client.AddStream(request, opts...)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) types.Tuple3[float64, float64, float64] {
-		var value types.Tuple3[float64, float64, float64]
-		encode.Unmarshal(b, &value)
-		return value
-	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	stream := krpcgo.MapStreamErr(rawStream, func(b []byte) (bool, error) {
+		var value bool
+		err := encode.Unmarshal(b, &value)
+		return value, tracerr.Wrap(err)
 	})
 	return stream, nil
 }
 
-// SetColor - set the color
-//
-// Allowed game scenes: any.
-func (s *Text) SetColor(value types.Tuple3[float64, float64, float64]) error {
+// VisibleCall builds a call to Visible without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Text) VisibleCall() (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_set_Color",
+		Procedure: "Text_get_Visible",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	argBytes, err = encode.Marshal(value)
-	if err != nil {
-		return tracerr.Wrap(err)
+	return request, nil
+}
+
+// DecodeVisible decodes the result of a call built with VisibleCall.
+func (s *Text) DecodeVisible(result *types.ProcedureResult) (bool, error) {
+	var vv bool
+	if err := krpcgo.ResultError(result); err != nil {
+		return vv, tracerr.Wrap(err)
 	}
-	request.Arguments = append(request.Arguments, &types.Argument{
-		Position: uint32(0x1),
-		Value:    argBytes,
-	})
-	_, err = s.Client.Call(request)
+	err := encode.Unmarshal(result.Value, &vv)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return vv, tracerr.Wrap(err)
 	}
-	return nil
+	return vv, nil
 }
 
-// Visible - whether the UI object is visible.
+// SetVisible - whether the UI object is visible.
 //
 // Allowed game scenes: any.
-func (s *Text) Visible() (bool, error) {
+func (s *Text) SetVisible(value bool) error {
+	return s.SetVisibleContext(context.Background(), value)
+}
+
+// SetVisibleContext - whether the UI object is visible.
+//
+// Allowed game scenes: any.
+func (s *Text) SetVisibleContext(ctx context.Context, value bool) error {
 	var err error
 	var argBytes []byte
-	var vv bool
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_Visible",
+		Procedure: "Text_set_Visible",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	result, err := s.Client.Call(request)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	err = encode.Unmarshal(result.Value, &vv)
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
+	})
+	_, err = s.Client.CallContext(ctx, request)
 	if err != nil {
-		return vv, tracerr.Wrap(err)
+		return tracerr.Wrap(err)
 	}
-	return vv, nil
+	return nil
 }
 
-// VisibleStream - whether the UI object is visible.
+// SetVisibleAsync - whether the UI object is visible.
 //
 // Allowed game scenes: any.
-func (s *Text) VisibleStream() (*krpcgo.Stream[bool], error) {
+func (s *Text) SetVisibleAsync(value bool) (*krpcgo.Future[struct{}], error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
-		Procedure: "Text_get_Visible",
+		Procedure: "Text_set_Visible",
 		Service:   "UI",
 	}
 	argBytes, err = encode.Marshal(s)
@@ -3527,27 +8842,24 @@ func (s *Text) VisibleStream() (*krpcgo.Stream[bool], error) {
 		Position: uint32(0x0),
 		Value:    argBytes,
 	})
-	krpc := krpc.New(s.Client)
-	st, err := krpc.AddStream(request, true)
+	argBytes, err = encode.Marshal(value)
 	if err != nil {
 		return nil, tracerr.Wrap(err)
 	}
-	rawStream := s.Client.GetStream(st.Id)
-	stream := krpcgo.MapStream(rawStream, func(b []byte) bool {
-		var value bool
-		encode.Unmarshal(b, &value)
-		return value
+	request.Arguments = append(request.Arguments, &types.Argument{
+		Position: uint32(0x1),
+		Value:    argBytes,
 	})
-	stream.AddCloser(func() error {
-		return tracerr.Wrap(krpc.RemoveStream(st.Id))
+	future := krpcgo.MapFuture(s.Client.CallAsync(request), func(result *types.ProcedureResult) (struct{}, error) {
+		return struct{}{}, nil
 	})
-	return stream, nil
+	return future, nil
 }
 
-// SetVisible - whether the UI object is visible.
-//
-// Allowed game scenes: any.
-func (s *Text) SetVisible(value bool) error {
+// SetVisibleCall builds a call to SetVisible without making it, such as to make
+// several calls with CallMultiple, add a stream with AddStream or use it in an
+// expression.
+func (s *Text) SetVisibleCall(value bool) (*types.ProcedureCall, error) {
 	var err error
 	var argBytes []byte
 	request := &types.ProcedureCall{
@@ -3556,7 +8868,7 @@ func (s *Text) SetVisible(value bool) error {
 	}
 	argBytes, err = encode.Marshal(s)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x0),
@@ -3564,15 +8876,170 @@ func (s *Text) SetVisible(value bool) error {
 	})
 	argBytes, err = encode.Marshal(value)
 	if err != nil {
-		return tracerr.Wrap(err)
+		return nil, tracerr.Wrap(err)
 	}
 	request.Arguments = append(request.Arguments, &types.Argument{
 		Position: uint32(0x1),
 		Value:    argBytes,
 	})
-	_, err = s.Client.Call(request)
-	if err != nil {
-		return tracerr.Wrap(err)
-	}
-	return nil
+	return request, nil
+}
+func init() {
+	krpcgo.RegisterReturnTypes("UI", map[string]*types.Type{
+		"AddCanvas": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Canvas",
+			Service: "UI",
+		},
+		"Button_get_Clicked": &types.Type{Code: types.Type_BOOL},
+		"Button_get_RectTransform": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "RectTransform",
+			Service: "UI",
+		},
+		"Button_get_Text": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Text",
+			Service: "UI",
+		},
+		"Button_get_Visible": &types.Type{Code: types.Type_BOOL},
+		"Canvas_AddButton": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Button",
+			Service: "UI",
+		},
+		"Canvas_AddInputField": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "InputField",
+			Service: "UI",
+		},
+		"Canvas_AddPanel": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Panel",
+			Service: "UI",
+		},
+		"Canvas_AddText": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Text",
+			Service: "UI",
+		},
+		"Canvas_get_RectTransform": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "RectTransform",
+			Service: "UI",
+		},
+		"Canvas_get_Visible":     &types.Type{Code: types.Type_BOOL},
+		"InputField_get_Changed": &types.Type{Code: types.Type_BOOL},
+		"InputField_get_RectTransform": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "RectTransform",
+			Service: "UI",
+		},
+		"InputField_get_Text": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Text",
+			Service: "UI",
+		},
+		"InputField_get_Value":   &types.Type{Code: types.Type_STRING},
+		"InputField_get_Visible": &types.Type{Code: types.Type_BOOL},
+		"Panel_AddButton": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Button",
+			Service: "UI",
+		},
+		"Panel_AddInputField": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "InputField",
+			Service: "UI",
+		},
+		"Panel_AddPanel": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Panel",
+			Service: "UI",
+		},
+		"Panel_AddText": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Text",
+			Service: "UI",
+		},
+		"Panel_get_RectTransform": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "RectTransform",
+			Service: "UI",
+		},
+		"Panel_get_Visible": &types.Type{Code: types.Type_BOOL},
+		"RectTransform_get_AnchorMax": &types.Type{
+			Code:  types.Type_TUPLE,
+			Types: []*types.Type{&types.Type{Code: types.Type_DOUBLE}, &types.Type{Code: types.Type_DOUBLE}},
+		},
+		"RectTransform_get_AnchorMin": &types.Type{
+			Code:  types.Type_TUPLE,
+			Types: []*types.Type{&types.Type{Code: types.Type_DOUBLE}, &types.Type{Code: types.Type_DOUBLE}},
+		},
+		"RectTransform_get_LocalPosition": &types.Type{
+			Code:  types.Type_TUPLE,
+			Types: []*types.Type{&types.Type{Code: types.Type_DOUBLE}, &types.Type{Code: types.Type_DOUBLE}, &types.Type{Code: types.Type_DOUBLE}},
+		},
+		"RectTransform_get_LowerLeft": &types.Type{
+			Code:  types.Type_TUPLE,
+			Types: []*types.Type{&types.Type{Code: types.Type_DOUBLE}, &types.Type{Code: types.Type_DOUBLE}},
+		},
+		"RectTransform_get_Pivot": &types.Type{
+			Code:  types.Type_TUPLE,
+			Types: []*types.Type{&types.Type{Code: types.Type_DOUBLE}, &types.Type{Code: types.Type_DOUBLE}},
+		},
+		"RectTransform_get_Position": &types.Type{
+			Code:  types.Type_TUPLE,
+			Types: []*types.Type{&types.Type{Code: types.Type_DOUBLE}, &types.Type{Code: types.Type_DOUBLE}},
+		},
+		"RectTransform_get_Rotation": &types.Type{
+			Code:  types.Type_TUPLE,
+			Types: []*types.Type{&types.Type{Code: types.Type_DOUBLE}, &types.Type{Code: types.Type_DOUBLE}, &types.Type{Code: types.Type_DOUBLE}, &types.Type{Code: types.Type_DOUBLE}},
+		},
+		"RectTransform_get_Scale": &types.Type{
+			Code:  types.Type_TUPLE,
+			Types: []*types.Type{&types.Type{Code: types.Type_DOUBLE}, &types.Type{Code: types.Type_DOUBLE}, &types.Type{Code: types.Type_DOUBLE}},
+		},
+		"RectTransform_get_Size": &types.Type{
+			Code:  types.Type_TUPLE,
+			Types: []*types.Type{&types.Type{Code: types.Type_DOUBLE}, &types.Type{Code: types.Type_DOUBLE}},
+		},
+		"RectTransform_get_UpperRight": &types.Type{
+			Code:  types.Type_TUPLE,
+			Types: []*types.Type{&types.Type{Code: types.Type_DOUBLE}, &types.Type{Code: types.Type_DOUBLE}},
+		},
+		"Text_get_Alignment": &types.Type{
+			Code:    types.Type_ENUMERATION,
+			Name:    "TextAnchor",
+			Service: "UI",
+		},
+		"Text_get_AvailableFonts": &types.Type{
+			Code:  types.Type_LIST,
+			Types: []*types.Type{&types.Type{Code: types.Type_STRING}},
+		},
+		"Text_get_Color": &types.Type{
+			Code:  types.Type_TUPLE,
+			Types: []*types.Type{&types.Type{Code: types.Type_DOUBLE}, &types.Type{Code: types.Type_DOUBLE}, &types.Type{Code: types.Type_DOUBLE}},
+		},
+		"Text_get_Content":     &types.Type{Code: types.Type_STRING},
+		"Text_get_Font":        &types.Type{Code: types.Type_STRING},
+		"Text_get_LineSpacing": &types.Type{Code: types.Type_FLOAT},
+		"Text_get_RectTransform": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "RectTransform",
+			Service: "UI",
+		},
+		"Text_get_Size": &types.Type{Code: types.Type_SINT32},
+		"Text_get_Style": &types.Type{
+			Code:    types.Type_ENUMERATION,
+			Name:    "FontStyle",
+			Service: "UI",
+		},
+		"Text_get_Visible": &types.Type{Code: types.Type_BOOL},
+		"get_StockCanvas": &types.Type{
+			Code:    types.Type_CLASS,
+			Name:    "Canvas",
+			Service: "UI",
+		},
+	})
 }
@@ -0,0 +1,116 @@
+package krpcgo
+
+import (
+	"context"
+
+	"github.com/ztrue/tracerr"
+)
+
+// WaitUntil waits until a value of the stream satisfies cond, and returns
+// that value. The most recent value is checked first, followed by the values
+// received from C, so WaitUntil shouldn't be used while another goroutine
+// receives from C. Values that are dropped before they're received are still
+// checked if they become the most recent value. It gives up when ctx is
+// done, so use context.WithTimeout for a timeout, and fails if the stream
+// ends first. See Latest for the errors returned when the stream has ended.
+func (s *Stream[T]) WaitUntil(ctx context.Context, cond func(T) bool) (T, error) {
+	var zero T
+	for {
+		// Get the channel before the value so that no change is missed.
+		updated := s.updated()
+		value, _, err := s.Latest(ctx)
+		if err != nil {
+			return zero, tracerr.Wrap(err)
+		}
+		if cond(value) {
+			return value, nil
+		}
+		select {
+		case value, ok := <-s.C:
+			if !ok {
+				return zero, tracerr.Wrap(s.endErr())
+			}
+			if cond(value) {
+				return value, nil
+			}
+		case <-updated:
+		case <-s.done:
+			return zero, tracerr.Wrap(s.endErr())
+		case <-ctx.Done():
+			return zero, tracerr.Wrap(ctx.Err())
+		}
+	}
+}
+
+// Condition is a condition on the values of a stream, for WaitAny and
+// WaitAll. Create one with Until.
+type Condition struct {
+	wait func(ctx context.Context) (interface{}, error)
+}
+
+// Until creates a condition that holds once a value of the stream satisfies
+// cond. See Stream.WaitUntil.
+func Until[T any](s *Stream[T], cond func(T) bool) *Condition {
+	return &Condition{
+		wait: func(ctx context.Context) (interface{}, error) {
+			value, err := s.WaitUntil(ctx, cond)
+			return value, tracerr.Wrap(err)
+		},
+	}
+}
+
+// conditionResult is the result of waiting for a condition.
+type conditionResult struct {
+	index int
+	value interface{}
+	err   error
+}
+
+// waitConditions waits for every condition concurrently, and sends the
+// results to a channel. The waits stop when ctx is done.
+func waitConditions(ctx context.Context, conds []*Condition) <-chan conditionResult {
+	results := make(chan conditionResult, len(conds))
+	for i, cond := range conds {
+		i, cond := i, cond
+		go func() {
+			value, err := cond.wait(ctx)
+			results <- conditionResult{index: i, value: value, err: err}
+		}()
+	}
+	return results
+}
+
+// WaitAny waits until any of the conditions holds, and returns the index of
+// the condition and the value that satisfied it. It fails as soon as waiting
+// for any condition fails, such as when its stream ends, or ctx is done.
+func WaitAny(ctx context.Context, conds ...*Condition) (int, interface{}, error) {
+	if len(conds) == 0 {
+		return -1, nil, tracerr.Errorf("No conditions to wait for")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	result := <-waitConditions(ctx, conds)
+	if result.err != nil {
+		return -1, nil, tracerr.Wrap(result.err)
+	}
+	return result.index, result.value, nil
+}
+
+// WaitAll waits until every condition has held, not necessarily at the same
+// time, and returns the values that satisfied them in the same order as the
+// conditions. It fails as soon as waiting for any condition fails, such as
+// when its stream ends, or ctx is done.
+func WaitAll(ctx context.Context, conds ...*Condition) ([]interface{}, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	results := waitConditions(ctx, conds)
+	values := make([]interface{}, len(conds))
+	for range conds {
+		result := <-results
+		if result.err != nil {
+			return nil, tracerr.Wrap(result.err)
+		}
+		values[result.index] = result.value
+	}
+	return values, nil
+}
@@ -0,0 +1,80 @@
+package krpcgo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// feed sends values to a channel in the background.
+func feed[T any](c chan T, values ...T) {
+	go func() {
+		for _, value := range values {
+			c <- value
+		}
+	}()
+}
+
+func TestWaitUntil(t *testing.T) {
+	c := make(chan int)
+	stream := NewStream(1, c)
+	defer stream.Close()
+	feed(c, 1, 2, 3, 4)
+	value, err := stream.WaitUntil(context.Background(), func(v int) bool { return v >= 3 })
+	require.NoError(t, err)
+	require.Equal(t, 3, value)
+
+	// The latest value is checked straight away.
+	value, err = stream.WaitUntil(context.Background(), func(v int) bool { return v >= 3 })
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, value, 3)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = stream.WaitUntil(ctx, func(v int) bool { return v > 10 })
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// Values dropped before they're received are still checked.
+	sm := newStreamManager(0)
+	raw := sm.newStream()
+	defer raw.Close()
+	sm.write([]byte("dropped"))
+	s, err := raw.WaitUntil(context.Background(), func(b []byte) bool { return string(b) == "dropped" })
+	require.NoError(t, err)
+	require.Equal(t, "dropped", string(s))
+
+	require.NoError(t, raw.Close())
+	_, err = raw.WaitUntil(context.Background(), func([]byte) bool { return false })
+	require.ErrorIs(t, err, ErrStreamClosed)
+}
+
+func TestWaitAnyAll(t *testing.T) {
+	numbers := make(chan int)
+	words := make(chan string)
+	numberStream := NewStream(1, numbers)
+	defer numberStream.Close()
+	wordStream := NewStream(2, words)
+	defer wordStream.Close()
+	conds := []*Condition{
+		Until(numberStream, func(v int) bool { return v > 10 }),
+		Until(wordStream, func(s string) bool { return s == "go" }),
+	}
+
+	feed(words, "wait", "go")
+	index, value, err := WaitAny(context.Background(), conds...)
+	require.NoError(t, err)
+	require.Equal(t, 1, index)
+	require.Equal(t, "go", value)
+
+	feed(numbers, 5, 20)
+	values, err := WaitAll(context.Background(), conds...)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{20, "go"}, values)
+
+	// A stream ending stops the wait.
+	close(numbers)
+	_, err = WaitAll(context.Background(), Until(numberStream, func(v int) bool { return v > 100 }), conds[1])
+	require.ErrorIs(t, err, ErrStreamClosed)
+}